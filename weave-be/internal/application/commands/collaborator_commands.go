@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// InviteCollaboratorCommand represents the command to invite a user to collaborate on a weave
+type InviteCollaboratorCommand struct {
+	WeaveID   uuid.UUID                 `json:"weave_id" validate:"required"`
+	UserID    uuid.UUID                 `json:"user_id" validate:"required"` // The inviting user
+	InviteeID uuid.UUID                 `json:"invitee_id" validate:"required"`
+	Role      entities.CollaboratorRole `json:"role" validate:"required"`
+}
+
+// AcceptCollaborationCommand represents the command to accept a collaboration invitation
+type AcceptCollaborationCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// RemoveCollaboratorCommand represents the command to revoke a role, decline an invitation or leave a weave
+type RemoveCollaboratorCommand struct {
+	WeaveID        uuid.UUID `json:"weave_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"` // The acting user
+	CollaboratorID uuid.UUID `json:"collaborator_id" validate:"required"`
+}
@@ -0,0 +1,48 @@
+package commands
+
+import "github.com/google/uuid"
+
+// CreateCollectionCommand represents the command to create a weave collection
+type CreateCollectionCommand struct {
+	UserID      uuid.UUID `json:"user_id" validate:"required"`
+	Name        string    `json:"name" validate:"required,max=100"`
+	Description *string   `json:"description,omitempty"`
+	IsPublic    bool      `json:"is_public"`
+}
+
+// UpdateCollectionCommand represents the command to update a collection; nil fields are left unchanged
+type UpdateCollectionCommand struct {
+	CollectionID uuid.UUID `json:"collection_id" validate:"required"`
+	UserID       uuid.UUID `json:"user_id" validate:"required"`
+	Name         *string   `json:"name,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	IsPublic     *bool     `json:"is_public,omitempty"`
+}
+
+// DeleteCollectionCommand represents the command to delete a collection
+type DeleteCollectionCommand struct {
+	CollectionID uuid.UUID `json:"collection_id" validate:"required"`
+	UserID       uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// AddCollectionWeaveCommand represents the command to add a weave to a collection
+type AddCollectionWeaveCommand struct {
+	CollectionID uuid.UUID `json:"collection_id" validate:"required"`
+	UserID       uuid.UUID `json:"user_id" validate:"required"`
+	WeaveID      uuid.UUID `json:"weave_id" validate:"required"`
+	Position     *int      `json:"position,omitempty"` // Appended at the end when nil
+}
+
+// RemoveCollectionWeaveCommand represents the command to take a weave out of a collection
+type RemoveCollectionWeaveCommand struct {
+	CollectionID uuid.UUID `json:"collection_id" validate:"required"`
+	UserID       uuid.UUID `json:"user_id" validate:"required"`
+	WeaveID      uuid.UUID `json:"weave_id" validate:"required"`
+}
+
+// ReorderCollectionCommand represents the command to change the order of the weaves in a collection
+type ReorderCollectionCommand struct {
+	CollectionID uuid.UUID   `json:"collection_id" validate:"required"`
+	UserID       uuid.UUID   `json:"user_id" validate:"required"`
+	WeaveIDs     []uuid.UUID `json:"weave_ids" validate:"required"`
+}
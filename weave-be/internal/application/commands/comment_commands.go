@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+	"weave-module/diff"
+)
+
+// CreateCommentCommand represents the command to comment on a weave in the lab
+type CreateCommentCommand struct {
+	WeaveID         uuid.UUID            `json:"weave_id" validate:"required"`
+	UserID          uuid.UUID            `json:"user_id" validate:"required"`
+	ParentCommentID *uuid.UUID           `json:"parent_comment_id,omitempty"`
+	Type            entities.CommentType `json:"type" validate:"required"`
+	Content         string               `json:"content" validate:"required,max=10000"`
+	Anchor          *diff.Anchor         `json:"anchor,omitempty"` // Checked against the weave's current content
+}
+
+// EditCommentCommand represents the command to change the content of a comment
+type EditCommentCommand struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+	Content   string    `json:"content" validate:"required,max=10000"`
+}
+
+// ResolveCommentCommand represents the command to resolve a thread
+type ResolveCommentCommand struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// ReopenCommentCommand represents the command to reopen a resolved thread
+type ReopenCommentCommand struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// LikeCommentCommand represents the command to like a comment
+type LikeCommentCommand struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// UnlikeCommentCommand represents the command to remove a like from a comment
+type UnlikeCommentCommand struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+}
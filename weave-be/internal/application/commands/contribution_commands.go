@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// CreateMergeRequestCommand represents the command to propose a fork back to its parent weave
+type CreateMergeRequestCommand struct {
+	WeaveID     uuid.UUID `json:"weave_id" validate:"required"` // The fork being proposed
+	UserID      uuid.UUID `json:"user_id" validate:"required"`
+	Title       string    `json:"title" validate:"required,max=200"`
+	Description *string   `json:"description"`
+}
+
+// CreateSuggestionCommand represents the command to suggest replacing a single value in a weave's content
+type CreateSuggestionCommand struct {
+	WeaveID     uuid.UUID       `json:"weave_id" validate:"required"`
+	UserID      uuid.UUID       `json:"user_id" validate:"required"`
+	Path        string          `json:"path" validate:"required"`
+	Value       json.RawMessage `json:"value" validate:"required"`
+	Title       string          `json:"title" validate:"max=200"`
+	Description *string         `json:"description"`
+}
+
+// AcceptSuggestionCommand represents the command to approve and apply a suggestion in one step
+type AcceptSuggestionCommand struct {
+	ContributionID uuid.UUID `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// MergeContributionCommand represents the command to merge a contribution into its weave
+type MergeContributionCommand struct {
+	ContributionID uuid.UUID `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// ResolveContributionConflictsCommand represents the command to rebase a contribution onto the latest weave version
+type ResolveContributionConflictsCommand struct {
+	ContributionID uuid.UUID             `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID             `json:"user_id" validate:"required"`
+	Content        entities.WeaveContent `json:"content" validate:"required"`
+	BaseVersion    int                   `json:"base_version" validate:"min=1"`
+}
+
+// ReviewContributionCommand represents the command to review a contribution
+type ReviewContributionCommand struct {
+	ContributionID uuid.UUID               `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID               `json:"user_id" validate:"required"`
+	Decision       entities.ReviewDecision `json:"decision" validate:"required"`
+	Comment        *string                 `json:"comment"`
+}
+
+// AssignReviewerCommand represents the command to put a reviewer in charge of a contribution
+type AssignReviewerCommand struct {
+	ContributionID uuid.UUID `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"`
+	ReviewerID     uuid.UUID `json:"reviewer_id" validate:"required"`
+}
+
+// VoteContributionCommand represents the command to cast or change a vote on a contribution
+type VoteContributionCommand struct {
+	ContributionID uuid.UUID         `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID         `json:"user_id" validate:"required"`
+	Vote           entities.VoteType `json:"vote" validate:"required,oneof=up down"`
+}
+
+// RemoveContributionVoteCommand represents the command to withdraw a vote on a contribution
+type RemoveContributionVoteCommand struct {
+	ContributionID uuid.UUID `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// UpdateMergePolicyCommand represents the command to set what contributions need before merging into a weave
+type UpdateMergePolicyCommand struct {
+	WeaveID                 uuid.UUID `json:"weave_id" validate:"required"`
+	UserID                  uuid.UUID `json:"user_id" validate:"required"`
+	RequireOwnerApproval    bool      `json:"require_owner_approval"`
+	RequiredApprovals       int       `json:"required_approvals" validate:"min=0"`
+	MinVoteScore            *int      `json:"min_vote_score"`
+	BlockOnChangesRequested bool      `json:"block_on_changes_requested"`
+}
@@ -0,0 +1,10 @@
+package commands
+
+import "github.com/google/uuid"
+
+// MergeTagsCommand represents the command to fold a duplicate tag into another
+type MergeTagsCommand struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+	Source string    `json:"source" validate:"required"` // Tag that is merged away
+	Target string    `json:"target" validate:"required"` // Tag that keeps the weaves
+}
@@ -0,0 +1,25 @@
+package commands
+
+import "github.com/google/uuid"
+
+// SetTemplateCommand represents the command to offer a weave as a template or withdraw it
+type SetTemplateCommand struct {
+	WeaveID    uuid.UUID `json:"weave_id" validate:"required"`
+	UserID     uuid.UUID `json:"user_id" validate:"required"`
+	IsTemplate bool      `json:"is_template"`
+}
+
+// PinTemplateCommand represents the command to pin a template as one of a channel's official templates
+type PinTemplateCommand struct {
+	ChannelID uuid.UUID `json:"channel_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"` // The pinning moderator
+	WeaveID   uuid.UUID `json:"weave_id" validate:"required"`
+	Position  *int      `json:"position,omitempty"` // Appended after the other pins when nil
+}
+
+// UnpinTemplateCommand represents the command to remove a template from a channel's official templates
+type UnpinTemplateCommand struct {
+	ChannelID uuid.UUID `json:"channel_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+	WeaveID   uuid.UUID `json:"weave_id" validate:"required"`
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// CreateWeaveCommand represents the command to create a new weave
+type CreateWeaveCommand struct {
+	UserID      uuid.UUID             `json:"user_id" validate:"required"`
+	ChannelID   uuid.UUID             `json:"channel_id" validate:"required"`
+	Title       string                `json:"title" validate:"required,max=200"`
+	Description *string               `json:"description,omitempty"`
+	CoverImage  *string               `json:"cover_image,omitempty"`
+	Content     entities.WeaveContent `json:"content"`
+	TemplateID  *uuid.UUID            `json:"template_id,omitempty"` // Content is copied from the template when set
+	Tags        []string              `json:"tags,omitempty"`
+}
+
+// UpdateWeaveCommand represents the command to update an existing weave
+type UpdateWeaveCommand struct {
+	WeaveID          uuid.UUID              `json:"weave_id" validate:"required"`
+	UserID           uuid.UUID              `json:"user_id" validate:"required"`
+	Title            *string                `json:"title,omitempty"`
+	Description      *string                `json:"description,omitempty"`
+	CoverImage       *string                `json:"cover_image,omitempty"`
+	Content          *entities.WeaveContent `json:"content,omitempty"`
+	ChangeLog        *string                `json:"change_log,omitempty"`
+	ExpectedVersions []int                  `json:"expected_versions" validate:"dive,min=1"` // Versions the edit may be based on
+	AnyVersion       bool                   `json:"any_version"`                             // Skip the version check, as If-Match: * does
+	Tags             *[]string              `json:"tags,omitempty"`                          // Left unchanged when nil
+}
+
+// DeleteWeaveCommand represents the command to delete a weave
+type DeleteWeaveCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// ForkWeaveCommand represents the command to fork a weave
+type ForkWeaveCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// LikeWeaveCommand represents the command to like a weave
+type LikeWeaveCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// UnlikeWeaveCommand represents the command to remove a like from a weave
+type UnlikeWeaveCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// ChangeWeaveStatusCommand represents the command to move a weave to another status
+type ChangeWeaveStatusCommand struct {
+	WeaveID uuid.UUID            `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID            `json:"user_id" validate:"required"`
+	Status  entities.WeaveStatus `json:"status" validate:"required"`
+}
+
+// RestoreWeaveCommand represents the command to take a weave out of the trash
+type RestoreWeaveCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// SchedulePublicationCommand represents the command to publish a weave automatically at a later time
+type SchedulePublicationCommand struct {
+	WeaveID   uuid.UUID `json:"weave_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+	PublishAt time.Time `json:"publish_at" validate:"required"`
+}
+
+// CancelScheduledPublicationCommand represents the command to drop a weave's publication schedule
+type CancelScheduledPublicationCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// RevertWeaveCommand represents the command to restore an earlier version of a weave
+type RevertWeaveCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+	Version int       `json:"version" validate:"min=1"`
+}
+
+// SyncWeaveCommand represents the command to merge new upstream changes into a fork
+type SyncWeaveCommand struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// ImportWeaveCommand represents the command to import a weave from a JSON bundle or a Markdown file
+type ImportWeaveCommand struct {
+	UserID      uuid.UUID `json:"user_id" validate:"required"`
+	ChannelID   uuid.UUID `json:"channel_id" validate:"required"`
+	Format      string    `json:"format" validate:"required"` // json, markdown
+	Bundle      []byte    `json:"bundle,omitempty"`
+	Markdown    string    `json:"markdown,omitempty"`
+	ContentType string    `json:"content_type,omitempty"` // Type the Markdown is read into
+}
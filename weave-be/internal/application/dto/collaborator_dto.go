@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// Request DTOs
+type InviteCollaboratorRequest struct {
+	UserID uuid.UUID                 `json:"user_id" binding:"required"`
+	Role   entities.CollaboratorRole `json:"role" binding:"required"`
+}
+
+func (r InviteCollaboratorRequest) Validate() error {
+	if r.UserID == uuid.Nil {
+		return fmt.Errorf("user_id is required")
+	}
+	if !r.Role.IsValid() {
+		return fmt.Errorf("role must be one of owner, maintainer, editor, reviewer")
+	}
+	return nil
+}
+
+// Response DTOs
+type CollaboratorResponse struct {
+	UserID     uuid.UUID                   `json:"user_id"`
+	Username   string                      `json:"username"`
+	Role       entities.CollaboratorRole   `json:"role"`
+	Status     entities.CollaboratorStatus `json:"status"`
+	InvitedBy  *uuid.UUID                  `json:"invited_by"` // Nil for the weave creator
+	AcceptedAt *time.Time                  `json:"accepted_at"`
+	CreatedAt  time.Time                   `json:"created_at"`
+}
+
+// Conversion functions
+func CollaboratorToResponse(collaborator *entities.WeaveCollaborator) *CollaboratorResponse {
+	invitedBy := collaborator.InvitedBy
+	return &CollaboratorResponse{
+		UserID:     collaborator.UserID,
+		Username:   collaborator.Username,
+		Role:       collaborator.Role,
+		Status:     collaborator.Status,
+		InvitedBy:  &invitedBy,
+		AcceptedAt: collaborator.AcceptedAt,
+		CreatedAt:  collaborator.CreatedAt,
+	}
+}
+
+// WeaveOwnerToResponse describes the weave creator, who holds the owner role without an invitation
+func WeaveOwnerToResponse(weave *entities.Weave, username string) *CollaboratorResponse {
+	return &CollaboratorResponse{
+		UserID:    weave.UserID,
+		Username:  username,
+		Role:      entities.CollaboratorRoleOwner,
+		Status:    entities.CollaboratorStatusActive,
+		CreatedAt: weave.CreatedAt,
+	}
+}
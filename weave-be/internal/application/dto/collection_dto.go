@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// Request DTOs
+type CreateCollectionRequest struct {
+	Name        string  `json:"name" binding:"required,max=100"`
+	Description *string `json:"description"`
+	IsPublic    bool    `json:"is_public"`
+}
+
+func (r CreateCollectionRequest) Validate() error {
+	return validateCollectionName(r.Name)
+}
+
+type UpdateCollectionRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	IsPublic    *bool   `json:"is_public"`
+}
+
+func (r UpdateCollectionRequest) Validate() error {
+	if r.Name == nil && r.Description == nil && r.IsPublic == nil {
+		return fmt.Errorf("nothing to update")
+	}
+	if r.Name != nil {
+		return validateCollectionName(*r.Name)
+	}
+	return nil
+}
+
+func validateCollectionName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(name) > 100 {
+		return fmt.Errorf("name cannot exceed 100 characters")
+	}
+	return nil
+}
+
+type AddCollectionWeaveRequest struct {
+	WeaveID  uuid.UUID `json:"weave_id" binding:"required"`
+	Position *int      `json:"position"`
+}
+
+func (r AddCollectionWeaveRequest) Validate() error {
+	if r.WeaveID == uuid.Nil {
+		return fmt.Errorf("weave_id is required")
+	}
+	if r.Position != nil && *r.Position < 0 {
+		return fmt.Errorf("position cannot be negative")
+	}
+	return nil
+}
+
+type ReorderCollectionRequest struct {
+	WeaveIDs []uuid.UUID `json:"weave_ids" binding:"required"`
+}
+
+func (r ReorderCollectionRequest) Validate() error {
+	if len(r.WeaveIDs) > entities.MaxCollectionWeaves {
+		return fmt.Errorf("a collection holds at most %d weaves", entities.MaxCollectionWeaves)
+	}
+	return nil
+}
+
+// Response DTOs
+type CollectionResponse struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	IsPublic    bool      `json:"is_public"`
+	WeaveCount  int       `json:"weave_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type PaginatedCollectionsResponse struct {
+	Collections []CollectionResponse `json:"collections"`
+	Page        int                  `json:"page"`
+	Limit       int                  `json:"limit"`
+	Total       int                  `json:"total"`
+}
+
+// Conversion functions
+func CollectionToResponse(collection *entities.Collection) *CollectionResponse {
+	return &CollectionResponse{
+		ID:          collection.ID,
+		UserID:      collection.UserID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		IsPublic:    collection.IsPublic,
+		WeaveCount:  collection.WeaveCount,
+		CreatedAt:   collection.CreatedAt,
+		UpdatedAt:   collection.UpdatedAt,
+	}
+}
+
+func CollectionsToResponse(collections []*entities.Collection) []CollectionResponse {
+	responses := make([]CollectionResponse, len(collections))
+	for i, collection := range collections {
+		responses[i] = *CollectionToResponse(collection)
+	}
+	return responses
+}
@@ -0,0 +1,199 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+	"weave-module/diff"
+)
+
+// maxCommentLength caps the text of a single comment
+const maxCommentLength = 10000
+
+// Request DTOs
+
+// CommentAnchorRequest points a comment at a value in the weave content, given as a
+// JSON Pointer such as "/data/steps/2/text", and optionally at a range of characters
+// [start, end) within a text value
+type CommentAnchorRequest struct {
+	Path  string `json:"path" binding:"required"`
+	Start *int   `json:"start"`
+	End   *int   `json:"end"`
+}
+
+func (r CommentAnchorRequest) Validate() error {
+	if !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("anchor path must be a JSON pointer starting with /")
+	}
+	if (r.Start == nil) != (r.End == nil) {
+		return fmt.Errorf("anchor start and end must be given together")
+	}
+	if r.Start != nil && (*r.Start < 0 || *r.End <= *r.Start) {
+		return fmt.Errorf("anchor range must satisfy 0 <= start < end")
+	}
+	return nil
+}
+
+type CreateCommentRequest struct {
+	ParentCommentID *uuid.UUID            `json:"parent_comment_id"` // Set to reply within a thread
+	Type            entities.CommentType  `json:"type"`              // Defaults to general
+	Content         string                `json:"content" binding:"required"`
+	Anchor          *CommentAnchorRequest `json:"anchor"` // Omitted for comments on the whole weave
+}
+
+func (r CreateCommentRequest) Validate() error {
+	if err := validateCommentContent(r.Content); err != nil {
+		return err
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		return fmt.Errorf("type must be one of general, suggestion, question, issue or approval")
+	}
+	if r.Anchor != nil {
+		if r.ParentCommentID != nil {
+			return fmt.Errorf("replies cannot be anchored, the first comment of the thread holds the anchor")
+		}
+		return r.Anchor.Validate()
+	}
+	return nil
+}
+
+type EditCommentRequest struct {
+	Content string `json:"content" binding:"required"`
+}
+
+func (r EditCommentRequest) Validate() error {
+	return validateCommentContent(r.Content)
+}
+
+func validateCommentContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return fmt.Errorf("content is required")
+	}
+	if len([]rune(content)) > maxCommentLength {
+		return fmt.Errorf("content cannot exceed %d characters", maxCommentLength)
+	}
+	return nil
+}
+
+// CommentFilterRequest holds the filters of a comment listing, taken from the query string
+type CommentFilterRequest struct {
+	AnchorPath *string
+	Type       *string
+	IsResolved *bool
+	IsOutdated *bool
+}
+
+func (r CommentFilterRequest) Validate() error {
+	if r.AnchorPath != nil && !strings.HasPrefix(*r.AnchorPath, "/") {
+		return fmt.Errorf("path must be a JSON pointer starting with /")
+	}
+	if r.Type != nil && !entities.CommentType(*r.Type).IsValid() {
+		return fmt.Errorf("type must be one of general, suggestion, question, issue or approval")
+	}
+	return nil
+}
+
+// Response DTOs
+type CommentResponse struct {
+	ID              uuid.UUID            `json:"id"`
+	WeaveID         uuid.UUID            `json:"weave_id"`
+	UserID          uuid.UUID            `json:"user_id"`
+	ParentCommentID *uuid.UUID           `json:"parent_comment_id"`
+	Type            entities.CommentType `json:"type"`
+	Content         string               `json:"content"`
+	Anchor          *diff.Anchor         `json:"anchor"`
+	IsOutdated      bool                 `json:"is_outdated"` // The anchored content no longer exists
+	IsResolved      bool                 `json:"is_resolved"`
+	ResolvedBy      *uuid.UUID           `json:"resolved_by"`
+	ResolvedAt      *time.Time           `json:"resolved_at"`
+	LikeCount       int                  `json:"like_count"`
+	ReplyCount      int                  `json:"reply_count"`
+	EditedAt        *time.Time           `json:"edited_at"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+// CommentThreadResponse is a comment with its replies nested below it
+type CommentThreadResponse struct {
+	*CommentResponse
+	Replies        []*CommentThreadResponse `json:"replies"`
+	HasMoreReplies bool                     `json:"has_more_replies"` // Replies exist below the requested depth
+}
+
+type CommentRevisionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"` // When this content was replaced
+}
+
+type IssueSummaryResponse struct {
+	WeaveID           uuid.UUID                    `json:"weave_id"`
+	OpenIssues        int                          `json:"open_issues"`
+	OutdatedIssues    int                          `json:"outdated_issues"`
+	OpenThreads       map[entities.CommentType]int `json:"open_threads"` // Unresolved threads by type
+	OldestOpenIssueAt *time.Time                   `json:"oldest_open_issue_at"`
+	Issues            []*CommentResponse           `json:"issues"`
+}
+
+type PaginatedCommentsResponse struct {
+	Comments []*CommentResponse `json:"comments"`
+	Page     int                `json:"page"`
+	Limit    int                `json:"limit"`
+	Total    int                `json:"total"`
+}
+
+// Conversion functions
+func CommentToResponse(comment *entities.LabComment) *CommentResponse {
+	return &CommentResponse{
+		ID:              comment.ID,
+		WeaveID:         comment.WeaveID,
+		UserID:          comment.UserID,
+		ParentCommentID: comment.ParentCommentID,
+		Type:            comment.Type,
+		Content:         comment.Content,
+		Anchor:          comment.Anchor,
+		IsOutdated:      comment.IsOutdated,
+		IsResolved:      comment.IsResolved,
+		ResolvedBy:      comment.ResolvedBy,
+		ResolvedAt:      comment.ResolvedAt,
+		LikeCount:       comment.LikeCount,
+		ReplyCount:      comment.ReplyCount,
+		EditedAt:        comment.EditedAt,
+		CreatedAt:       comment.CreatedAt,
+		UpdatedAt:       comment.UpdatedAt,
+	}
+}
+
+func CommentsToResponse(comments []*entities.LabComment) []*CommentResponse {
+	responses := make([]*CommentResponse, len(comments))
+	for i, comment := range comments {
+		responses[i] = CommentToResponse(comment)
+	}
+	return responses
+}
+
+func CommentRevisionsToResponse(revisions []*entities.CommentRevision) []*CommentRevisionResponse {
+	responses := make([]*CommentRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		responses[i] = &CommentRevisionResponse{
+			ID:        revision.ID,
+			Content:   revision.Content,
+			CreatedAt: revision.CreatedAt,
+		}
+	}
+	return responses
+}
+
+func IssueSummaryToResponse(weaveID uuid.UUID, summary *entities.IssueSummary) *IssueSummaryResponse {
+	return &IssueSummaryResponse{
+		WeaveID:           weaveID,
+		OpenIssues:        summary.OpenIssues,
+		OutdatedIssues:    summary.OutdatedIssues,
+		OpenThreads:       summary.OpenByType,
+		OldestOpenIssueAt: summary.OldestOpenIssueAt,
+		Issues:            CommentsToResponse(summary.Issues),
+	}
+}
@@ -0,0 +1,285 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+	"weave-module/diff"
+)
+
+// Request DTOs
+type CreateMergeRequestRequest struct {
+	Title       string  `json:"title" binding:"required,max=200"`
+	Description *string `json:"description"`
+}
+
+func (r CreateMergeRequestRequest) Validate() error {
+	if strings.TrimSpace(r.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if len(r.Title) > 200 {
+		return fmt.Errorf("title cannot exceed 200 characters")
+	}
+	return nil
+}
+
+type ResolveConflictsRequest struct {
+	Content     entities.WeaveContent `json:"content" binding:"required"`
+	BaseVersion int                   `json:"base_version" binding:"required,min=1"` // Weave version the resolution was made against
+}
+
+func (r ResolveConflictsRequest) Validate() error {
+	if strings.TrimSpace(r.Content.Type) == "" {
+		return fmt.Errorf("content type is required")
+	}
+	if r.BaseVersion < 1 {
+		return fmt.Errorf("base_version must be a positive version number")
+	}
+	return nil
+}
+
+// maxSuggestionValueSize caps the encoded size of a suggested value in bytes
+const maxSuggestionValueSize = 10000
+
+type CreateSuggestionRequest struct {
+	Path        string          `json:"path" binding:"required"` // JSON Pointer of the value to replace, e.g. "/data/steps/2/text"
+	Value       json.RawMessage `json:"value"`                   // Replacement value, null included
+	Title       string          `json:"title" binding:"max=200"` // Defaults to one naming the path
+	Description *string         `json:"description"`
+}
+
+func (r CreateSuggestionRequest) Validate() error {
+	if !strings.HasPrefix(r.Path, "/data/") {
+		return fmt.Errorf("path must point into the content data, e.g. /data/servings")
+	}
+	if len(r.Value) == 0 {
+		return fmt.Errorf("value is required")
+	}
+	if len(r.Value) > maxSuggestionValueSize {
+		return fmt.Errorf("value cannot exceed %d bytes", maxSuggestionValueSize)
+	}
+	if len(r.Title) > 200 {
+		return fmt.Errorf("title cannot exceed 200 characters")
+	}
+	return nil
+}
+
+// maxReviewCommentLength caps the comment of a single review
+const maxReviewCommentLength = 5000
+
+// maxRequiredApprovals caps how many approvals a merge policy can ask for
+const maxRequiredApprovals = 10
+
+type ReviewContributionRequest struct {
+	Decision entities.ReviewDecision `json:"decision" binding:"required"`
+	Comment  *string                 `json:"comment"` // Required unless approving
+}
+
+func (r ReviewContributionRequest) Validate() error {
+	if !r.Decision.IsValid() {
+		return fmt.Errorf("decision must be one of approve, request_changes or reject")
+	}
+	hasComment := r.Comment != nil && strings.TrimSpace(*r.Comment) != ""
+	if r.Decision != entities.ReviewDecisionApprove && !hasComment {
+		return fmt.Errorf("a comment is required when requesting changes or rejecting")
+	}
+	if r.Comment != nil && len([]rune(*r.Comment)) > maxReviewCommentLength {
+		return fmt.Errorf("comment cannot exceed %d characters", maxReviewCommentLength)
+	}
+	return nil
+}
+
+type AssignReviewerRequest struct {
+	ReviewerID uuid.UUID `json:"reviewer_id" binding:"required"`
+}
+
+func (r AssignReviewerRequest) Validate() error {
+	if r.ReviewerID == uuid.Nil {
+		return fmt.Errorf("reviewer_id is required")
+	}
+	return nil
+}
+
+type VoteContributionRequest struct {
+	Vote entities.VoteType `json:"vote" binding:"required"`
+}
+
+func (r VoteContributionRequest) Validate() error {
+	if !r.Vote.IsValid() {
+		return fmt.Errorf("vote must be up or down")
+	}
+	return nil
+}
+
+type UpdateMergePolicyRequest struct {
+	RequireOwnerApproval    bool `json:"require_owner_approval"`
+	RequiredApprovals       int  `json:"required_approvals"`
+	MinVoteScore            *int `json:"min_vote_score"` // Omit for no vote threshold
+	BlockOnChangesRequested bool `json:"block_on_changes_requested"`
+}
+
+func (r UpdateMergePolicyRequest) Validate() error {
+	if r.RequiredApprovals < 0 || r.RequiredApprovals > maxRequiredApprovals {
+		return fmt.Errorf("required_approvals must be between 0 and %d", maxRequiredApprovals)
+	}
+	return nil
+}
+
+// Response DTOs
+type ContributionResponse struct {
+	ID              uuid.UUID                   `json:"id"`
+	UserID          uuid.UUID                   `json:"user_id"`
+	WeaveID         uuid.UUID                   `json:"weave_id"`
+	Type            entities.ContributionType   `json:"type"`
+	Title           string                      `json:"title"`
+	Description     *string                     `json:"description"`
+	OriginalContent *entities.WeaveContent      `json:"original_content"`
+	ProposedContent *entities.WeaveContent      `json:"proposed_content"`
+	ContentDiff     *diff.Diff                  `json:"content_diff"`
+	ProposedAt      time.Time                   `json:"proposed_at"`
+	SourceWeaveID   *uuid.UUID                  `json:"source_weave_id,omitempty"`
+	Status          entities.ContributionStatus `json:"status"`
+	ReviewerID      *uuid.UUID                  `json:"reviewer_id"`
+	ReviewedAt      *time.Time                  `json:"reviewed_at"`
+	ReviewComment   *string                     `json:"review_comment"`
+	MergedBy        *uuid.UUID                  `json:"merged_by"`
+	MergedAt        *time.Time                  `json:"merged_at"`
+	VoteScore       int                         `json:"vote_score"`
+	Priority        int                         `json:"priority"`
+	CreatedAt       time.Time                   `json:"created_at"`
+	UpdatedAt       time.Time                   `json:"updated_at"`
+}
+
+// MergeContributionResponse reports the outcome of a merge attempt.
+// When Merged is false, Conflicts lists the paths that need manual resolution.
+type MergeContributionResponse struct {
+	ContributionID uuid.UUID                   `json:"contribution_id"`
+	WeaveID        uuid.UUID                   `json:"weave_id"`
+	Status         entities.ContributionStatus `json:"status"`
+	Merged         bool                        `json:"merged"`
+	Version        *WeaveVersionResponse       `json:"version,omitempty"`
+	Conflicts      []diff.Conflict             `json:"conflicts"`
+}
+
+// ContributionConflictsResponse describes how a contribution relates to the latest weave version.
+// Clients send WeaveVersion back as base_version when resolving.
+type ContributionConflictsResponse struct {
+	ContributionID uuid.UUID             `json:"contribution_id"`
+	WeaveID        uuid.UUID             `json:"weave_id"`
+	WeaveVersion   int                   `json:"weave_version"`
+	IsStale        bool                  `json:"is_stale"`
+	CurrentContent entities.WeaveContent `json:"current_content"`
+	Conflicts      []diff.Conflict       `json:"conflicts"`
+}
+
+// Conversion functions
+func ContributionToResponse(contribution *entities.Contribution) *ContributionResponse {
+	return &ContributionResponse{
+		ID:              contribution.ID,
+		UserID:          contribution.UserID,
+		WeaveID:         contribution.WeaveID,
+		Type:            contribution.Type,
+		Title:           contribution.Title,
+		Description:     contribution.Description,
+		OriginalContent: contribution.OriginalContent,
+		ProposedContent: contribution.ProposedContent,
+		ContentDiff:     contribution.ContentDiff,
+		ProposedAt:      contribution.ProposedAt,
+		SourceWeaveID:   contribution.SourceWeaveID,
+		Status:          contribution.Status,
+		ReviewerID:      contribution.ReviewerID,
+		ReviewedAt:      contribution.ReviewedAt,
+		ReviewComment:   contribution.ReviewComment,
+		MergedBy:        contribution.MergedBy,
+		MergedAt:        contribution.MergedAt,
+		VoteScore:       contribution.VoteScore,
+		Priority:        contribution.Priority,
+		CreatedAt:       contribution.CreatedAt,
+		UpdatedAt:       contribution.UpdatedAt,
+	}
+}
+
+type ContributionReviewResponse struct {
+	ID         uuid.UUID               `json:"id"`
+	ReviewerID uuid.UUID               `json:"reviewer_id"`
+	Decision   entities.ReviewDecision `json:"decision"`
+	Comment    *string                 `json:"comment"`
+	IsOutdated bool                    `json:"is_outdated"` // Left on an earlier proposal, so it no longer counts
+	CreatedAt  time.Time               `json:"created_at"`
+}
+
+type ContributionVoteResponse struct {
+	ContributionID uuid.UUID          `json:"contribution_id"`
+	Vote           *entities.VoteType `json:"vote"` // The user's vote, nil once withdrawn
+	VoteScore      int                `json:"vote_score"`
+}
+
+type MergePolicyResponse struct {
+	WeaveID                 uuid.UUID  `json:"weave_id"`
+	RequireOwnerApproval    bool       `json:"require_owner_approval"`
+	RequiredApprovals       int        `json:"required_approvals"`
+	MinVoteScore            *int       `json:"min_vote_score"`
+	BlockOnChangesRequested bool       `json:"block_on_changes_requested"`
+	IsDefault               bool       `json:"is_default"` // The weave never set a policy
+	UpdatedBy               *uuid.UUID `json:"updated_by"`
+	UpdatedAt               *time.Time `json:"updated_at"`
+}
+
+// MergeReadinessResponse tells whether a contribution meets its weave's merge policy
+type MergeReadinessResponse struct {
+	Policy    *MergePolicyResponse `json:"policy"`
+	Mergeable bool                 `json:"mergeable"`
+	Unmet     []string             `json:"unmet"` // Requirements still missing
+}
+
+type ContributionReviewsResponse struct {
+	ContributionID uuid.UUID                     `json:"contribution_id"`
+	Status         entities.ContributionStatus   `json:"status"`
+	ReviewerID     *uuid.UUID                    `json:"reviewer_id"`
+	VoteScore      int                           `json:"vote_score"`
+	Reviews        []*ContributionReviewResponse `json:"reviews"`
+	Readiness      *MergeReadinessResponse       `json:"readiness"`
+}
+
+func ContributionReviewsToResponse(contribution *entities.Contribution, reviews []*entities.ContributionReview) []*ContributionReviewResponse {
+	responses := make([]*ContributionReviewResponse, len(reviews))
+	for i, review := range reviews {
+		responses[i] = &ContributionReviewResponse{
+			ID:         review.ID,
+			ReviewerID: review.ReviewerID,
+			Decision:   review.Decision,
+			Comment:    review.Comment,
+			IsOutdated: !contribution.IsCurrentReview(review),
+			CreatedAt:  review.CreatedAt,
+		}
+	}
+	return responses
+}
+
+func MergePolicyToResponse(policy *entities.MergePolicy) *MergePolicyResponse {
+	return &MergePolicyResponse{
+		WeaveID:                 policy.WeaveID,
+		RequireOwnerApproval:    policy.RequireOwnerApproval,
+		RequiredApprovals:       policy.RequiredApprovals,
+		MinVoteScore:            policy.MinVoteScore,
+		BlockOnChangesRequested: policy.BlockOnChangesRequested,
+		IsDefault:               policy.IsDefault(),
+		UpdatedBy:               policy.UpdatedBy,
+		UpdatedAt:               policy.UpdatedAt,
+	}
+}
+
+func MergeReadinessToResponse(policy *entities.MergePolicy, unmet []string) *MergeReadinessResponse {
+	if unmet == nil {
+		unmet = []string{}
+	}
+	return &MergeReadinessResponse{
+		Policy:    MergePolicyToResponse(policy),
+		Mergeable: len(unmet) == 0,
+		Unmet:     unmet,
+	}
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// Request DTOs
+type MergeTagsRequest struct {
+	Into string `json:"into" binding:"required"` // Tag that keeps the weaves
+}
+
+func (r MergeTagsRequest) Validate() error {
+	if strings.TrimSpace(r.Into) == "" {
+		return fmt.Errorf("into is required")
+	}
+	return nil
+}
+
+// Response DTOs
+type TagResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	WeaveCount  int       `json:"weave_count"`
+}
+
+type RelatedTagResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	SharedWeaves int       `json:"shared_weaves"`
+}
+
+// Conversion functions
+func TagToResponse(tag *entities.Tag) *TagResponse {
+	return &TagResponse{
+		ID:          tag.ID,
+		Name:        tag.Name,
+		Description: tag.Description,
+		WeaveCount:  tag.WeaveCount,
+	}
+}
+
+func TagsToResponse(tags []*entities.Tag) []*TagResponse {
+	responses := make([]*TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = TagToResponse(tag)
+	}
+	return responses
+}
+
+func RelatedTagsToResponse(related []*entities.RelatedTag) []*RelatedTagResponse {
+	responses := make([]*RelatedTagResponse, len(related))
+	for i, r := range related {
+		responses[i] = &RelatedTagResponse{
+			ID:           r.Tag.ID,
+			Name:         r.Tag.Name,
+			SharedWeaves: r.SharedWeaves,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// Request DTOs
+type PinTemplateRequest struct {
+	WeaveID  uuid.UUID `json:"weave_id" binding:"required"`
+	Position *int      `json:"position"`
+}
+
+func (r PinTemplateRequest) Validate() error {
+	if r.WeaveID == uuid.Nil {
+		return fmt.Errorf("weave_id is required")
+	}
+	if r.Position != nil && *r.Position < 0 {
+		return fmt.Errorf("position cannot be negative")
+	}
+	return nil
+}
+
+// Response DTOs
+type ChannelTemplateResponse struct {
+	ChannelID uuid.UUID      `json:"channel_id"`
+	Position  int            `json:"position"`
+	PinnedBy  uuid.UUID      `json:"pinned_by"`
+	PinnedAt  time.Time      `json:"pinned_at"`
+	Weave     *WeaveResponse `json:"weave"`
+}
+
+// Conversion functions
+func ChannelTemplateToResponse(pin *entities.ChannelTemplate) *ChannelTemplateResponse {
+	response := &ChannelTemplateResponse{
+		ChannelID: pin.ChannelID,
+		Position:  pin.Position,
+		PinnedBy:  pin.PinnedBy,
+		PinnedAt:  pin.CreatedAt,
+	}
+	if pin.Weave != nil {
+		response.Weave = WeaveToResponse(pin.Weave)
+	}
+	return response
+}
+
+func ChannelTemplatesToResponse(pins []*entities.ChannelTemplate) []*ChannelTemplateResponse {
+	responses := make([]*ChannelTemplateResponse, len(pins))
+	for i, pin := range pins {
+		responses[i] = ChannelTemplateToResponse(pin)
+	}
+	return responses
+}
@@ -0,0 +1,447 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-module/diff"
+)
+
+// Request DTOs
+type CreateWeaveRequest struct {
+	ChannelID   uuid.UUID             `json:"channel_id" binding:"required"`
+	Title       string                `json:"title" binding:"required,max=200"`
+	Description *string               `json:"description"`
+	CoverImage  *string               `json:"cover_image"`
+	Content     entities.WeaveContent `json:"content"`
+	TemplateID  *uuid.UUID            `json:"template_id"` // Copies the template's content instead
+	Tags        []string              `json:"tags"`
+}
+
+func (r CreateWeaveRequest) Validate() error {
+	if r.ChannelID == uuid.Nil {
+		return fmt.Errorf("channel_id is required")
+	}
+	if strings.TrimSpace(r.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if len(r.Title) > 200 {
+		return fmt.Errorf("title cannot exceed 200 characters")
+	}
+	if len(r.Tags) > entities.MaxWeaveTags {
+		return fmt.Errorf("a weave can have at most %d tags", entities.MaxWeaveTags)
+	}
+	if r.TemplateID != nil {
+		if r.Content.Type != "" || len(r.Content.Data) > 0 {
+			return fmt.Errorf("content cannot be given together with template_id")
+		}
+		return nil
+	}
+	if strings.TrimSpace(r.Content.Type) == "" {
+		return fmt.Errorf("content type is required")
+	}
+	return nil
+}
+
+type ChangeWeaveStatusRequest struct {
+	Status entities.WeaveStatus `json:"status" binding:"required"`
+}
+
+func (r ChangeWeaveStatusRequest) Validate() error {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("status must be one of draft, in_review, published, archived or deleted")
+	}
+	return nil
+}
+
+type SchedulePublicationRequest struct {
+	PublishAt time.Time `json:"publish_at" binding:"required"`
+}
+
+func (r SchedulePublicationRequest) Validate() error {
+	if r.PublishAt.IsZero() {
+		return fmt.Errorf("publish_at is required")
+	}
+	return nil
+}
+
+// ImportWeaveRequest carries either a JSON bundle exported from a weave or a Markdown
+// file. Markdown has no content type of its own, so the type to read it into is required.
+type ImportWeaveRequest struct {
+	ChannelID   uuid.UUID       `json:"channel_id" binding:"required"`
+	Format      string          `json:"format" binding:"required"` // json, markdown
+	Bundle      json.RawMessage `json:"bundle,omitempty"`
+	Markdown    string          `json:"markdown,omitempty"`
+	ContentType string          `json:"content_type,omitempty"`
+}
+
+func (r ImportWeaveRequest) Validate() error {
+	if r.ChannelID == uuid.Nil {
+		return fmt.Errorf("channel_id is required")
+	}
+	switch r.Format {
+	case "json":
+		if len(r.Bundle) == 0 {
+			return fmt.Errorf("bundle is required for json imports")
+		}
+	case "markdown":
+		if strings.TrimSpace(r.Markdown) == "" {
+			return fmt.Errorf("markdown is required for markdown imports")
+		}
+		if strings.TrimSpace(r.ContentType) == "" {
+			return fmt.Errorf("content_type is required for markdown imports")
+		}
+	default:
+		return fmt.Errorf("format must be json or markdown")
+	}
+	return nil
+}
+
+type UpdateWeaveRequest struct {
+	Title           *string                `json:"title"`
+	Description     *string                `json:"description"`
+	CoverImage      *string                `json:"cover_image"`
+	Content         *entities.WeaveContent `json:"content"`
+	ChangeLog       *string                `json:"change_log"`
+	ExpectedVersion *int                   `json:"expected_version"` // Alternative to the If-Match header
+	Tags            *[]string              `json:"tags"`             // Replaces every tag; an empty list removes them
+}
+
+func (r UpdateWeaveRequest) Validate() error {
+	if r.Title != nil {
+		if strings.TrimSpace(*r.Title) == "" {
+			return fmt.Errorf("title cannot be empty")
+		}
+		if len(*r.Title) > 200 {
+			return fmt.Errorf("title cannot exceed 200 characters")
+		}
+	}
+	if r.Content != nil && strings.TrimSpace(r.Content.Type) == "" {
+		return fmt.Errorf("content type is required")
+	}
+	if r.ExpectedVersion != nil && *r.ExpectedVersion < 1 {
+		return fmt.Errorf("expected_version must be a positive version number")
+	}
+	if r.Tags != nil && len(*r.Tags) > entities.MaxWeaveTags {
+		return fmt.Errorf("a weave can have at most %d tags", entities.MaxWeaveTags)
+	}
+	return nil
+}
+
+// Response DTOs
+type WeaveResponse struct {
+	ID              uuid.UUID             `json:"id"`
+	UserID          uuid.UUID             `json:"user_id"`
+	ChannelID       uuid.UUID             `json:"channel_id"`
+	Title           string                `json:"title"`
+	Description     *string               `json:"description"`
+	CoverImage      *string               `json:"cover_image"`
+	Content         entities.WeaveContent `json:"content"`
+	Version         int                   `json:"version"`
+	ParentWeaveID   *uuid.UUID            `json:"parent_weave_id"`
+	OriginalWeaveID *uuid.UUID            `json:"original_weave_id"`
+	UpstreamVersion *int                  `json:"upstream_version,omitempty"`
+	Status          entities.WeaveStatus  `json:"status"`
+	IsPublished     bool                  `json:"is_published"`
+	PublishedAt     *time.Time            `json:"published_at"`
+	PublishAt       *time.Time            `json:"publish_at,omitempty"` // Scheduled publication
+	IsFeatured      bool                  `json:"is_featured"`
+	IsTemplate      bool                  `json:"is_template"`
+	TemplateID      *uuid.UUID            `json:"template_id,omitempty"` // Template the weave was started from
+	TemplateVersion *int                  `json:"template_version,omitempty"`
+	Tags            []string              `json:"tags,omitempty"` // Only filled in for single weaves
+	ViewCount       int                   `json:"view_count"`
+	LikeCount       int                   `json:"like_count"`
+	ForkCount       int                   `json:"fork_count"`
+	CreatedAt       time.Time             `json:"created_at"`
+	UpdatedAt       time.Time             `json:"updated_at"`
+}
+
+// StaleVersionResponse is returned when an edit was based on an outdated version of a weave
+type StaleVersionResponse struct {
+	WeaveID         uuid.UUID `json:"weave_id"`
+	ExpectedVersion int       `json:"expected_version"`
+	CurrentVersion  int       `json:"current_version"`
+}
+
+type WeaveDetailResponse struct {
+	Weave   WeaveResponse `json:"weave"`
+	IsLiked bool          `json:"is_liked"`
+	CanEdit bool          `json:"can_edit"`
+}
+
+type WeaveVersionResponse struct {
+	ID          uuid.UUID             `json:"id"`
+	WeaveID     uuid.UUID             `json:"weave_id"`
+	UserID      uuid.UUID             `json:"user_id"`
+	Version     int                   `json:"version"`
+	Title       string                `json:"title"`
+	Content     entities.WeaveContent `json:"content"`
+	ChangeLog   *string               `json:"change_log"`
+	ContentDiff *diff.Diff            `json:"content_diff,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+}
+
+// WeaveVersionDiffResponse describes what changed between two versions of a weave
+type WeaveVersionDiffResponse struct {
+	WeaveID      uuid.UUID  `json:"weave_id"`
+	FromVersion  int        `json:"from_version"`
+	ToVersion    int        `json:"to_version"`
+	TitleChanged bool       `json:"title_changed"`
+	Diff         *diff.Diff `json:"diff"`
+}
+
+// UpstreamStatusResponse describes how far a fork has drifted from its parent weave
+type UpstreamStatusResponse struct {
+	WeaveID         uuid.UUID `json:"weave_id"`
+	ParentWeaveID   uuid.UUID `json:"parent_weave_id"`
+	Version         int       `json:"version"`
+	UpstreamVersion int       `json:"upstream_version"` // Parent version last incorporated
+	ParentVersion   int       `json:"parent_version"`
+	Ahead           int       `json:"ahead"`
+	Behind          int       `json:"behind"`
+	HasDiverged     bool      `json:"has_diverged"` // Fork content differs from the parent's current content
+}
+
+// SyncWeaveResponse reports the outcome of a sync from upstream.
+// When Synced is false, Conflicts lists the paths that need manual resolution.
+type SyncWeaveResponse struct {
+	WeaveID         uuid.UUID             `json:"weave_id"`
+	UpstreamVersion int                   `json:"upstream_version"`
+	Synced          bool                  `json:"synced"`
+	Version         *WeaveVersionResponse `json:"version,omitempty"`
+	Conflicts       []diff.Conflict       `json:"conflicts"`
+}
+
+// LineageNodeResponse is a single weave in a fork family tree
+type LineageNodeResponse struct {
+	ID            uuid.UUID              `json:"id"`
+	ParentWeaveID *uuid.UUID             `json:"parent_weave_id"`
+	Author        LineageAuthorResponse  `json:"author"`
+	Title         string                 `json:"title"`
+	Version       int                    `json:"version"`
+	LikeCount     int                    `json:"like_count"`
+	ForkCount     int                    `json:"fork_count"`
+	Status        entities.WeaveStatus   `json:"status"`
+	Depth         int                    `json:"depth"`
+	CreatedAt     time.Time              `json:"created_at"`
+	Children      []*LineageNodeResponse `json:"children,omitempty"`
+}
+
+type LineageAuthorResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+// WeaveLineageResponse holds the ancestor chain (root first) and the fork tree below a weave
+type WeaveLineageResponse struct {
+	Ancestors       []*LineageNodeResponse `json:"ancestors"`
+	Tree            *LineageNodeResponse   `json:"tree"`
+	DescendantCount int                    `json:"descendant_count"`
+	Truncated       bool                   `json:"truncated"`
+}
+
+// WeaveBlameResponse credits every field of a weave's current content to the version that last changed it
+type WeaveBlameResponse struct {
+	WeaveID uuid.UUID             `json:"weave_id"`
+	Version int                   `json:"version"`
+	Fields  []BlameFieldResponse  `json:"fields"`
+	Authors []BlameAuthorResponse `json:"authors"`
+}
+
+type BlameFieldResponse struct {
+	Path           string     `json:"path"`
+	Version        int        `json:"version"`
+	UserID         uuid.UUID  `json:"user_id"`
+	ContributionID *uuid.UUID `json:"contribution_id,omitempty"`
+	ChangedAt      time.Time  `json:"changed_at"`
+}
+
+type BlameAuthorResponse struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Username   string    `json:"username"`
+	FieldCount int       `json:"field_count"`
+}
+
+// ExportedWeaveResponse is a rendered export, sent as a file download rather than JSON
+type ExportedWeaveResponse struct {
+	Filename    string
+	ContentType string
+	Body        []byte
+}
+
+// ImportWeaveResponse holds the imported weave, or the ID of the background
+// import for large bundles; the importer is notified once it finishes
+type ImportWeaveResponse struct {
+	Status   string         `json:"status"` // imported, queued
+	Weave    *WeaveResponse `json:"weave,omitempty"`
+	ImportID *uuid.UUID     `json:"import_id,omitempty"`
+}
+
+type PaginatedWeavesResponse struct {
+	Weaves []WeaveResponse `json:"weaves"`
+	Page   int             `json:"page"`
+	Limit  int             `json:"limit"`
+	Total  int             `json:"total"`
+}
+
+// TrashedWeaveResponse is a deleted weave with the time it will be removed permanently
+type TrashedWeaveResponse struct {
+	WeaveResponse
+	TrashedAt *time.Time `json:"trashed_at"`
+	PurgeAt   *time.Time `json:"purge_at"`
+}
+
+type PaginatedTrashResponse struct {
+	Weaves []TrashedWeaveResponse `json:"weaves"`
+	Page   int                    `json:"page"`
+	Limit  int                    `json:"limit"`
+	Total  int                    `json:"total"`
+}
+
+// Conversion functions
+func WeaveToResponse(weave *entities.Weave) *WeaveResponse {
+	return &WeaveResponse{
+		ID:              weave.ID,
+		UserID:          weave.UserID,
+		ChannelID:       weave.ChannelID,
+		Title:           weave.Title,
+		Description:     weave.Description,
+		CoverImage:      weave.CoverImage,
+		Content:         weave.Content,
+		Version:         weave.Version,
+		ParentWeaveID:   weave.ParentWeaveID,
+		OriginalWeaveID: weave.OriginalWeaveID,
+		UpstreamVersion: weave.UpstreamVersion,
+		Status:          weave.Status,
+		IsPublished:     weave.IsPublished(),
+		PublishedAt:     weave.PublishedAt,
+		PublishAt:       weave.PublishAt,
+		IsFeatured:      weave.IsFeatured,
+		IsTemplate:      weave.IsTemplate,
+		TemplateID:      weave.TemplateID,
+		TemplateVersion: weave.TemplateVersion,
+		Tags:            weave.Tags,
+		ViewCount:       weave.ViewCount,
+		LikeCount:       weave.LikeCount,
+		ForkCount:       weave.ForkCount,
+		CreatedAt:       weave.CreatedAt,
+		UpdatedAt:       weave.UpdatedAt,
+	}
+}
+
+func WeavesToResponse(weaves []*entities.Weave) []WeaveResponse {
+	responses := make([]WeaveResponse, len(weaves))
+	for i, weave := range weaves {
+		responses[i] = *WeaveToResponse(weave)
+	}
+	return responses
+}
+
+func TrashedWeavesToResponse(weaves []*entities.Weave, retention time.Duration) []TrashedWeaveResponse {
+	responses := make([]TrashedWeaveResponse, len(weaves))
+	for i, weave := range weaves {
+		responses[i] = TrashedWeaveResponse{
+			WeaveResponse: *WeaveToResponse(weave),
+			TrashedAt:     weave.TrashedAt,
+		}
+		if weave.TrashedAt != nil {
+			purgeAt := weave.TrashedAt.Add(retention)
+			responses[i].PurgeAt = &purgeAt
+		}
+	}
+	return responses
+}
+
+func WeaveVersionToResponse(version *entities.WeaveVersion) *WeaveVersionResponse {
+	return &WeaveVersionResponse{
+		ID:          version.ID,
+		WeaveID:     version.WeaveID,
+		UserID:      version.UserID,
+		Version:     version.Version,
+		Title:       version.Title,
+		Content:     version.Content,
+		ChangeLog:   version.ChangeLog,
+		ContentDiff: version.ContentDiff,
+		CreatedAt:   version.CreatedAt,
+	}
+}
+
+func WeaveVersionsToResponse(versions []*entities.WeaveVersion) []WeaveVersionResponse {
+	responses := make([]WeaveVersionResponse, len(versions))
+	for i, version := range versions {
+		responses[i] = *WeaveVersionToResponse(version)
+	}
+	return responses
+}
+
+func WeaveBlameToResponse(blame *entities.WeaveBlame) *WeaveBlameResponse {
+	response := &WeaveBlameResponse{
+		WeaveID: blame.WeaveID,
+		Version: blame.Version,
+		Fields:  make([]BlameFieldResponse, len(blame.Fields)),
+		Authors: make([]BlameAuthorResponse, len(blame.Authors)),
+	}
+	for i, field := range blame.Fields {
+		response.Fields[i] = BlameFieldResponse{
+			Path:           field.Path,
+			Version:        field.Version,
+			UserID:         field.UserID,
+			ContributionID: field.ContributionID,
+			ChangedAt:      field.ChangedAt,
+		}
+	}
+	for i, author := range blame.Authors {
+		response.Authors[i] = BlameAuthorResponse{
+			UserID:     author.UserID,
+			Username:   author.Username,
+			FieldCount: author.FieldCount,
+		}
+	}
+	return response
+}
+
+func LineageNodeToResponse(node *entities.LineageNode) *LineageNodeResponse {
+	return &LineageNodeResponse{
+		ID:            node.WeaveID,
+		ParentWeaveID: node.ParentWeaveID,
+		Author: LineageAuthorResponse{
+			ID:       node.UserID,
+			Username: node.Username,
+		},
+		Title:     node.Title,
+		Version:   node.Version,
+		LikeCount: node.LikeCount,
+		ForkCount: node.ForkCount,
+		Status:    node.Status,
+		Depth:     node.Depth,
+		CreatedAt: node.CreatedAt,
+	}
+}
+
+// ContentTypeResponse describes a content type and the schema its data must follow
+type ContentTypeResponse struct {
+	Name        string               `json:"name"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	Version     int                  `json:"version"` // Schema version new content is written against
+	Schema      *contenttypes.Schema `json:"schema"`
+}
+
+// InvalidContentResponse lists every problem found in submitted weave content
+type InvalidContentResponse = contenttypes.InvalidContent
+
+func ContentTypeToResponse(contentType *contenttypes.ContentType) *ContentTypeResponse {
+	return &ContentTypeResponse{
+		Name:        contentType.Name,
+		Title:       contentType.Title,
+		Description: contentType.Description,
+		Version:     contentType.Version,
+		Schema:      contentType.Schema,
+	}
+}
@@ -0,0 +1,9 @@
+package queries
+
+import "github.com/google/uuid"
+
+// ListCollaboratorsQuery represents the query to list who holds a role on a weave
+type ListCollaboratorsQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id"`
+}
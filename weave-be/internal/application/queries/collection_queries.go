@@ -0,0 +1,26 @@
+package queries
+
+import "github.com/google/uuid"
+
+// GetCollectionQuery represents the query to get a single collection
+// ViewerID is nil for anonymous requests
+type GetCollectionQuery struct {
+	CollectionID uuid.UUID  `json:"collection_id" validate:"required"`
+	ViewerID     *uuid.UUID `json:"viewer_id,omitempty"`
+}
+
+// GetCollectionWeavesQuery represents the query to list the weaves of a collection
+type GetCollectionWeavesQuery struct {
+	CollectionID uuid.UUID  `json:"collection_id" validate:"required"`
+	ViewerID     *uuid.UUID `json:"viewer_id,omitempty"`
+	Page         int        `json:"page" validate:"min=1"`
+	Limit        int        `json:"limit" validate:"min=1,max=100"`
+}
+
+// ListUserCollectionsQuery represents the query to list a user's collections
+type ListUserCollectionsQuery struct {
+	UserID   uuid.UUID  `json:"user_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+	Page     int        `json:"page" validate:"min=1"`
+	Limit    int        `json:"limit" validate:"min=1,max=100"`
+}
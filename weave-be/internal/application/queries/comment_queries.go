@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// ListCommentsQuery represents the query to list the lab comments of a weave
+type ListCommentsQuery struct {
+	WeaveID    uuid.UUID             `json:"weave_id" validate:"required"`
+	ViewerID   uuid.UUID             `json:"viewer_id" validate:"required"`
+	AnchorPath *string               `json:"anchor_path,omitempty"` // Comments anchored at or below this path
+	Type       *entities.CommentType `json:"type,omitempty"`
+	IsResolved *bool                 `json:"is_resolved,omitempty"`
+	IsOutdated *bool                 `json:"is_outdated,omitempty"`
+	Page       int                   `json:"page" validate:"min=1"`
+	Limit      int                   `json:"limit" validate:"min=1,max=100"`
+}
+
+// GetCommentThreadQuery represents the query to get a comment with its replies
+type GetCommentThreadQuery struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	ViewerID  uuid.UUID `json:"viewer_id" validate:"required"`
+	Depth     int       `json:"depth"` // Levels of replies to include, defaulted and capped by the use case
+}
+
+// GetCommentHistoryQuery represents the query to get the edit history of a comment
+type GetCommentHistoryQuery struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	ViewerID  uuid.UUID `json:"viewer_id" validate:"required"`
+}
+
+// GetIssueSummaryQuery represents the query to summarize the open issues of a weave
+type GetIssueSummaryQuery struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
@@ -0,0 +1,21 @@
+package queries
+
+import "github.com/google/uuid"
+
+// GetContributionConflictsQuery represents the query to check a contribution against the latest weave version
+type GetContributionConflictsQuery struct {
+	ContributionID uuid.UUID `json:"contribution_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetContributionReviewsQuery represents the query to get the reviews of a contribution and whether it may be merged
+type GetContributionReviewsQuery struct {
+	ContributionID uuid.UUID `json:"contribution_id" validate:"required"`
+	ViewerID       uuid.UUID `json:"viewer_id" validate:"required"`
+}
+
+// GetMergePolicyQuery represents the query to get the merge policy of a weave
+type GetMergePolicyQuery struct {
+	WeaveID  uuid.UUID `json:"weave_id" validate:"required"`
+	ViewerID uuid.UUID `json:"viewer_id" validate:"required"`
+}
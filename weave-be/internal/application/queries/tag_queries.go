@@ -0,0 +1,20 @@
+package queries
+
+// AutocompleteTagsQuery represents the query to suggest tags while typing
+type AutocompleteTagsQuery struct {
+	Prefix string `json:"prefix"`
+	Limit  int    `json:"limit"` // 0 means the default`
+}
+
+// GetTagWeavesQuery represents the query to list the published weaves carrying a tag
+type GetTagWeavesQuery struct {
+	Name  string `json:"name" validate:"required"`
+	Page  int    `json:"page" validate:"min=1"`
+	Limit int    `json:"limit" validate:"min=1,max=100"`
+}
+
+// GetRelatedTagsQuery represents the query to suggest tags used alongside a tag
+type GetRelatedTagsQuery struct {
+	Name  string `json:"name" validate:"required"`
+	Limit int    `json:"limit"` // 0 means the default`
+}
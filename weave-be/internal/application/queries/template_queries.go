@@ -0,0 +1,15 @@
+package queries
+
+import "github.com/google/uuid"
+
+// ListTemplatesQuery represents the query to browse published templates
+type ListTemplatesQuery struct {
+	ChannelID *uuid.UUID `json:"channel_id,omitempty"` // Templates created in one channel only
+	Page      int        `json:"page" validate:"min=1"`
+	Limit     int        `json:"limit" validate:"min=1,max=100"`
+}
+
+// ListChannelTemplatesQuery represents the query to get the templates a channel pins
+type ListChannelTemplatesQuery struct {
+	ChannelID uuid.UUID `json:"channel_id" validate:"required"`
+}
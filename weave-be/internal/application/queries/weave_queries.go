@@ -0,0 +1,101 @@
+package queries
+
+import "github.com/google/uuid"
+
+// GetWeaveQuery represents the query to get a single weave
+// ViewerID is nil for anonymous requests
+type GetWeaveQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+}
+
+// ListWeavesQuery represents the query to list public weaves
+type ListWeavesQuery struct {
+	Page  int `json:"page" validate:"min=1"`
+	Limit int `json:"limit" validate:"min=1,max=100"`
+}
+
+// GetTrendingWeavesQuery represents the query to get trending weaves
+type GetTrendingWeavesQuery struct {
+	Timeframe string `json:"timeframe"` // hour, day, week, month
+	Page      int    `json:"page" validate:"min=1"`
+	Limit     int    `json:"limit" validate:"min=1,max=100"`
+}
+
+// SearchWeavesQuery represents the query to search published weaves
+type SearchWeavesQuery struct {
+	Query     string     `json:"query"`
+	ChannelID *uuid.UUID `json:"channel_id,omitempty"`
+	Page      int        `json:"page" validate:"min=1"`
+	Limit     int        `json:"limit" validate:"min=1,max=100"`
+}
+
+// GetWeaveForksQuery represents the query to get forks of a weave
+type GetWeaveForksQuery struct {
+	WeaveID uuid.UUID `json:"weave_id" validate:"required"`
+	Page    int       `json:"page" validate:"min=1"`
+	Limit   int       `json:"limit" validate:"min=1,max=100"`
+}
+
+// GetWeaveVersionsQuery represents the query to get the version history of a weave
+type GetWeaveVersionsQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+}
+
+// GetWeaveVersionQuery represents the query to get a specific version of a weave
+type GetWeaveVersionQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	Version  int        `json:"version" validate:"min=1"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+}
+
+// GetWeaveVersionDiffQuery represents the query to compare two versions of a weave
+type GetWeaveVersionDiffQuery struct {
+	WeaveID     uuid.UUID  `json:"weave_id" validate:"required"`
+	FromVersion int        `json:"from_version" validate:"min=1"`
+	ToVersion   int        `json:"to_version" validate:"min=1"`
+	ViewerID    *uuid.UUID `json:"viewer_id,omitempty"`
+}
+
+// GetWeaveLineageQuery represents the query to get the fork family tree of a weave
+type GetWeaveLineageQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+	Depth    int        `json:"depth"` // Maximum fork generations below the weave
+	Limit    int        `json:"limit"` // Maximum number of descendants
+}
+
+// GetUpstreamStatusQuery represents the query to compare a fork with its parent weave
+type GetUpstreamStatusQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+}
+
+// GetUserWeavesQuery represents the query to get weaves belonging to a user (drafts, liked, trash)
+type GetUserWeavesQuery struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+	Page   int       `json:"page" validate:"min=1"`
+	Limit  int       `json:"limit" validate:"min=1,max=100"`
+}
+
+// GetContentTypesQuery represents the query to list content type schemas.
+// With a ChannelID only the types accepted by that channel are listed.
+type GetContentTypesQuery struct {
+	ChannelID *uuid.UUID `json:"channel_id,omitempty"`
+}
+
+// GetWeaveBlameQuery represents the query to attribute a weave's content to its authors
+type GetWeaveBlameQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+}
+
+// ExportWeaveQuery represents the query to export a weave as a document or bundle.
+// Version selects an earlier version for document formats; nil exports the current one.
+type ExportWeaveQuery struct {
+	WeaveID  uuid.UUID  `json:"weave_id" validate:"required"`
+	ViewerID *uuid.UUID `json:"viewer_id,omitempty"`
+	Format   string     `json:"format"` // markdown, html, json
+	Version  *int       `json:"version,omitempty"`
+}
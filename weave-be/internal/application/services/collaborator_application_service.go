@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/collaborator"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+)
+
+// CollaboratorApplicationService orchestrates weave collaborator use cases
+type CollaboratorApplicationService struct {
+	// Command Use Cases
+	inviteCollaboratorUC  *collaborator.InviteCollaboratorUseCase
+	acceptCollaborationUC *collaborator.AcceptCollaborationUseCase
+	removeCollaboratorUC  *collaborator.RemoveCollaboratorUseCase
+
+	// Query Use Cases
+	listCollaboratorsUC *collaborator.ListCollaboratorsUseCase
+}
+
+// NewCollaboratorApplicationService creates a new CollaboratorApplicationService with all use cases
+func NewCollaboratorApplicationService(
+	collaboratorRepo repositories.CollaboratorRepository,
+	weaveRepo repositories.WeaveRepository,
+	userRepo repositories.UserRepository,
+	notificationService services.NotificationService,
+) *CollaboratorApplicationService {
+	return &CollaboratorApplicationService{
+		inviteCollaboratorUC:  collaborator.NewInviteCollaboratorUseCase(weaveRepo, collaboratorRepo, userRepo, notificationService),
+		acceptCollaborationUC: collaborator.NewAcceptCollaborationUseCase(weaveRepo, collaboratorRepo),
+		removeCollaboratorUC:  collaborator.NewRemoveCollaboratorUseCase(weaveRepo, collaboratorRepo),
+		listCollaboratorsUC:   collaborator.NewListCollaboratorsUseCase(weaveRepo, collaboratorRepo, userRepo),
+	}
+}
+
+// InviteCollaborator invites a user to take a role on a weave
+func (s *CollaboratorApplicationService) InviteCollaborator(ctx context.Context, weaveID, userID uuid.UUID, req dto.InviteCollaboratorRequest) (*dto.CollaboratorResponse, error) {
+	cmd := commands.InviteCollaboratorCommand{
+		WeaveID:   weaveID,
+		UserID:    userID,
+		InviteeID: req.UserID,
+		Role:      req.Role,
+	}
+
+	return s.inviteCollaboratorUC.Execute(ctx, cmd)
+}
+
+// AcceptCollaboration accepts the user's pending invitation to a weave
+func (s *CollaboratorApplicationService) AcceptCollaboration(ctx context.Context, weaveID, userID uuid.UUID) (*dto.CollaboratorResponse, error) {
+	cmd := commands.AcceptCollaborationCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.acceptCollaborationUC.Execute(ctx, cmd)
+}
+
+// RemoveCollaborator revokes a collaborator's role on a weave
+func (s *CollaboratorApplicationService) RemoveCollaborator(ctx context.Context, weaveID, userID, collaboratorID uuid.UUID) error {
+	cmd := commands.RemoveCollaboratorCommand{
+		WeaveID:        weaveID,
+		UserID:         userID,
+		CollaboratorID: collaboratorID,
+	}
+
+	return s.removeCollaboratorUC.Execute(ctx, cmd)
+}
+
+// ListCollaborators lists who holds a role on a weave
+func (s *CollaboratorApplicationService) ListCollaborators(ctx context.Context, weaveID uuid.UUID, viewerID *uuid.UUID) ([]*dto.CollaboratorResponse, error) {
+	query := queries.ListCollaboratorsQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+	}
+
+	return s.listCollaboratorsUC.Execute(ctx, query)
+}
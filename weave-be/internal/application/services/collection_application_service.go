@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/collection"
+	"weave-be/internal/domain/repositories"
+)
+
+// CollectionApplicationService orchestrates weave collection use cases
+type CollectionApplicationService struct {
+	// Command Use Cases
+	createCollectionUC      *collection.CreateCollectionUseCase
+	updateCollectionUC      *collection.UpdateCollectionUseCase
+	deleteCollectionUC      *collection.DeleteCollectionUseCase
+	addCollectionWeaveUC    *collection.AddCollectionWeaveUseCase
+	removeCollectionWeaveUC *collection.RemoveCollectionWeaveUseCase
+	reorderCollectionUC     *collection.ReorderCollectionUseCase
+
+	// Query Use Cases
+	getCollectionUC       *collection.GetCollectionUseCase
+	getCollectionWeavesUC *collection.GetCollectionWeavesUseCase
+	listUserCollectionsUC *collection.ListUserCollectionsUseCase
+}
+
+// NewCollectionApplicationService creates a new CollectionApplicationService with all use cases
+func NewCollectionApplicationService(
+	collectionRepo repositories.CollectionRepository,
+	weaveRepo repositories.WeaveRepository,
+	userRepo repositories.UserRepository,
+) *CollectionApplicationService {
+	return &CollectionApplicationService{
+		createCollectionUC:      collection.NewCreateCollectionUseCase(collectionRepo),
+		updateCollectionUC:      collection.NewUpdateCollectionUseCase(collectionRepo),
+		deleteCollectionUC:      collection.NewDeleteCollectionUseCase(collectionRepo),
+		addCollectionWeaveUC:    collection.NewAddCollectionWeaveUseCase(collectionRepo, weaveRepo),
+		removeCollectionWeaveUC: collection.NewRemoveCollectionWeaveUseCase(collectionRepo),
+		reorderCollectionUC:     collection.NewReorderCollectionUseCase(collectionRepo),
+		getCollectionUC:         collection.NewGetCollectionUseCase(collectionRepo),
+		getCollectionWeavesUC:   collection.NewGetCollectionWeavesUseCase(collectionRepo),
+		listUserCollectionsUC:   collection.NewListUserCollectionsUseCase(collectionRepo, userRepo),
+	}
+}
+
+// CreateCollection creates an empty collection
+func (s *CollectionApplicationService) CreateCollection(ctx context.Context, userID uuid.UUID, req dto.CreateCollectionRequest) (*dto.CollectionResponse, error) {
+	cmd := commands.CreateCollectionCommand{
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+	}
+
+	return s.createCollectionUC.Execute(ctx, cmd)
+}
+
+// UpdateCollection renames a collection or changes its description or visibility
+func (s *CollectionApplicationService) UpdateCollection(ctx context.Context, collectionID, userID uuid.UUID, req dto.UpdateCollectionRequest) (*dto.CollectionResponse, error) {
+	cmd := commands.UpdateCollectionCommand{
+		CollectionID: collectionID,
+		UserID:       userID,
+		Name:         req.Name,
+		Description:  req.Description,
+		IsPublic:     req.IsPublic,
+	}
+
+	return s.updateCollectionUC.Execute(ctx, cmd)
+}
+
+// DeleteCollection deletes a collection
+func (s *CollectionApplicationService) DeleteCollection(ctx context.Context, collectionID, userID uuid.UUID) error {
+	cmd := commands.DeleteCollectionCommand{
+		CollectionID: collectionID,
+		UserID:       userID,
+	}
+
+	return s.deleteCollectionUC.Execute(ctx, cmd)
+}
+
+// AddWeave adds a weave to a collection
+func (s *CollectionApplicationService) AddWeave(ctx context.Context, collectionID, userID uuid.UUID, req dto.AddCollectionWeaveRequest) error {
+	cmd := commands.AddCollectionWeaveCommand{
+		CollectionID: collectionID,
+		UserID:       userID,
+		WeaveID:      req.WeaveID,
+		Position:     req.Position,
+	}
+
+	return s.addCollectionWeaveUC.Execute(ctx, cmd)
+}
+
+// RemoveWeave takes a weave out of a collection
+func (s *CollectionApplicationService) RemoveWeave(ctx context.Context, collectionID, userID, weaveID uuid.UUID) error {
+	cmd := commands.RemoveCollectionWeaveCommand{
+		CollectionID: collectionID,
+		UserID:       userID,
+		WeaveID:      weaveID,
+	}
+
+	return s.removeCollectionWeaveUC.Execute(ctx, cmd)
+}
+
+// ReorderWeaves changes the order of the weaves in a collection
+func (s *CollectionApplicationService) ReorderWeaves(ctx context.Context, collectionID, userID uuid.UUID, req dto.ReorderCollectionRequest) error {
+	cmd := commands.ReorderCollectionCommand{
+		CollectionID: collectionID,
+		UserID:       userID,
+		WeaveIDs:     req.WeaveIDs,
+	}
+
+	return s.reorderCollectionUC.Execute(ctx, cmd)
+}
+
+// GetCollection retrieves a collection
+func (s *CollectionApplicationService) GetCollection(ctx context.Context, collectionID uuid.UUID, viewerID *uuid.UUID) (*dto.CollectionResponse, error) {
+	query := queries.GetCollectionQuery{
+		CollectionID: collectionID,
+		ViewerID:     viewerID,
+	}
+
+	return s.getCollectionUC.Execute(ctx, query)
+}
+
+// GetCollectionWeaves lists the weaves of a collection in order
+func (s *CollectionApplicationService) GetCollectionWeaves(ctx context.Context, collectionID uuid.UUID, viewerID *uuid.UUID, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.GetCollectionWeavesQuery{
+		CollectionID: collectionID,
+		ViewerID:     viewerID,
+		Page:         page,
+		Limit:        limit,
+	}
+
+	return s.getCollectionWeavesUC.Execute(ctx, query)
+}
+
+// ListUserCollections lists a user's collections
+func (s *CollectionApplicationService) ListUserCollections(ctx context.Context, userID uuid.UUID, viewerID *uuid.UUID, page, limit int) (*dto.PaginatedCollectionsResponse, error) {
+	query := queries.ListUserCollectionsQuery{
+		UserID:   userID,
+		ViewerID: viewerID,
+		Page:     page,
+		Limit:    limit,
+	}
+
+	return s.listUserCollectionsUC.Execute(ctx, query)
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/comment"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+)
+
+// CommentApplicationService orchestrates lab comment use cases
+type CommentApplicationService struct {
+	// Command Use Cases
+	createCommentUC  *comment.CreateCommentUseCase
+	editCommentUC    *comment.EditCommentUseCase
+	resolveCommentUC *comment.ResolveCommentUseCase
+	reopenCommentUC  *comment.ReopenCommentUseCase
+	likeCommentUC    *comment.LikeCommentUseCase
+	unlikeCommentUC  *comment.UnlikeCommentUseCase
+
+	// Query Use Cases
+	listCommentsUC      *comment.ListCommentsUseCase
+	getCommentThreadUC  *comment.GetCommentThreadUseCase
+	getCommentHistoryUC *comment.GetCommentHistoryUseCase
+	getIssueSummaryUC   *comment.GetIssueSummaryUseCase
+}
+
+// NewCommentApplicationService creates a new CommentApplicationService with all use cases
+func NewCommentApplicationService(
+	commentRepo repositories.CommentRepository,
+	weaveRepo repositories.WeaveRepository,
+) *CommentApplicationService {
+	return &CommentApplicationService{
+		createCommentUC:     comment.NewCreateCommentUseCase(commentRepo, weaveRepo),
+		editCommentUC:       comment.NewEditCommentUseCase(commentRepo, weaveRepo),
+		resolveCommentUC:    comment.NewResolveCommentUseCase(commentRepo, weaveRepo),
+		reopenCommentUC:     comment.NewReopenCommentUseCase(commentRepo, weaveRepo),
+		likeCommentUC:       comment.NewLikeCommentUseCase(commentRepo, weaveRepo),
+		unlikeCommentUC:     comment.NewUnlikeCommentUseCase(commentRepo),
+		listCommentsUC:      comment.NewListCommentsUseCase(commentRepo, weaveRepo),
+		getCommentThreadUC:  comment.NewGetCommentThreadUseCase(commentRepo, weaveRepo),
+		getCommentHistoryUC: comment.NewGetCommentHistoryUseCase(commentRepo, weaveRepo),
+		getIssueSummaryUC:   comment.NewGetIssueSummaryUseCase(commentRepo, weaveRepo),
+	}
+}
+
+// CreateComment adds a lab comment to a weave, optionally anchored in its content
+func (s *CommentApplicationService) CreateComment(ctx context.Context, weaveID, userID uuid.UUID, req dto.CreateCommentRequest) (*dto.CommentResponse, error) {
+	commentType := req.Type
+	if commentType == "" {
+		commentType = entities.CommentTypeGeneral
+	}
+
+	cmd := commands.CreateCommentCommand{
+		WeaveID:         weaveID,
+		UserID:          userID,
+		ParentCommentID: req.ParentCommentID,
+		Type:            commentType,
+		Content:         req.Content,
+	}
+	if req.Anchor != nil {
+		cmd.Anchor = &diff.Anchor{
+			Path:  req.Anchor.Path,
+			Start: req.Anchor.Start,
+			End:   req.Anchor.End,
+		}
+	}
+
+	return s.createCommentUC.Execute(ctx, cmd)
+}
+
+// EditComment changes the content of the user's comment
+func (s *CommentApplicationService) EditComment(ctx context.Context, commentID, userID uuid.UUID, req dto.EditCommentRequest) (*dto.CommentResponse, error) {
+	cmd := commands.EditCommentCommand{
+		CommentID: commentID,
+		UserID:    userID,
+		Content:   req.Content,
+	}
+	return s.editCommentUC.Execute(ctx, cmd)
+}
+
+// ResolveComment marks a thread as resolved
+func (s *CommentApplicationService) ResolveComment(ctx context.Context, commentID, userID uuid.UUID) (*dto.CommentResponse, error) {
+	cmd := commands.ResolveCommentCommand{
+		CommentID: commentID,
+		UserID:    userID,
+	}
+	return s.resolveCommentUC.Execute(ctx, cmd)
+}
+
+// ReopenComment reopens a resolved thread
+func (s *CommentApplicationService) ReopenComment(ctx context.Context, commentID, userID uuid.UUID) (*dto.CommentResponse, error) {
+	cmd := commands.ReopenCommentCommand{
+		CommentID: commentID,
+		UserID:    userID,
+	}
+	return s.reopenCommentUC.Execute(ctx, cmd)
+}
+
+// LikeComment likes a comment
+func (s *CommentApplicationService) LikeComment(ctx context.Context, commentID, userID uuid.UUID) error {
+	cmd := commands.LikeCommentCommand{
+		CommentID: commentID,
+		UserID:    userID,
+	}
+	return s.likeCommentUC.Execute(ctx, cmd)
+}
+
+// UnlikeComment removes the user's like from a comment
+func (s *CommentApplicationService) UnlikeComment(ctx context.Context, commentID, userID uuid.UUID) error {
+	cmd := commands.UnlikeCommentCommand{
+		CommentID: commentID,
+		UserID:    userID,
+	}
+	return s.unlikeCommentUC.Execute(ctx, cmd)
+}
+
+// ListComments lists the lab comments of a weave matching the filter
+func (s *CommentApplicationService) ListComments(ctx context.Context, weaveID, viewerID uuid.UUID, filter dto.CommentFilterRequest, page, limit int) (*dto.PaginatedCommentsResponse, error) {
+	query := queries.ListCommentsQuery{
+		WeaveID:    weaveID,
+		ViewerID:   viewerID,
+		AnchorPath: filter.AnchorPath,
+		IsResolved: filter.IsResolved,
+		IsOutdated: filter.IsOutdated,
+		Page:       page,
+		Limit:      limit,
+	}
+	if filter.Type != nil {
+		commentType := entities.CommentType(*filter.Type)
+		query.Type = &commentType
+	}
+
+	return s.listCommentsUC.Execute(ctx, query)
+}
+
+// GetCommentThread returns a comment with its replies down to depth levels
+func (s *CommentApplicationService) GetCommentThread(ctx context.Context, commentID, viewerID uuid.UUID, depth int) (*dto.CommentThreadResponse, error) {
+	query := queries.GetCommentThreadQuery{
+		CommentID: commentID,
+		ViewerID:  viewerID,
+		Depth:     depth,
+	}
+	return s.getCommentThreadUC.Execute(ctx, query)
+}
+
+// GetCommentHistory returns the earlier contents of a comment
+func (s *CommentApplicationService) GetCommentHistory(ctx context.Context, commentID, viewerID uuid.UUID) ([]*dto.CommentRevisionResponse, error) {
+	query := queries.GetCommentHistoryQuery{
+		CommentID: commentID,
+		ViewerID:  viewerID,
+	}
+	return s.getCommentHistoryUC.Execute(ctx, query)
+}
+
+// GetIssueSummary summarizes the open threads of the owner's weave
+func (s *CommentApplicationService) GetIssueSummary(ctx context.Context, weaveID, userID uuid.UUID) (*dto.IssueSummaryResponse, error) {
+	query := queries.GetIssueSummaryQuery{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+	return s.getIssueSummaryUC.Execute(ctx, query)
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/contribution"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+)
+
+// ContributionApplicationService orchestrates contribution-related use cases
+type ContributionApplicationService struct {
+	// Command Use Cases
+	createMergeRequestUC *contribution.CreateMergeRequestUseCase
+	createSuggestionUC   *contribution.CreateSuggestionUseCase
+	acceptSuggestionUC   *contribution.AcceptSuggestionUseCase
+	mergeContributionUC  *contribution.MergeContributionUseCase
+	resolveConflictsUC   *contribution.ResolveContributionConflictsUseCase
+	reviewUC             *contribution.ReviewContributionUseCase
+	assignReviewerUC     *contribution.AssignReviewerUseCase
+	voteUC               *contribution.VoteContributionUseCase
+	removeVoteUC         *contribution.RemoveContributionVoteUseCase
+	updateMergePolicyUC  *contribution.UpdateMergePolicyUseCase
+
+	// Query Use Cases
+	getConflictsUC   *contribution.GetContributionConflictsUseCase
+	getReviewsUC     *contribution.GetContributionReviewsUseCase
+	getMergePolicyUC *contribution.GetMergePolicyUseCase
+}
+
+// NewContributionApplicationService creates a new ContributionApplicationService with all use cases
+func NewContributionApplicationService(
+	contributionRepo repositories.ContributionRepository,
+	weaveRepo repositories.WeaveRepository,
+	mergePolicyRepo repositories.MergePolicyRepository,
+	registry *contenttypes.Registry,
+	notificationService services.NotificationService,
+) *ContributionApplicationService {
+	return &ContributionApplicationService{
+		createMergeRequestUC: contribution.NewCreateMergeRequestUseCase(contributionRepo, weaveRepo, notificationService),
+		createSuggestionUC:   contribution.NewCreateSuggestionUseCase(contributionRepo, weaveRepo, registry, notificationService),
+		acceptSuggestionUC:   contribution.NewAcceptSuggestionUseCase(contributionRepo, weaveRepo, mergePolicyRepo, registry, notificationService),
+		mergeContributionUC:  contribution.NewMergeContributionUseCase(contributionRepo, weaveRepo, mergePolicyRepo, registry),
+		resolveConflictsUC:   contribution.NewResolveContributionConflictsUseCase(contributionRepo, weaveRepo, registry),
+		reviewUC:             contribution.NewReviewContributionUseCase(contributionRepo, weaveRepo, notificationService),
+		assignReviewerUC:     contribution.NewAssignReviewerUseCase(contributionRepo, weaveRepo, notificationService),
+		voteUC:               contribution.NewVoteContributionUseCase(contributionRepo, weaveRepo),
+		removeVoteUC:         contribution.NewRemoveContributionVoteUseCase(contributionRepo, weaveRepo),
+		updateMergePolicyUC:  contribution.NewUpdateMergePolicyUseCase(mergePolicyRepo, weaveRepo),
+		getConflictsUC:       contribution.NewGetContributionConflictsUseCase(contributionRepo, weaveRepo),
+		getReviewsUC:         contribution.NewGetContributionReviewsUseCase(contributionRepo, weaveRepo, mergePolicyRepo),
+		getMergePolicyUC:     contribution.NewGetMergePolicyUseCase(mergePolicyRepo, weaveRepo),
+	}
+}
+
+// CreateMergeRequest proposes a fork back to its parent weave
+func (s *ContributionApplicationService) CreateMergeRequest(ctx context.Context, weaveID, userID uuid.UUID, req dto.CreateMergeRequestRequest) (*dto.ContributionResponse, error) {
+	cmd := commands.CreateMergeRequestCommand{
+		WeaveID:     weaveID,
+		UserID:      userID,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+
+	return s.createMergeRequestUC.Execute(ctx, cmd)
+}
+
+// CreateSuggestion suggests replacing a single value in a weave's content
+func (s *ContributionApplicationService) CreateSuggestion(ctx context.Context, weaveID, userID uuid.UUID, req dto.CreateSuggestionRequest) (*dto.ContributionResponse, error) {
+	cmd := commands.CreateSuggestionCommand{
+		WeaveID:     weaveID,
+		UserID:      userID,
+		Path:        req.Path,
+		Value:       req.Value,
+		Title:       strings.TrimSpace(req.Title),
+		Description: req.Description,
+	}
+
+	return s.createSuggestionUC.Execute(ctx, cmd)
+}
+
+// AcceptSuggestion approves a suggestion and applies it as a new weave version
+func (s *ContributionApplicationService) AcceptSuggestion(ctx context.Context, contributionID, userID uuid.UUID) (*dto.MergeContributionResponse, error) {
+	cmd := commands.AcceptSuggestionCommand{
+		ContributionID: contributionID,
+		UserID:         userID,
+	}
+
+	return s.acceptSuggestionUC.Execute(ctx, cmd)
+}
+
+// MergeContribution merges a contribution into its weave
+func (s *ContributionApplicationService) MergeContribution(ctx context.Context, contributionID, userID uuid.UUID) (*dto.MergeContributionResponse, error) {
+	cmd := commands.MergeContributionCommand{
+		ContributionID: contributionID,
+		UserID:         userID,
+	}
+
+	return s.mergeContributionUC.Execute(ctx, cmd)
+}
+
+// GetContributionConflicts lists the conflicts between a contribution and the latest weave version
+func (s *ContributionApplicationService) GetContributionConflicts(ctx context.Context, contributionID, userID uuid.UUID) (*dto.ContributionConflictsResponse, error) {
+	query := queries.GetContributionConflictsQuery{
+		ContributionID: contributionID,
+		UserID:         userID,
+	}
+
+	return s.getConflictsUC.Execute(ctx, query)
+}
+
+// ResolveContributionConflicts rebases a contribution onto the latest weave version
+func (s *ContributionApplicationService) ResolveContributionConflicts(ctx context.Context, contributionID, userID uuid.UUID, req dto.ResolveConflictsRequest) (*dto.ContributionResponse, error) {
+	cmd := commands.ResolveContributionConflictsCommand{
+		ContributionID: contributionID,
+		UserID:         userID,
+		Content:        req.Content,
+		BaseVersion:    req.BaseVersion,
+	}
+
+	return s.resolveConflictsUC.Execute(ctx, cmd)
+}
+
+// ReviewContribution approves, requests changes on or rejects a contribution
+func (s *ContributionApplicationService) ReviewContribution(ctx context.Context, contributionID, userID uuid.UUID, req dto.ReviewContributionRequest) (*dto.ContributionResponse, error) {
+	cmd := commands.ReviewContributionCommand{
+		ContributionID: contributionID,
+		UserID:         userID,
+		Decision:       req.Decision,
+		Comment:        req.Comment,
+	}
+
+	return s.reviewUC.Execute(ctx, cmd)
+}
+
+// AssignReviewer puts a reviewer in charge of a contribution
+func (s *ContributionApplicationService) AssignReviewer(ctx context.Context, contributionID, userID uuid.UUID, req dto.AssignReviewerRequest) (*dto.ContributionResponse, error) {
+	cmd := commands.AssignReviewerCommand{
+		ContributionID: contributionID,
+		UserID:         userID,
+		ReviewerID:     req.ReviewerID,
+	}
+
+	return s.assignReviewerUC.Execute(ctx, cmd)
+}
+
+// GetContributionReviews lists the reviews of a contribution and what its merge still requires
+func (s *ContributionApplicationService) GetContributionReviews(ctx context.Context, contributionID, viewerID uuid.UUID) (*dto.ContributionReviewsResponse, error) {
+	query := queries.GetContributionReviewsQuery{
+		ContributionID: contributionID,
+		ViewerID:       viewerID,
+	}
+
+	return s.getReviewsUC.Execute(ctx, query)
+}
+
+// VoteContribution casts or changes the user's vote on a contribution
+func (s *ContributionApplicationService) VoteContribution(ctx context.Context, contributionID, userID uuid.UUID, req dto.VoteContributionRequest) (*dto.ContributionVoteResponse, error) {
+	cmd := commands.VoteContributionCommand{
+		ContributionID: contributionID,
+		UserID:         userID,
+		Vote:           req.Vote,
+	}
+
+	return s.voteUC.Execute(ctx, cmd)
+}
+
+// RemoveContributionVote withdraws the user's vote on a contribution
+func (s *ContributionApplicationService) RemoveContributionVote(ctx context.Context, contributionID, userID uuid.UUID) (*dto.ContributionVoteResponse, error) {
+	cmd := commands.RemoveContributionVoteCommand{
+		ContributionID: contributionID,
+		UserID:         userID,
+	}
+
+	return s.removeVoteUC.Execute(ctx, cmd)
+}
+
+// GetMergePolicy returns what contributions need before merging into a weave
+func (s *ContributionApplicationService) GetMergePolicy(ctx context.Context, weaveID, viewerID uuid.UUID) (*dto.MergePolicyResponse, error) {
+	query := queries.GetMergePolicyQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+	}
+
+	return s.getMergePolicyUC.Execute(ctx, query)
+}
+
+// UpdateMergePolicy replaces the merge policy of a weave
+func (s *ContributionApplicationService) UpdateMergePolicy(ctx context.Context, weaveID, userID uuid.UUID, req dto.UpdateMergePolicyRequest) (*dto.MergePolicyResponse, error) {
+	cmd := commands.UpdateMergePolicyCommand{
+		WeaveID:                 weaveID,
+		UserID:                  userID,
+		RequireOwnerApproval:    req.RequireOwnerApproval,
+		RequiredApprovals:       req.RequiredApprovals,
+		MinVoteScore:            req.MinVoteScore,
+		BlockOnChangesRequested: req.BlockOnChangesRequested,
+	}
+
+	return s.updateMergePolicyUC.Execute(ctx, cmd)
+}
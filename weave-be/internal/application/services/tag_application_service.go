@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/tag"
+	"weave-be/internal/domain/repositories"
+)
+
+// TagApplicationService orchestrates tag use cases
+type TagApplicationService struct {
+	// Command Use Cases
+	mergeTagsUC *tag.MergeTagsUseCase
+
+	// Query Use Cases
+	autocompleteTagsUC *tag.AutocompleteTagsUseCase
+	getTagWeavesUC     *tag.GetTagWeavesUseCase
+	getRelatedTagsUC   *tag.GetRelatedTagsUseCase
+}
+
+// NewTagApplicationService creates a new TagApplicationService with all use cases
+func NewTagApplicationService(
+	tagRepo repositories.TagRepository,
+	weaveRepo repositories.WeaveRepository,
+	userRepo repositories.UserRepository,
+) *TagApplicationService {
+	return &TagApplicationService{
+		mergeTagsUC:        tag.NewMergeTagsUseCase(tagRepo, userRepo),
+		autocompleteTagsUC: tag.NewAutocompleteTagsUseCase(tagRepo),
+		getTagWeavesUC:     tag.NewGetTagWeavesUseCase(tagRepo, weaveRepo),
+		getRelatedTagsUC:   tag.NewGetRelatedTagsUseCase(tagRepo),
+	}
+}
+
+// MergeTags folds the source tag into the target tag
+func (s *TagApplicationService) MergeTags(ctx context.Context, userID uuid.UUID, source string, req dto.MergeTagsRequest) (*dto.TagResponse, error) {
+	cmd := commands.MergeTagsCommand{
+		UserID: userID,
+		Source: source,
+		Target: req.Into,
+	}
+
+	return s.mergeTagsUC.Execute(ctx, cmd)
+}
+
+// AutocompleteTags suggests tags starting with the prefix
+func (s *TagApplicationService) AutocompleteTags(ctx context.Context, prefix string, limit int) ([]*dto.TagResponse, error) {
+	query := queries.AutocompleteTagsQuery{
+		Prefix: prefix,
+		Limit:  limit,
+	}
+
+	return s.autocompleteTagsUC.Execute(ctx, query)
+}
+
+// GetTagWeaves lists the published weaves carrying a tag
+func (s *TagApplicationService) GetTagWeaves(ctx context.Context, name string, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.GetTagWeavesQuery{
+		Name:  name,
+		Page:  page,
+		Limit: limit,
+	}
+
+	return s.getTagWeavesUC.Execute(ctx, query)
+}
+
+// GetRelatedTags suggests tags often used together with a tag
+func (s *TagApplicationService) GetRelatedTags(ctx context.Context, name string, limit int) ([]*dto.RelatedTagResponse, error) {
+	query := queries.GetRelatedTagsQuery{
+		Name:  name,
+		Limit: limit,
+	}
+
+	return s.getRelatedTagsUC.Execute(ctx, query)
+}
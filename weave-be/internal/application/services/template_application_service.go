@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/template"
+	"weave-be/internal/domain/repositories"
+)
+
+// TemplateApplicationService orchestrates weave template use cases
+type TemplateApplicationService struct {
+	// Command Use Cases
+	setTemplateUC   *template.SetTemplateUseCase
+	pinTemplateUC   *template.PinTemplateUseCase
+	unpinTemplateUC *template.UnpinTemplateUseCase
+
+	// Query Use Cases
+	listTemplatesUC        *template.ListTemplatesUseCase
+	listChannelTemplatesUC *template.ListChannelTemplatesUseCase
+}
+
+// NewTemplateApplicationService creates a new TemplateApplicationService with all use cases
+func NewTemplateApplicationService(
+	templateRepo repositories.TemplateRepository,
+	weaveRepo repositories.WeaveRepository,
+	channelRepo repositories.ChannelRepository,
+	userRepo repositories.UserRepository,
+) *TemplateApplicationService {
+	return &TemplateApplicationService{
+		setTemplateUC:          template.NewSetTemplateUseCase(weaveRepo),
+		pinTemplateUC:          template.NewPinTemplateUseCase(weaveRepo, channelRepo, templateRepo, userRepo),
+		unpinTemplateUC:        template.NewUnpinTemplateUseCase(templateRepo, userRepo),
+		listTemplatesUC:        template.NewListTemplatesUseCase(weaveRepo),
+		listChannelTemplatesUC: template.NewListChannelTemplatesUseCase(channelRepo, templateRepo),
+	}
+}
+
+// SetTemplate offers a weave as a template or withdraws it
+func (s *TemplateApplicationService) SetTemplate(ctx context.Context, weaveID, userID uuid.UUID, isTemplate bool) (*dto.WeaveResponse, error) {
+	cmd := commands.SetTemplateCommand{
+		WeaveID:    weaveID,
+		UserID:     userID,
+		IsTemplate: isTemplate,
+	}
+
+	return s.setTemplateUC.Execute(ctx, cmd)
+}
+
+// PinTemplate adds a template to a channel's official templates
+func (s *TemplateApplicationService) PinTemplate(ctx context.Context, channelID, userID uuid.UUID, req dto.PinTemplateRequest) (*dto.ChannelTemplateResponse, error) {
+	cmd := commands.PinTemplateCommand{
+		ChannelID: channelID,
+		UserID:    userID,
+		WeaveID:   req.WeaveID,
+		Position:  req.Position,
+	}
+
+	return s.pinTemplateUC.Execute(ctx, cmd)
+}
+
+// UnpinTemplate removes a template from a channel's official templates
+func (s *TemplateApplicationService) UnpinTemplate(ctx context.Context, channelID, userID, weaveID uuid.UUID) error {
+	cmd := commands.UnpinTemplateCommand{
+		ChannelID: channelID,
+		UserID:    userID,
+		WeaveID:   weaveID,
+	}
+
+	return s.unpinTemplateUC.Execute(ctx, cmd)
+}
+
+// ListTemplates lists published templates, optionally those created in one channel
+func (s *TemplateApplicationService) ListTemplates(ctx context.Context, channelID *uuid.UUID, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.ListTemplatesQuery{
+		ChannelID: channelID,
+		Page:      page,
+		Limit:     limit,
+	}
+
+	return s.listTemplatesUC.Execute(ctx, query)
+}
+
+// ListChannelTemplates lists the templates a channel pins
+func (s *TemplateApplicationService) ListChannelTemplates(ctx context.Context, channelID uuid.UUID) ([]*dto.ChannelTemplateResponse, error) {
+	query := queries.ListChannelTemplatesQuery{
+		ChannelID: channelID,
+	}
+
+	return s.listChannelTemplatesUC.Execute(ctx, query)
+}
@@ -0,0 +1,457 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/weave"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+)
+
+// WeaveApplicationService orchestrates weave-related use cases
+type WeaveApplicationService struct {
+	// Command Use Cases
+	createWeaveUC  *weave.CreateWeaveUseCase
+	updateWeaveUC  *weave.UpdateWeaveUseCase
+	deleteWeaveUC  *weave.DeleteWeaveUseCase
+	restoreWeaveUC *weave.RestoreWeaveUseCase
+	forkWeaveUC    *weave.ForkWeaveUseCase
+	likeWeaveUC    *weave.LikeWeaveUseCase
+	unlikeWeaveUC  *weave.UnlikeWeaveUseCase
+	changeStatusUC *weave.ChangeWeaveStatusUseCase
+	scheduleUC     *weave.SchedulePublicationUseCase
+	unscheduleUC   *weave.CancelScheduledPublicationUseCase
+	revertWeaveUC  *weave.RevertWeaveUseCase
+	syncWeaveUC    *weave.SyncWeaveUseCase
+	importWeaveUC  *weave.ImportWeaveUseCase
+
+	// Query Use Cases
+	getWeaveUC           *weave.GetWeaveUseCase
+	getWeaveVersionsUC   *weave.GetWeaveVersionsUseCase
+	getWeaveVersionUC    *weave.GetWeaveVersionUseCase
+	getVersionDiffUC     *weave.GetWeaveVersionDiffUseCase
+	getLineageUC         *weave.GetWeaveLineageUseCase
+	getUpstreamStatusUC  *weave.GetUpstreamStatusUseCase
+	getPublishedWeavesUC *weave.GetPublishedWeavesUseCase
+	getFeaturedWeavesUC  *weave.GetFeaturedWeavesUseCase
+	getTrendingWeavesUC  *weave.GetTrendingWeavesUseCase
+	getPopularWeavesUC   *weave.GetPopularWeavesUseCase
+	searchWeavesUC       *weave.SearchWeavesUseCase
+	getWeaveForksUC      *weave.GetWeaveForksUseCase
+	getDraftsUC          *weave.GetDraftsUseCase
+	getTrashUC           *weave.GetTrashUseCase
+	getLikedWeavesUC     *weave.GetLikedWeavesUseCase
+	getContentTypesUC    *weave.GetContentTypesUseCase
+	getBlameUC           *weave.GetWeaveBlameUseCase
+	exportWeaveUC        *weave.ExportWeaveUseCase
+}
+
+// NewWeaveApplicationService creates a new WeaveApplicationService with all use cases
+func NewWeaveApplicationService(
+	weaveRepo repositories.WeaveRepository,
+	channelRepo repositories.ChannelRepository,
+	contributionRepo repositories.ContributionRepository,
+	userRepo repositories.UserRepository,
+	tagRepo repositories.TagRepository,
+	blameCache repositories.WeaveBlameCache,
+	registry *contenttypes.Registry,
+	userDomainService services.UserDomainService,
+	taskService services.TaskService,
+	trashRetention time.Duration,
+) *WeaveApplicationService {
+	return &WeaveApplicationService{
+		createWeaveUC:        weave.NewCreateWeaveUseCase(weaveRepo, channelRepo, registry, userDomainService),
+		updateWeaveUC:        weave.NewUpdateWeaveUseCase(weaveRepo, channelRepo, tagRepo, registry),
+		deleteWeaveUC:        weave.NewDeleteWeaveUseCase(weaveRepo),
+		restoreWeaveUC:       weave.NewRestoreWeaveUseCase(weaveRepo),
+		forkWeaveUC:          weave.NewForkWeaveUseCase(weaveRepo),
+		likeWeaveUC:          weave.NewLikeWeaveUseCase(weaveRepo),
+		unlikeWeaveUC:        weave.NewUnlikeWeaveUseCase(weaveRepo),
+		changeStatusUC:       weave.NewChangeWeaveStatusUseCase(weaveRepo, registry),
+		scheduleUC:           weave.NewSchedulePublicationUseCase(weaveRepo, registry),
+		unscheduleUC:         weave.NewCancelScheduledPublicationUseCase(weaveRepo),
+		revertWeaveUC:        weave.NewRevertWeaveUseCase(weaveRepo),
+		syncWeaveUC:          weave.NewSyncWeaveUseCase(weaveRepo, registry),
+		importWeaveUC:        weave.NewImportWeaveUseCase(weaveRepo, channelRepo, registry, userDomainService, taskService),
+		getWeaveUC:           weave.NewGetWeaveUseCase(weaveRepo),
+		getWeaveVersionsUC:   weave.NewGetWeaveVersionsUseCase(weaveRepo),
+		getWeaveVersionUC:    weave.NewGetWeaveVersionUseCase(weaveRepo),
+		getVersionDiffUC:     weave.NewGetWeaveVersionDiffUseCase(weaveRepo),
+		getLineageUC:         weave.NewGetWeaveLineageUseCase(weaveRepo),
+		getUpstreamStatusUC:  weave.NewGetUpstreamStatusUseCase(weaveRepo),
+		getPublishedWeavesUC: weave.NewGetPublishedWeavesUseCase(weaveRepo),
+		getFeaturedWeavesUC:  weave.NewGetFeaturedWeavesUseCase(weaveRepo),
+		getTrendingWeavesUC:  weave.NewGetTrendingWeavesUseCase(weaveRepo),
+		getPopularWeavesUC:   weave.NewGetPopularWeavesUseCase(weaveRepo),
+		searchWeavesUC:       weave.NewSearchWeavesUseCase(weaveRepo),
+		getWeaveForksUC:      weave.NewGetWeaveForksUseCase(weaveRepo),
+		getDraftsUC:          weave.NewGetDraftsUseCase(weaveRepo),
+		getTrashUC:           weave.NewGetTrashUseCase(weaveRepo, trashRetention),
+		getLikedWeavesUC:     weave.NewGetLikedWeavesUseCase(weaveRepo),
+		getContentTypesUC:    weave.NewGetContentTypesUseCase(channelRepo, registry),
+		getBlameUC:           weave.NewGetWeaveBlameUseCase(weaveRepo, contributionRepo, userRepo, blameCache),
+		exportWeaveUC:        weave.NewExportWeaveUseCase(weaveRepo, userRepo),
+	}
+}
+
+// CreateWeave creates a new draft weave
+func (s *WeaveApplicationService) CreateWeave(ctx context.Context, userID uuid.UUID, req dto.CreateWeaveRequest) (*dto.WeaveResponse, error) {
+	cmd := commands.CreateWeaveCommand{
+		UserID:      userID,
+		ChannelID:   req.ChannelID,
+		Title:       req.Title,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+		Content:     req.Content,
+		TemplateID:  req.TemplateID,
+		Tags:        req.Tags,
+	}
+
+	return s.createWeaveUC.Execute(ctx, cmd)
+}
+
+// ImportWeave creates a draft weave from a JSON bundle or a Markdown file
+func (s *WeaveApplicationService) ImportWeave(ctx context.Context, userID uuid.UUID, req dto.ImportWeaveRequest) (*dto.ImportWeaveResponse, error) {
+	cmd := commands.ImportWeaveCommand{
+		UserID:      userID,
+		ChannelID:   req.ChannelID,
+		Format:      req.Format,
+		Bundle:      req.Bundle,
+		Markdown:    req.Markdown,
+		ContentType: req.ContentType,
+	}
+
+	return s.importWeaveUC.Execute(ctx, cmd)
+}
+
+// UpdateWeave updates a weave the user can edit, provided it is still at one of
+// expectedVersions, or at whichever version is current when anyVersion is set
+func (s *WeaveApplicationService) UpdateWeave(ctx context.Context, weaveID, userID uuid.UUID, expectedVersions []int, anyVersion bool, req dto.UpdateWeaveRequest) (*dto.WeaveResponse, error) {
+	cmd := commands.UpdateWeaveCommand{
+		WeaveID:          weaveID,
+		UserID:           userID,
+		Title:            req.Title,
+		Description:      req.Description,
+		CoverImage:       req.CoverImage,
+		Content:          req.Content,
+		ChangeLog:        req.ChangeLog,
+		ExpectedVersions: expectedVersions,
+		AnyVersion:       anyVersion,
+		Tags:             req.Tags,
+	}
+
+	return s.updateWeaveUC.Execute(ctx, cmd)
+}
+
+// DeleteWeave deletes a weave owned by the user
+func (s *WeaveApplicationService) DeleteWeave(ctx context.Context, weaveID, userID uuid.UUID) error {
+	cmd := commands.DeleteWeaveCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.deleteWeaveUC.Execute(ctx, cmd)
+}
+
+// RestoreWeave takes a weave out of the trash
+func (s *WeaveApplicationService) RestoreWeave(ctx context.Context, weaveID, userID uuid.UUID) (*dto.WeaveResponse, error) {
+	cmd := commands.RestoreWeaveCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.restoreWeaveUC.Execute(ctx, cmd)
+}
+
+// ForkWeave forks a weave for the user
+func (s *WeaveApplicationService) ForkWeave(ctx context.Context, weaveID, userID uuid.UUID) (*dto.WeaveResponse, error) {
+	cmd := commands.ForkWeaveCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.forkWeaveUC.Execute(ctx, cmd)
+}
+
+// LikeWeave likes a weave
+func (s *WeaveApplicationService) LikeWeave(ctx context.Context, weaveID, userID uuid.UUID) error {
+	cmd := commands.LikeWeaveCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.likeWeaveUC.Execute(ctx, cmd)
+}
+
+// UnlikeWeave removes a like from a weave
+func (s *WeaveApplicationService) UnlikeWeave(ctx context.Context, weaveID, userID uuid.UUID) error {
+	cmd := commands.UnlikeWeaveCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.unlikeWeaveUC.Execute(ctx, cmd)
+}
+
+// PublishWeave publishes a weave or moves it back to draft
+func (s *WeaveApplicationService) PublishWeave(ctx context.Context, weaveID, userID uuid.UUID, publish bool) (*dto.WeaveResponse, error) {
+	status := entities.WeaveStatusDraft
+	if publish {
+		status = entities.WeaveStatusPublished
+	}
+
+	return s.ChangeWeaveStatus(ctx, weaveID, userID, dto.ChangeWeaveStatusRequest{Status: status})
+}
+
+// ChangeWeaveStatus moves a weave to another lifecycle status
+func (s *WeaveApplicationService) ChangeWeaveStatus(ctx context.Context, weaveID, userID uuid.UUID, req dto.ChangeWeaveStatusRequest) (*dto.WeaveResponse, error) {
+	cmd := commands.ChangeWeaveStatusCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+		Status:  req.Status,
+	}
+
+	return s.changeStatusUC.Execute(ctx, cmd)
+}
+
+// SchedulePublication publishes a draft or in-review weave automatically at the requested time
+func (s *WeaveApplicationService) SchedulePublication(ctx context.Context, weaveID, userID uuid.UUID, req dto.SchedulePublicationRequest) (*dto.WeaveResponse, error) {
+	cmd := commands.SchedulePublicationCommand{
+		WeaveID:   weaveID,
+		UserID:    userID,
+		PublishAt: req.PublishAt,
+	}
+
+	return s.scheduleUC.Execute(ctx, cmd)
+}
+
+// CancelScheduledPublication drops a weave's publication schedule
+func (s *WeaveApplicationService) CancelScheduledPublication(ctx context.Context, weaveID, userID uuid.UUID) (*dto.WeaveResponse, error) {
+	cmd := commands.CancelScheduledPublicationCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.unscheduleUC.Execute(ctx, cmd)
+}
+
+// RevertWeave restores an earlier version of a weave as a new version
+func (s *WeaveApplicationService) RevertWeave(ctx context.Context, weaveID, userID uuid.UUID, version int) (*dto.WeaveVersionResponse, error) {
+	cmd := commands.RevertWeaveCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+		Version: version,
+	}
+
+	return s.revertWeaveUC.Execute(ctx, cmd)
+}
+
+// SyncWeave merges new upstream changes into a fork
+func (s *WeaveApplicationService) SyncWeave(ctx context.Context, weaveID, userID uuid.UUID) (*dto.SyncWeaveResponse, error) {
+	cmd := commands.SyncWeaveCommand{
+		WeaveID: weaveID,
+		UserID:  userID,
+	}
+
+	return s.syncWeaveUC.Execute(ctx, cmd)
+}
+
+// GetWeave gets a weave by ID
+func (s *WeaveApplicationService) GetWeave(ctx context.Context, weaveID uuid.UUID, viewerID *uuid.UUID) (*dto.WeaveDetailResponse, error) {
+	query := queries.GetWeaveQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+	}
+
+	return s.getWeaveUC.Execute(ctx, query)
+}
+
+// GetWeaveVersions gets the version history of a weave
+func (s *WeaveApplicationService) GetWeaveVersions(ctx context.Context, weaveID uuid.UUID, viewerID *uuid.UUID) ([]dto.WeaveVersionResponse, error) {
+	query := queries.GetWeaveVersionsQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+	}
+
+	return s.getWeaveVersionsUC.Execute(ctx, query)
+}
+
+// GetWeaveVersion gets a specific version of a weave
+func (s *WeaveApplicationService) GetWeaveVersion(ctx context.Context, weaveID uuid.UUID, version int, viewerID *uuid.UUID) (*dto.WeaveVersionResponse, error) {
+	query := queries.GetWeaveVersionQuery{
+		WeaveID:  weaveID,
+		Version:  version,
+		ViewerID: viewerID,
+	}
+
+	return s.getWeaveVersionUC.Execute(ctx, query)
+}
+
+// GetWeaveVersionDiff compares two versions of a weave
+func (s *WeaveApplicationService) GetWeaveVersionDiff(ctx context.Context, weaveID uuid.UUID, fromVersion, toVersion int, viewerID *uuid.UUID) (*dto.WeaveVersionDiffResponse, error) {
+	query := queries.GetWeaveVersionDiffQuery{
+		WeaveID:     weaveID,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		ViewerID:    viewerID,
+	}
+
+	return s.getVersionDiffUC.Execute(ctx, query)
+}
+
+// GetWeaveLineage gets the fork family tree of a weave
+func (s *WeaveApplicationService) GetWeaveLineage(ctx context.Context, weaveID uuid.UUID, viewerID *uuid.UUID, depth, limit int) (*dto.WeaveLineageResponse, error) {
+	query := queries.GetWeaveLineageQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+		Depth:    depth,
+		Limit:    limit,
+	}
+
+	return s.getLineageUC.Execute(ctx, query)
+}
+
+// GetUpstreamStatus compares a fork with its parent weave
+func (s *WeaveApplicationService) GetUpstreamStatus(ctx context.Context, weaveID uuid.UUID, viewerID *uuid.UUID) (*dto.UpstreamStatusResponse, error) {
+	query := queries.GetUpstreamStatusQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+	}
+
+	return s.getUpstreamStatusUC.Execute(ctx, query)
+}
+
+// GetContentTypes lists content type schemas, optionally only those a channel accepts
+func (s *WeaveApplicationService) GetContentTypes(ctx context.Context, channelID *uuid.UUID) ([]dto.ContentTypeResponse, error) {
+	query := queries.GetContentTypesQuery{
+		ChannelID: channelID,
+	}
+
+	return s.getContentTypesUC.Execute(ctx, query)
+}
+
+// GetWeaveBlame attributes each field of a weave's content to the version and user that last changed it
+func (s *WeaveApplicationService) GetWeaveBlame(ctx context.Context, weaveID uuid.UUID, viewerID *uuid.UUID) (*dto.WeaveBlameResponse, error) {
+	query := queries.GetWeaveBlameQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+	}
+
+	return s.getBlameUC.Execute(ctx, query)
+}
+
+// ExportWeave renders a weave as Markdown or HTML, or archives it as a JSON bundle
+func (s *WeaveApplicationService) ExportWeave(ctx context.Context, weaveID uuid.UUID, format string, version *int, viewerID *uuid.UUID) (*dto.ExportedWeaveResponse, error) {
+	query := queries.ExportWeaveQuery{
+		WeaveID:  weaveID,
+		ViewerID: viewerID,
+		Format:   format,
+		Version:  version,
+	}
+
+	return s.exportWeaveUC.Execute(ctx, query)
+}
+
+// GetPublishedWeaves lists published weaves
+func (s *WeaveApplicationService) GetPublishedWeaves(ctx context.Context, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.ListWeavesQuery{
+		Page:  page,
+		Limit: limit,
+	}
+
+	return s.getPublishedWeavesUC.Execute(ctx, query)
+}
+
+// GetFeaturedWeaves lists featured weaves
+func (s *WeaveApplicationService) GetFeaturedWeaves(ctx context.Context, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.ListWeavesQuery{
+		Page:  page,
+		Limit: limit,
+	}
+
+	return s.getFeaturedWeavesUC.Execute(ctx, query)
+}
+
+// GetTrendingWeaves lists trending weaves for a timeframe
+func (s *WeaveApplicationService) GetTrendingWeaves(ctx context.Context, timeframe string, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.GetTrendingWeavesQuery{
+		Timeframe: timeframe,
+		Page:      page,
+		Limit:     limit,
+	}
+
+	return s.getTrendingWeavesUC.Execute(ctx, query)
+}
+
+// GetPopularWeaves lists popular weaves
+func (s *WeaveApplicationService) GetPopularWeaves(ctx context.Context, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.ListWeavesQuery{
+		Page:  page,
+		Limit: limit,
+	}
+
+	return s.getPopularWeavesUC.Execute(ctx, query)
+}
+
+// SearchWeaves searches published weaves
+func (s *WeaveApplicationService) SearchWeaves(ctx context.Context, searchQuery string, channelID *uuid.UUID, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.SearchWeavesQuery{
+		Query:     searchQuery,
+		ChannelID: channelID,
+		Page:      page,
+		Limit:     limit,
+	}
+
+	return s.searchWeavesUC.Execute(ctx, query)
+}
+
+// GetWeaveForks lists forks of a weave
+func (s *WeaveApplicationService) GetWeaveForks(ctx context.Context, weaveID uuid.UUID, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.GetWeaveForksQuery{
+		WeaveID: weaveID,
+		Page:    page,
+		Limit:   limit,
+	}
+
+	return s.getWeaveForksUC.Execute(ctx, query)
+}
+
+// GetDrafts lists the user's drafts
+func (s *WeaveApplicationService) GetDrafts(ctx context.Context, userID uuid.UUID, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.GetUserWeavesQuery{
+		UserID: userID,
+		Page:   page,
+		Limit:  limit,
+	}
+
+	return s.getDraftsUC.Execute(ctx, query)
+}
+
+// GetTrash lists the user's deleted weaves that can still be restored
+func (s *WeaveApplicationService) GetTrash(ctx context.Context, userID uuid.UUID, page, limit int) (*dto.PaginatedTrashResponse, error) {
+	query := queries.GetUserWeavesQuery{
+		UserID: userID,
+		Page:   page,
+		Limit:  limit,
+	}
+
+	return s.getTrashUC.Execute(ctx, query)
+}
+
+// GetLikedWeaves lists weaves liked by the user
+func (s *WeaveApplicationService) GetLikedWeaves(ctx context.Context, userID uuid.UUID, page, limit int) (*dto.PaginatedWeavesResponse, error) {
+	query := queries.GetUserWeavesQuery{
+		UserID: userID,
+		Page:   page,
+		Limit:  limit,
+	}
+
+	return s.getLikedWeavesUC.Execute(ctx, query)
+}
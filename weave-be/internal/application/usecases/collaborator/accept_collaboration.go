@@ -0,0 +1,50 @@
+package collaborator
+
+import (
+	"context"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// AcceptCollaborationUseCase handles accepting a collaboration invitation
+type AcceptCollaborationUseCase struct {
+	weaveRepo        repositories.WeaveRepository
+	collaboratorRepo repositories.CollaboratorRepository
+}
+
+// NewAcceptCollaborationUseCase creates a new AcceptCollaborationUseCase
+func NewAcceptCollaborationUseCase(weaveRepo repositories.WeaveRepository, collaboratorRepo repositories.CollaboratorRepository) *AcceptCollaborationUseCase {
+	return &AcceptCollaborationUseCase{
+		weaveRepo:        weaveRepo,
+		collaboratorRepo: collaboratorRepo,
+	}
+}
+
+// Execute activates the invited user's role on the weave
+func (uc *AcceptCollaborationUseCase) Execute(ctx context.Context, cmd commands.AcceptCollaborationCommand) (*dto.CollaboratorResponse, error) {
+	if _, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID); err != nil {
+		return nil, err
+	}
+
+	collaborator, err := findCollaborator(ctx, uc.collaboratorRepo, cmd.WeaveID, cmd.UserID)
+	if err != nil {
+		if appErrors.IsNotFound(err) {
+			return nil, appErrors.NotFound("Invitation not found")
+		}
+		return nil, err
+	}
+	if collaborator.IsActive() {
+		return nil, appErrors.Conflict("Invitation has already been accepted")
+	}
+
+	collaborator.Accept()
+	if err := uc.collaboratorRepo.Update(ctx, collaborator); err != nil {
+		return nil, appErrors.InternalServerError("Failed to accept invitation")
+	}
+
+	return dto.CollaboratorToResponse(collaborator), nil
+}
@@ -0,0 +1,106 @@
+package collaborator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+	appErrors "weave-module/errors"
+)
+
+// InviteCollaboratorUseCase handles inviting a user to collaborate on a weave
+type InviteCollaboratorUseCase struct {
+	weaveRepo           repositories.WeaveRepository
+	collaboratorRepo    repositories.CollaboratorRepository
+	userRepo            repositories.UserRepository
+	notificationService services.NotificationService
+}
+
+// NewInviteCollaboratorUseCase creates a new InviteCollaboratorUseCase
+func NewInviteCollaboratorUseCase(
+	weaveRepo repositories.WeaveRepository,
+	collaboratorRepo repositories.CollaboratorRepository,
+	userRepo repositories.UserRepository,
+	notificationService services.NotificationService,
+) *InviteCollaboratorUseCase {
+	return &InviteCollaboratorUseCase{
+		weaveRepo:           weaveRepo,
+		collaboratorRepo:    collaboratorRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Execute stores a pending invitation and notifies the invitee.
+// The role only takes effect once the invitee accepts.
+func (uc *InviteCollaboratorUseCase) Execute(ctx context.Context, cmd commands.InviteCollaboratorCommand) (*dto.CollaboratorResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+
+	inviterRole := weave.RoleOf(cmd.UserID)
+	if !inviterRole.Can(entities.PermissionManageCollaborators) {
+		return nil, appErrors.Forbidden("You do not have permission to manage collaborators of this weave")
+	}
+	if !cmd.Role.IsValid() {
+		return nil, appErrors.BadRequest("Invalid collaborator role")
+	}
+	if !inviterRole.CanAssign(cmd.Role) {
+		return nil, appErrors.Forbidden("Maintainers can only invite editors and reviewers")
+	}
+	if cmd.InviteeID == weave.UserID {
+		return nil, appErrors.Conflict("The weave creator is already its owner")
+	}
+
+	invitee, err := uc.userRepo.GetByID(ctx, cmd.InviteeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.ErrUserNotFound
+		}
+		return nil, appErrors.InternalServerError("Failed to get user")
+	}
+
+	collaborator := entities.NewWeaveCollaborator(weave.ID, invitee.ID, cmd.UserID, cmd.Role)
+	if err := uc.collaboratorRepo.Create(ctx, collaborator); err != nil {
+		if errors.Is(err, repositories.ErrCollaboratorExists) {
+			return nil, appErrors.Conflict("User is already a collaborator on this weave")
+		}
+		return nil, appErrors.InternalServerError("Failed to invite collaborator")
+	}
+	collaborator.Username = invitee.Username
+
+	// Notifications are best effort and must not fail the request
+	_ = uc.notificationService.Notify(ctx, invitee.ID, "collaboration",
+		"Collaboration Invitation",
+		fmt.Sprintf("You were invited to collaborate on \"%s\" as %s", weave.Title, cmd.Role),
+		map[string]interface{}{
+			"type":       "collaborator_invite",
+			"weave_id":   weave.ID.String(),
+			"role":       string(cmd.Role),
+			"invited_by": cmd.UserID.String(),
+		},
+	)
+
+	return dto.CollaboratorToResponse(collaborator), nil
+}
+
+// findCollaborator loads a user's role on a weave, pending invitations included
+func findCollaborator(ctx context.Context, collaboratorRepo repositories.CollaboratorRepository, weaveID, userID uuid.UUID) (*entities.WeaveCollaborator, error) {
+	collaborator, err := collaboratorRepo.GetByWeaveAndUser(ctx, weaveID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Collaborator not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get collaborator")
+	}
+	return collaborator, nil
+}
@@ -0,0 +1,62 @@
+package collaborator
+
+import (
+	"context"
+
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// ListCollaboratorsUseCase handles listing who holds a role on a weave
+type ListCollaboratorsUseCase struct {
+	weaveRepo        repositories.WeaveRepository
+	collaboratorRepo repositories.CollaboratorRepository
+	userRepo         repositories.UserRepository
+}
+
+// NewListCollaboratorsUseCase creates a new ListCollaboratorsUseCase
+func NewListCollaboratorsUseCase(
+	weaveRepo repositories.WeaveRepository,
+	collaboratorRepo repositories.CollaboratorRepository,
+	userRepo repositories.UserRepository,
+) *ListCollaboratorsUseCase {
+	return &ListCollaboratorsUseCase{
+		weaveRepo:        weaveRepo,
+		collaboratorRepo: collaboratorRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// Execute lists the creator followed by the collaborators.
+// Pending invitations are only shown to users who manage collaborators.
+func (uc *ListCollaboratorsUseCase) Execute(ctx context.Context, query queries.ListCollaboratorsQuery) ([]*dto.CollaboratorResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, query.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !weave.IsVisibleTo(query.ViewerID) {
+		return nil, appErrors.ErrWeaveNotFound
+	}
+
+	includeInvited := query.ViewerID != nil && weave.HasPermission(*query.ViewerID, entities.PermissionManageCollaborators)
+	collaborators, err := uc.collaboratorRepo.ListByWeave(ctx, weave.ID, includeInvited)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get collaborators")
+	}
+
+	ownerName := ""
+	if owner, err := uc.userRepo.GetByID(ctx, weave.UserID); err == nil {
+		ownerName = owner.Username
+	}
+
+	responses := make([]*dto.CollaboratorResponse, 0, len(collaborators)+1)
+	responses = append(responses, dto.WeaveOwnerToResponse(weave, ownerName))
+	for _, collaborator := range collaborators {
+		responses = append(responses, dto.CollaboratorToResponse(collaborator))
+	}
+	return responses, nil
+}
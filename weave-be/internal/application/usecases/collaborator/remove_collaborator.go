@@ -0,0 +1,54 @@
+package collaborator
+
+import (
+	"context"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// RemoveCollaboratorUseCase handles revoking a collaborator's role
+type RemoveCollaboratorUseCase struct {
+	weaveRepo        repositories.WeaveRepository
+	collaboratorRepo repositories.CollaboratorRepository
+}
+
+// NewRemoveCollaboratorUseCase creates a new RemoveCollaboratorUseCase
+func NewRemoveCollaboratorUseCase(weaveRepo repositories.WeaveRepository, collaboratorRepo repositories.CollaboratorRepository) *RemoveCollaboratorUseCase {
+	return &RemoveCollaboratorUseCase{
+		weaveRepo:        weaveRepo,
+		collaboratorRepo: collaboratorRepo,
+	}
+}
+
+// Execute removes a collaborator or pending invitation. Collaborators may always
+// remove themselves; otherwise the actor must be allowed to assign the target's role.
+func (uc *RemoveCollaboratorUseCase) Execute(ctx context.Context, cmd commands.RemoveCollaboratorCommand) error {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return err
+	}
+	if cmd.CollaboratorID == weave.UserID {
+		return appErrors.BadRequest("The weave creator cannot be removed")
+	}
+
+	collaborator, err := findCollaborator(ctx, uc.collaboratorRepo, cmd.WeaveID, cmd.CollaboratorID)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UserID != collaborator.UserID {
+		actorRole := weave.RoleOf(cmd.UserID)
+		if !actorRole.Can(entities.PermissionManageCollaborators) || !actorRole.CanAssign(collaborator.Role) {
+			return appErrors.Forbidden("You do not have permission to remove this collaborator")
+		}
+	}
+
+	if err := uc.collaboratorRepo.Delete(ctx, collaborator.ID); err != nil {
+		return appErrors.InternalServerError("Failed to remove collaborator")
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package collection
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// AddCollectionWeaveUseCase handles adding weaves to a collection
+type AddCollectionWeaveUseCase struct {
+	collectionRepo repositories.CollectionRepository
+	weaveRepo      repositories.WeaveRepository
+}
+
+// NewAddCollectionWeaveUseCase creates a new AddCollectionWeaveUseCase
+func NewAddCollectionWeaveUseCase(collectionRepo repositories.CollectionRepository, weaveRepo repositories.WeaveRepository) *AddCollectionWeaveUseCase {
+	return &AddCollectionWeaveUseCase{
+		collectionRepo: collectionRepo,
+		weaveRepo:      weaveRepo,
+	}
+}
+
+// Execute adds a weave the user can see to one of their collections.
+// Unpublished weaves may be collected but are only listed to the collection owner.
+func (uc *AddCollectionWeaveUseCase) Execute(ctx context.Context, cmd commands.AddCollectionWeaveCommand) error {
+	collection, err := findEditableCollection(ctx, uc.collectionRepo, cmd.CollectionID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	if err := collection.CanAddWeave(); err != nil {
+		return err
+	}
+
+	weave, err := uc.weaveRepo.GetByID(ctx, cmd.WeaveID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.ErrWeaveNotFound
+		}
+		return appErrors.InternalServerError("Failed to get weave")
+	}
+	if !weave.IsVisibleTo(&cmd.UserID) {
+		return appErrors.ErrWeaveNotFound
+	}
+
+	if err := uc.collectionRepo.AddWeave(ctx, collection, weave.ID, cmd.Position); err != nil {
+		if errors.Is(err, repositories.ErrWeaveAlreadyInCollection) {
+			return appErrors.Conflict("Weave is already in this collection")
+		}
+		return appErrors.InternalServerError("Failed to add weave to collection")
+	}
+	return nil
+}
+
+// RemoveCollectionWeaveUseCase handles taking weaves out of a collection
+type RemoveCollectionWeaveUseCase struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+// NewRemoveCollectionWeaveUseCase creates a new RemoveCollectionWeaveUseCase
+func NewRemoveCollectionWeaveUseCase(collectionRepo repositories.CollectionRepository) *RemoveCollectionWeaveUseCase {
+	return &RemoveCollectionWeaveUseCase{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Execute removes a weave from one of the user's collections
+func (uc *RemoveCollectionWeaveUseCase) Execute(ctx context.Context, cmd commands.RemoveCollectionWeaveCommand) error {
+	collection, err := findEditableCollection(ctx, uc.collectionRepo, cmd.CollectionID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.collectionRepo.RemoveWeave(ctx, collection.ID, cmd.WeaveID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.NotFound("Weave is not in this collection")
+		}
+		return appErrors.InternalServerError("Failed to remove weave from collection")
+	}
+	return nil
+}
+
+// ReorderCollectionUseCase handles changing the order of the weaves in a collection
+type ReorderCollectionUseCase struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+// NewReorderCollectionUseCase creates a new ReorderCollectionUseCase
+func NewReorderCollectionUseCase(collectionRepo repositories.CollectionRepository) *ReorderCollectionUseCase {
+	return &ReorderCollectionUseCase{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Execute puts the weaves of one of the user's collections in the given order.
+// The order must list every weave in the collection exactly once.
+func (uc *ReorderCollectionUseCase) Execute(ctx context.Context, cmd commands.ReorderCollectionCommand) error {
+	collection, err := findEditableCollection(ctx, uc.collectionRepo, cmd.CollectionID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.collectionRepo.ReorderWeaves(ctx, collection.ID, cmd.WeaveIDs); err != nil {
+		if errors.Is(err, repositories.ErrCollectionOrderMismatch) {
+			return appErrors.BadRequest("weave_ids must list every weave in the collection exactly once")
+		}
+		return appErrors.InternalServerError("Failed to reorder collection")
+	}
+	return nil
+}
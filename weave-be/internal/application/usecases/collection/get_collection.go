@@ -0,0 +1,118 @@
+package collection
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// GetCollectionUseCase handles retrieving a single collection
+type GetCollectionUseCase struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+// NewGetCollectionUseCase creates a new GetCollectionUseCase
+func NewGetCollectionUseCase(collectionRepo repositories.CollectionRepository) *GetCollectionUseCase {
+	return &GetCollectionUseCase{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Execute returns a public collection, or a private one to its owner
+func (uc *GetCollectionUseCase) Execute(ctx context.Context, query queries.GetCollectionQuery) (*dto.CollectionResponse, error) {
+	collection, err := findVisibleCollection(ctx, uc.collectionRepo, query.CollectionID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CollectionToResponse(collection), nil
+}
+
+// GetCollectionWeavesUseCase handles listing the weaves of a collection
+type GetCollectionWeavesUseCase struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+// NewGetCollectionWeavesUseCase creates a new GetCollectionWeavesUseCase
+func NewGetCollectionWeavesUseCase(collectionRepo repositories.CollectionRepository) *GetCollectionWeavesUseCase {
+	return &GetCollectionWeavesUseCase{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Execute lists the weaves of a collection in order.
+// Only the owner sees the unpublished weaves they collected.
+func (uc *GetCollectionWeavesUseCase) Execute(ctx context.Context, query queries.GetCollectionWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	collection, err := findVisibleCollection(ctx, uc.collectionRepo, query.CollectionID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner := query.ViewerID != nil && collection.CanBeEditedBy(*query.ViewerID)
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.collectionRepo.GetWeaves(ctx, collection.ID, isOwner, query.Limit, offset)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get collection weaves")
+	}
+
+	total, err := uc.collectionRepo.CountWeaves(ctx, collection.ID, isOwner)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count collection weaves")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// ListUserCollectionsUseCase handles listing a user's collections
+type ListUserCollectionsUseCase struct {
+	collectionRepo repositories.CollectionRepository
+	userRepo       repositories.UserRepository
+}
+
+// NewListUserCollectionsUseCase creates a new ListUserCollectionsUseCase
+func NewListUserCollectionsUseCase(collectionRepo repositories.CollectionRepository, userRepo repositories.UserRepository) *ListUserCollectionsUseCase {
+	return &ListUserCollectionsUseCase{
+		collectionRepo: collectionRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// Execute lists a user's public collections, and their private ones too when they ask themselves
+func (uc *ListUserCollectionsUseCase) Execute(ctx context.Context, query queries.ListUserCollectionsQuery) (*dto.PaginatedCollectionsResponse, error) {
+	if _, err := uc.userRepo.GetByID(ctx, query.UserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.ErrUserNotFound
+		}
+		return nil, appErrors.InternalServerError("Failed to get user")
+	}
+
+	includePrivate := query.ViewerID != nil && *query.ViewerID == query.UserID
+	offset := (query.Page - 1) * query.Limit
+
+	collections, err := uc.collectionRepo.GetByUser(ctx, query.UserID, includePrivate, query.Limit, offset)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get collections")
+	}
+
+	total, err := uc.collectionRepo.CountByUser(ctx, query.UserID, includePrivate)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count collections")
+	}
+
+	return &dto.PaginatedCollectionsResponse{
+		Collections: dto.CollectionsToResponse(collections),
+		Page:        query.Page,
+		Limit:       query.Limit,
+		Total:       int(total),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// CreateCollectionUseCase handles creating weave collections
+type CreateCollectionUseCase struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+// NewCreateCollectionUseCase creates a new CreateCollectionUseCase
+func NewCreateCollectionUseCase(collectionRepo repositories.CollectionRepository) *CreateCollectionUseCase {
+	return &CreateCollectionUseCase{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Execute creates an empty collection owned by the user
+func (uc *CreateCollectionUseCase) Execute(ctx context.Context, cmd commands.CreateCollectionCommand) (*dto.CollectionResponse, error) {
+	collection := entities.NewCollection(cmd.UserID, strings.TrimSpace(cmd.Name), cmd.Description, cmd.IsPublic)
+	if err := uc.collectionRepo.Create(ctx, collection); err != nil {
+		return nil, appErrors.InternalServerError("Failed to create collection")
+	}
+	return dto.CollectionToResponse(collection), nil
+}
+
+// UpdateCollectionUseCase handles renaming collections and changing their visibility
+type UpdateCollectionUseCase struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+// NewUpdateCollectionUseCase creates a new UpdateCollectionUseCase
+func NewUpdateCollectionUseCase(collectionRepo repositories.CollectionRepository) *UpdateCollectionUseCase {
+	return &UpdateCollectionUseCase{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Execute applies the given fields to a collection owned by the user
+func (uc *UpdateCollectionUseCase) Execute(ctx context.Context, cmd commands.UpdateCollectionCommand) (*dto.CollectionResponse, error) {
+	collection, err := findEditableCollection(ctx, uc.collectionRepo, cmd.CollectionID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := cmd.Name
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		name = &trimmed
+	}
+	collection.Update(name, cmd.Description, cmd.IsPublic)
+
+	if err := uc.collectionRepo.Update(ctx, collection); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Collection not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to update collection")
+	}
+	return dto.CollectionToResponse(collection), nil
+}
+
+// DeleteCollectionUseCase handles deleting collections
+type DeleteCollectionUseCase struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+// NewDeleteCollectionUseCase creates a new DeleteCollectionUseCase
+func NewDeleteCollectionUseCase(collectionRepo repositories.CollectionRepository) *DeleteCollectionUseCase {
+	return &DeleteCollectionUseCase{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Execute deletes a collection owned by the user; its weaves are not affected
+func (uc *DeleteCollectionUseCase) Execute(ctx context.Context, cmd commands.DeleteCollectionCommand) error {
+	collection, err := findEditableCollection(ctx, uc.collectionRepo, cmd.CollectionID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.collectionRepo.Delete(ctx, collection.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.NotFound("Collection not found")
+		}
+		return appErrors.InternalServerError("Failed to delete collection")
+	}
+	return nil
+}
+
+// findCollection loads a collection and maps repository errors to application errors
+func findCollection(ctx context.Context, collectionRepo repositories.CollectionRepository, collectionID uuid.UUID) (*entities.Collection, error) {
+	collection, err := collectionRepo.GetByID(ctx, collectionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Collection not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get collection")
+	}
+	return collection, nil
+}
+
+// findVisibleCollection loads a collection, hiding private collections from everyone but their owner
+func findVisibleCollection(ctx context.Context, collectionRepo repositories.CollectionRepository, collectionID uuid.UUID, viewerID *uuid.UUID) (*entities.Collection, error) {
+	collection, err := findCollection(ctx, collectionRepo, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.IsVisibleTo(viewerID) {
+		return nil, appErrors.NotFound("Collection not found")
+	}
+	return collection, nil
+}
+
+// findEditableCollection loads a collection and ensures the user owns it
+func findEditableCollection(ctx context.Context, collectionRepo repositories.CollectionRepository, collectionID, userID uuid.UUID) (*entities.Collection, error) {
+	collection, err := findVisibleCollection(ctx, collectionRepo, collectionID, &userID)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.CanBeEditedBy(userID) {
+		return nil, appErrors.Forbidden("You do not have permission to edit this collection")
+	}
+	return collection, nil
+}
@@ -0,0 +1,145 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// CreateCommentUseCase handles commenting on a weave in the lab
+type CreateCommentUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewCreateCommentUseCase creates a new CreateCommentUseCase
+func NewCreateCommentUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *CreateCommentUseCase {
+	return &CreateCommentUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute adds a comment to a weave the user can see. An anchor must point at
+// content that exists in the current version; it is pinned to that version and
+// the text its range covers, so later versions can carry it along. Replies
+// join the thread of their parent, down to MaxCommentDepth levels.
+func (uc *CreateCommentUseCase) Execute(ctx context.Context, cmd commands.CreateCommentCommand) (*dto.CommentResponse, error) {
+	weave, err := findVisibleWeave(ctx, uc.weaveRepo, cmd.WeaveID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment *entities.LabComment
+	if cmd.ParentCommentID != nil {
+		parent, err := uc.findParent(ctx, *cmd.ParentCommentID, weave.ID)
+		if err != nil {
+			return nil, err
+		}
+		comment = entities.NewReply(parent, cmd.UserID, cmd.Type, cmd.Content)
+	} else {
+		if cmd.Anchor != nil {
+			if err := pinAnchor(cmd.Anchor, weave); err != nil {
+				return nil, err
+			}
+		}
+		comment = entities.NewLabComment(weave.ID, cmd.UserID, cmd.Type, cmd.Content, cmd.Anchor)
+	}
+
+	if err := uc.commentRepo.Create(ctx, comment); err != nil {
+		return nil, appErrors.InternalServerError("Failed to create comment")
+	}
+
+	return dto.CommentToResponse(comment), nil
+}
+
+// findParent loads the comment being replied to and checks the reply stays within the depth limit
+func (uc *CreateCommentUseCase) findParent(ctx context.Context, parentID, weaveID uuid.UUID) (*entities.LabComment, error) {
+	parent, err := uc.commentRepo.GetByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Parent comment not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get parent comment")
+	}
+	if parent.WeaveID != weaveID {
+		return nil, appErrors.NotFound("Parent comment not found")
+	}
+
+	depth, err := uc.commentRepo.GetDepth(ctx, parent.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get thread depth")
+	}
+	if depth >= entities.MaxCommentDepth {
+		return nil, appErrors.BadRequest(fmt.Sprintf("Replies cannot be nested more than %d levels deep", entities.MaxCommentDepth))
+	}
+	return parent, nil
+}
+
+// pinAnchor checks the anchor against the weave's current content
+func pinAnchor(anchor *diff.Anchor, weave *entities.Weave) error {
+	doc, err := diff.Normalize(weave.Content)
+	if err != nil {
+		return appErrors.InternalServerError("Failed to read weave content")
+	}
+
+	if err := anchor.Pin(doc); err != nil {
+		switch {
+		case errors.Is(err, diff.ErrAnchorNotFound):
+			return appErrors.BadRequest("Anchor path does not exist in the weave content")
+		case errors.Is(err, diff.ErrInvalidAnchorRange):
+			return appErrors.BadRequest("Anchor range must lie within a text value")
+		}
+		return appErrors.BadRequest("Invalid anchor")
+	}
+	anchor.Version = weave.Version
+	return nil
+}
+
+// findVisibleWeave loads a weave, hiding unpublished weaves from everyone without a role on them
+func findVisibleWeave(ctx context.Context, weaveRepo repositories.WeaveRepository, weaveID, viewerID uuid.UUID) (*entities.Weave, error) {
+	weave, err := weaveRepo.GetByID(ctx, weaveID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.ErrWeaveNotFound
+		}
+		return nil, appErrors.InternalServerError("Failed to get weave")
+	}
+	if !weave.IsVisibleTo(&viewerID) {
+		return nil, appErrors.ErrWeaveNotFound
+	}
+	return weave, nil
+}
+
+// findVisibleComment loads a comment along with its weave, hiding both from
+// users who cannot see the weave
+func findVisibleComment(ctx context.Context, commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository, commentID, viewerID uuid.UUID) (*entities.LabComment, *entities.Weave, error) {
+	comment, err := commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, appErrors.NotFound("Comment not found")
+		}
+		return nil, nil, appErrors.InternalServerError("Failed to get comment")
+	}
+
+	weave, err := weaveRepo.GetByID(ctx, comment.WeaveID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, appErrors.NotFound("Comment not found")
+		}
+		return nil, nil, appErrors.InternalServerError("Failed to get weave")
+	}
+	if !weave.IsVisibleTo(&viewerID) {
+		return nil, nil, appErrors.NotFound("Comment not found")
+	}
+	return comment, weave, nil
+}
@@ -0,0 +1,76 @@
+package comment
+
+import (
+	"context"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// EditCommentUseCase handles changing the content of a comment
+type EditCommentUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewEditCommentUseCase creates a new EditCommentUseCase
+func NewEditCommentUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *EditCommentUseCase {
+	return &EditCommentUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute replaces the content of the author's comment, keeping the previous
+// content in its edit history
+func (uc *EditCommentUseCase) Execute(ctx context.Context, cmd commands.EditCommentCommand) (*dto.CommentResponse, error) {
+	comment, _, err := findVisibleComment(ctx, uc.commentRepo, uc.weaveRepo, cmd.CommentID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != cmd.UserID {
+		return nil, appErrors.Forbidden("Only the author can edit this comment")
+	}
+	if comment.Content == cmd.Content {
+		return dto.CommentToResponse(comment), nil
+	}
+
+	revision := comment.Edit(cmd.Content)
+	if err := uc.commentRepo.Edit(ctx, comment, revision); err != nil {
+		return nil, appErrors.InternalServerError("Failed to edit comment")
+	}
+
+	return dto.CommentToResponse(comment), nil
+}
+
+// GetCommentHistoryUseCase handles listing the earlier contents of a comment
+type GetCommentHistoryUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewGetCommentHistoryUseCase creates a new GetCommentHistoryUseCase
+func NewGetCommentHistoryUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *GetCommentHistoryUseCase {
+	return &GetCommentHistoryUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute returns the contents a comment had before its edits, newest first
+func (uc *GetCommentHistoryUseCase) Execute(ctx context.Context, query queries.GetCommentHistoryQuery) ([]*dto.CommentRevisionResponse, error) {
+	comment, _, err := findVisibleComment(ctx, uc.commentRepo, uc.weaveRepo, query.CommentID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := uc.commentRepo.GetRevisions(ctx, comment.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get comment history")
+	}
+
+	return dto.CommentRevisionsToResponse(revisions), nil
+}
@@ -0,0 +1,80 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// defaultThreadDepth is how many levels of replies a thread shows unless asked for more
+const defaultThreadDepth = 3
+
+// GetCommentThreadUseCase handles loading a comment with the replies below it
+type GetCommentThreadUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewGetCommentThreadUseCase creates a new GetCommentThreadUseCase
+func NewGetCommentThreadUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *GetCommentThreadUseCase {
+	return &GetCommentThreadUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute returns the comment with its replies nested down to the requested
+// depth, at most MaxCommentDepth. Comments whose replies were cut off by the
+// depth are flagged so the client can load them from there.
+func (uc *GetCommentThreadUseCase) Execute(ctx context.Context, query queries.GetCommentThreadQuery) (*dto.CommentThreadResponse, error) {
+	comment, _, err := findVisibleComment(ctx, uc.commentRepo, uc.weaveRepo, query.CommentID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	depth := query.Depth
+	if depth <= 0 {
+		depth = defaultThreadDepth
+	}
+	if depth > entities.MaxCommentDepth {
+		depth = entities.MaxCommentDepth
+	}
+
+	// One extra level tells whether the deepest comments have replies of their own
+	replies, err := uc.commentRepo.GetReplies(ctx, comment.ID, depth+1)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get replies")
+	}
+
+	children := make(map[uuid.UUID][]*entities.LabComment)
+	for _, reply := range replies {
+		children[*reply.ParentCommentID] = append(children[*reply.ParentCommentID], reply)
+	}
+
+	return buildThread(comment, children, depth), nil
+}
+
+// buildThread nests the replies below the comment, depth levels deep
+func buildThread(comment *entities.LabComment, children map[uuid.UUID][]*entities.LabComment, depth int) *dto.CommentThreadResponse {
+	replies := children[comment.ID]
+	comment.ReplyCount = len(replies)
+
+	thread := &dto.CommentThreadResponse{
+		CommentResponse: dto.CommentToResponse(comment),
+		Replies:         []*dto.CommentThreadResponse{},
+	}
+	if depth == 0 {
+		thread.HasMoreReplies = len(replies) > 0
+		return thread
+	}
+
+	for _, reply := range replies {
+		thread.Replies = append(thread.Replies, buildThread(reply, children, depth-1))
+	}
+	return thread
+}
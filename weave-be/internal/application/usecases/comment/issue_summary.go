@@ -0,0 +1,76 @@
+package comment
+
+import (
+	"context"
+
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// summaryIssueLimit caps how many open issues the summary lists
+const summaryIssueLimit = 10
+
+// GetIssueSummaryUseCase handles summarizing the open issues of a weave for its owner
+type GetIssueSummaryUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewGetIssueSummaryUseCase creates a new GetIssueSummaryUseCase
+func NewGetIssueSummaryUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *GetIssueSummaryUseCase {
+	return &GetIssueSummaryUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute counts the unresolved threads of the owner's weave by type and lists
+// the issues that have been open the longest
+func (uc *GetIssueSummaryUseCase) Execute(ctx context.Context, query queries.GetIssueSummaryQuery) (*dto.IssueSummaryResponse, error) {
+	weave, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if weave.UserID != query.UserID {
+		return nil, appErrors.Forbidden("Only the weave owner can view its open issues")
+	}
+
+	openByType, err := uc.commentRepo.CountOpenThreads(ctx, weave.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count open threads")
+	}
+
+	issueType := entities.CommentTypeIssue
+	unresolved, outdated := false, true
+	openIssues := repositories.CommentFilter{
+		Type:        &issueType,
+		IsResolved:  &unresolved,
+		ThreadsOnly: true,
+	}
+
+	issues, err := uc.commentRepo.GetByWeave(ctx, weave.ID, openIssues, summaryIssueLimit, 0)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get open issues")
+	}
+
+	openIssues.IsOutdated = &outdated
+	outdatedIssues, err := uc.commentRepo.CountByWeave(ctx, weave.ID, openIssues)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count outdated issues")
+	}
+
+	summary := &entities.IssueSummary{
+		OpenByType:     openByType,
+		OpenIssues:     openByType[entities.CommentTypeIssue],
+		OutdatedIssues: int(outdatedIssues),
+		Issues:         issues,
+	}
+	if len(issues) > 0 {
+		summary.OldestOpenIssueAt = &issues[0].CreatedAt
+	}
+
+	return dto.IssueSummaryToResponse(weave.ID, summary), nil
+}
@@ -0,0 +1,74 @@
+package comment
+
+import (
+	"context"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// LikeCommentUseCase handles liking a comment
+type LikeCommentUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewLikeCommentUseCase creates a new LikeCommentUseCase
+func NewLikeCommentUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *LikeCommentUseCase {
+	return &LikeCommentUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute likes a comment on a weave the user can see
+func (uc *LikeCommentUseCase) Execute(ctx context.Context, cmd commands.LikeCommentCommand) error {
+	comment, _, err := findVisibleComment(ctx, uc.commentRepo, uc.weaveRepo, cmd.CommentID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	isLiked, err := uc.commentRepo.IsLiked(ctx, comment.ID, cmd.UserID)
+	if err != nil {
+		return appErrors.InternalServerError("Failed to check like status")
+	}
+	if isLiked {
+		return appErrors.Conflict("Already liked this comment")
+	}
+
+	if err := uc.commentRepo.Like(ctx, comment.ID, cmd.UserID); err != nil {
+		return appErrors.InternalServerError("Failed to like comment")
+	}
+
+	return nil
+}
+
+// UnlikeCommentUseCase handles removing a like from a comment
+type UnlikeCommentUseCase struct {
+	commentRepo repositories.CommentRepository
+}
+
+// NewUnlikeCommentUseCase creates a new UnlikeCommentUseCase
+func NewUnlikeCommentUseCase(commentRepo repositories.CommentRepository) *UnlikeCommentUseCase {
+	return &UnlikeCommentUseCase{
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute removes the user's like from a comment
+func (uc *UnlikeCommentUseCase) Execute(ctx context.Context, cmd commands.UnlikeCommentCommand) error {
+	isLiked, err := uc.commentRepo.IsLiked(ctx, cmd.CommentID, cmd.UserID)
+	if err != nil {
+		return appErrors.InternalServerError("Failed to check like status")
+	}
+	if !isLiked {
+		return appErrors.BadRequest("Not liking this comment")
+	}
+
+	if err := uc.commentRepo.Unlike(ctx, cmd.CommentID, cmd.UserID); err != nil {
+		return appErrors.InternalServerError("Failed to unlike comment")
+	}
+
+	return nil
+}
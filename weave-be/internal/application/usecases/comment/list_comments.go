@@ -0,0 +1,59 @@
+package comment
+
+import (
+	"context"
+
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// ListCommentsUseCase handles listing the lab comments of a weave
+type ListCommentsUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewListCommentsUseCase creates a new ListCommentsUseCase
+func NewListCommentsUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *ListCommentsUseCase {
+	return &ListCommentsUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute lists the threads of a weave the viewer can see, oldest first.
+// Replies are loaded per thread.
+func (uc *ListCommentsUseCase) Execute(ctx context.Context, query queries.ListCommentsQuery) (*dto.PaginatedCommentsResponse, error) {
+	weave, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repositories.CommentFilter{
+		AnchorPath:  query.AnchorPath,
+		Type:        query.Type,
+		IsResolved:  query.IsResolved,
+		IsOutdated:  query.IsOutdated,
+		ThreadsOnly: true,
+	}
+	offset := (query.Page - 1) * query.Limit
+
+	comments, err := uc.commentRepo.GetByWeave(ctx, weave.ID, filter, query.Limit, offset)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get comments")
+	}
+
+	total, err := uc.commentRepo.CountByWeave(ctx, weave.ID, filter)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count comments")
+	}
+
+	return &dto.PaginatedCommentsResponse{
+		Comments: dto.CommentsToResponse(comments),
+		Page:     query.Page,
+		Limit:    query.Limit,
+		Total:    int(total),
+	}, nil
+}
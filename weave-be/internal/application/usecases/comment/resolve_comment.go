@@ -0,0 +1,91 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// ResolveCommentUseCase handles marking a thread as resolved
+type ResolveCommentUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewResolveCommentUseCase creates a new ResolveCommentUseCase
+func NewResolveCommentUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *ResolveCommentUseCase {
+	return &ResolveCommentUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute resolves a thread on behalf of its author or the weave owner
+func (uc *ResolveCommentUseCase) Execute(ctx context.Context, cmd commands.ResolveCommentCommand) (*dto.CommentResponse, error) {
+	comment, err := findResolvableThread(ctx, uc.commentRepo, uc.weaveRepo, cmd.CommentID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsResolved {
+		return nil, appErrors.Conflict("Thread is already resolved")
+	}
+
+	comment.Resolve(cmd.UserID)
+	if err := uc.commentRepo.UpdateResolution(ctx, comment); err != nil {
+		return nil, appErrors.InternalServerError("Failed to resolve thread")
+	}
+
+	return dto.CommentToResponse(comment), nil
+}
+
+// ReopenCommentUseCase handles reopening a resolved thread
+type ReopenCommentUseCase struct {
+	commentRepo repositories.CommentRepository
+	weaveRepo   repositories.WeaveRepository
+}
+
+// NewReopenCommentUseCase creates a new ReopenCommentUseCase
+func NewReopenCommentUseCase(commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository) *ReopenCommentUseCase {
+	return &ReopenCommentUseCase{
+		commentRepo: commentRepo,
+		weaveRepo:   weaveRepo,
+	}
+}
+
+// Execute reopens a thread on behalf of its author or the weave owner
+func (uc *ReopenCommentUseCase) Execute(ctx context.Context, cmd commands.ReopenCommentCommand) (*dto.CommentResponse, error) {
+	comment, err := findResolvableThread(ctx, uc.commentRepo, uc.weaveRepo, cmd.CommentID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !comment.IsResolved {
+		return nil, appErrors.BadRequest("Thread is not resolved")
+	}
+
+	comment.Reopen()
+	if err := uc.commentRepo.UpdateResolution(ctx, comment); err != nil {
+		return nil, appErrors.InternalServerError("Failed to reopen thread")
+	}
+
+	return dto.CommentToResponse(comment), nil
+}
+
+// findResolvableThread loads the first comment of a thread and checks the user may resolve it
+func findResolvableThread(ctx context.Context, commentRepo repositories.CommentRepository, weaveRepo repositories.WeaveRepository, commentID, userID uuid.UUID) (*entities.LabComment, error) {
+	comment, weave, err := findVisibleComment(ctx, commentRepo, weaveRepo, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsReply() {
+		return nil, appErrors.BadRequest("Only the first comment of a thread can be resolved or reopened")
+	}
+	if !comment.CanBeResolvedBy(userID, weave) {
+		return nil, appErrors.Forbidden("Only the thread author or the weave owner can resolve or reopen this thread")
+	}
+	return comment, nil
+}
@@ -0,0 +1,107 @@
+package contribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// CreateMergeRequestUseCase handles proposing a fork back to its parent weave
+type CreateMergeRequestUseCase struct {
+	contributionRepo    repositories.ContributionRepository
+	weaveRepo           repositories.WeaveRepository
+	notificationService services.NotificationService
+}
+
+// NewCreateMergeRequestUseCase creates a new CreateMergeRequestUseCase
+func NewCreateMergeRequestUseCase(
+	contributionRepo repositories.ContributionRepository,
+	weaveRepo repositories.WeaveRepository,
+	notificationService services.NotificationService,
+) *CreateMergeRequestUseCase {
+	return &CreateMergeRequestUseCase{
+		contributionRepo:    contributionRepo,
+		weaveRepo:           weaveRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Execute opens a merge request whose proposal is the fork's current content,
+// based on the parent content at the fork point, and notifies the upstream owner
+func (uc *CreateMergeRequestUseCase) Execute(ctx context.Context, cmd commands.CreateMergeRequestCommand) (*dto.ContributionResponse, error) {
+	fork, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !fork.CanBeEditedBy(cmd.UserID) {
+		return nil, appErrors.Forbidden("Only the fork owner can open a merge request")
+	}
+	if !fork.IsForked() {
+		return nil, appErrors.BadRequest("Weave is not a fork")
+	}
+
+	parent, err := lookup.Weave(ctx, uc.weaveRepo, *fork.ParentWeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !parent.IsVisibleTo(&cmd.UserID) {
+		return nil, appErrors.ErrWeaveNotFound
+	}
+
+	open, err := uc.contributionRepo.HasOpenFromSource(ctx, fork.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to check existing merge requests")
+	}
+	if open {
+		return nil, appErrors.Conflict("This fork already has an open merge request")
+	}
+
+	baseWeaveID, baseVersion := fork.UpstreamBase()
+	base, err := uc.weaveRepo.GetVersion(ctx, baseWeaveID, baseVersion)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Fork point version not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get fork point version")
+	}
+
+	contentDiff, err := diff.Compare(base.Content, fork.Content)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to compute contribution diff")
+	}
+	if contentDiff.IsEmpty() {
+		return nil, appErrors.BadRequest("Fork has no changes to propose")
+	}
+
+	contribution := entities.NewMergeRequest(fork, cmd.Title, cmd.Description, base.Content, contentDiff)
+	if err := uc.contributionRepo.Create(ctx, contribution); err != nil {
+		return nil, appErrors.InternalServerError("Failed to create merge request")
+	}
+
+	// Notifications are best effort and must not fail the request
+	if parent.UserID != cmd.UserID {
+		_ = uc.notificationService.Notify(ctx, parent.UserID, "contribution",
+			"New Merge Request",
+			fmt.Sprintf("A fork of your weave \"%s\" was proposed for merging: %s", parent.Title, contribution.Title),
+			map[string]interface{}{
+				"type":            "merge_request",
+				"contribution_id": contribution.ID.String(),
+				"weave_id":        parent.ID.String(),
+				"source_weave_id": fork.ID.String(),
+				"contributor_id":  cmd.UserID.String(),
+			},
+		)
+	}
+
+	return dto.ContributionToResponse(contribution), nil
+}
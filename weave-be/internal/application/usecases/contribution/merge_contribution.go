@@ -0,0 +1,165 @@
+package contribution
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// MergeContributionUseCase handles merging a contribution into its weave
+type MergeContributionUseCase struct {
+	contributionRepo repositories.ContributionRepository
+	weaveRepo        repositories.WeaveRepository
+	mergePolicyRepo  repositories.MergePolicyRepository
+	registry         *contenttypes.Registry
+}
+
+// NewMergeContributionUseCase creates a new MergeContributionUseCase
+func NewMergeContributionUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository, mergePolicyRepo repositories.MergePolicyRepository, registry *contenttypes.Registry) *MergeContributionUseCase {
+	return &MergeContributionUseCase{
+		contributionRepo: contributionRepo,
+		weaveRepo:        weaveRepo,
+		mergePolicyRepo:  mergePolicyRepo,
+		registry:         registry,
+	}
+}
+
+// Execute three-way merges the proposed content with the weave's current content,
+// using the content the contribution was based on as the common ancestor.
+// Contributions that do not meet the weave's merge policy are refused, and so
+// are merges whose result is not valid content of its type.
+func (uc *MergeContributionUseCase) Execute(ctx context.Context, cmd commands.MergeContributionCommand) (*dto.MergeContributionResponse, error) {
+	contribution, err := findContribution(ctx, uc.contributionRepo, cmd.ContributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	weave, err := findContributionWeave(ctx, uc.weaveRepo, contribution)
+	if err != nil {
+		return nil, err
+	}
+
+	if !weave.HasPermission(cmd.UserID, entities.PermissionMerge) {
+		return nil, appErrors.Forbidden("Only owners and maintainers can merge contributions")
+	}
+	if contribution.IsMerged() {
+		return nil, appErrors.Conflict("Contribution has already been merged")
+	}
+	if !contribution.IsOpen() {
+		return nil, appErrors.BadRequest("Rejected contributions cannot be merged")
+	}
+	if !contribution.HasProposal() {
+		return nil, appErrors.BadRequest("Contribution has no proposed content")
+	}
+
+	readiness, err := mergeReadiness(ctx, uc.contributionRepo, uc.mergePolicyRepo, weave, contribution)
+	if err != nil {
+		return nil, err
+	}
+	if !readiness.Mergeable {
+		return nil, appErrors.Conflict("Contribution does not meet the merge policy of this weave").WithData(readiness)
+	}
+
+	result, err := mergeContent(contribution, weave)
+	if err != nil {
+		return nil, err
+	}
+	if result.HasConflicts() {
+		return mergeResponse(contribution, weave, result), nil
+	}
+
+	return applyMerge(ctx, uc.contributionRepo, uc.registry, contribution, weave, cmd.UserID, result, nil)
+}
+
+// mergeResponse reports the outcome of a merge that has not been stored
+func mergeResponse(contribution *entities.Contribution, weave *entities.Weave, result *diff.MergeResult) *dto.MergeContributionResponse {
+	return &dto.MergeContributionResponse{
+		ContributionID: contribution.ID,
+		WeaveID:        weave.ID,
+		Status:         contribution.Status,
+		Conflicts:      result.Conflicts,
+	}
+}
+
+// applyMerge validates a conflict-free merge result and stores it as the weave's
+// next version, credited to the contributor, together with the review given with
+// the merge, if any
+func applyMerge(ctx context.Context, contributionRepo repositories.ContributionRepository, registry *contenttypes.Registry, contribution *entities.Contribution, weave *entities.Weave, mergedBy uuid.UUID, result *diff.MergeResult, review *entities.ContributionReview) (*dto.MergeContributionResponse, error) {
+	merged, err := toWeaveContent(result.Merged)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to build merged content")
+	}
+	if merged, err = registry.PrepareContent(merged); err != nil {
+		return nil, err
+	}
+
+	version, err := contributionRepo.Merge(ctx, contribution.ID, mergedBy, merged, weave.Version, review)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWeaveVersionConflict) {
+			return nil, appErrors.Conflict("The weave was modified during the merge, please try again")
+		}
+		if errors.Is(err, repositories.ErrContributionNotOpen) {
+			return nil, appErrors.Conflict("Contribution was merged or rejected meanwhile")
+		}
+		return nil, appErrors.InternalServerError("Failed to merge contribution")
+	}
+
+	response := mergeResponse(contribution, weave, result)
+	response.Status = entities.ContributionStatusMerged
+	response.Merged = true
+	response.Version = dto.WeaveVersionToResponse(version)
+	return response, nil
+}
+
+// mergeContent runs the three-way merge of base (original), ours (weave) and theirs (proposal)
+func mergeContent(contribution *entities.Contribution, weave *entities.Weave) (*diff.MergeResult, error) {
+	var base interface{}
+	if contribution.OriginalContent != nil {
+		base = *contribution.OriginalContent
+	}
+
+	result, err := diff.Merge(base, weave.Content, *contribution.ProposedContent)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to merge contribution content")
+	}
+	return result, nil
+}
+
+// toWeaveContent converts a normalized JSON document back into weave content
+func toWeaveContent(doc interface{}) (entities.WeaveContent, error) {
+	var content entities.WeaveContent
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return content, err
+	}
+	err = json.Unmarshal(data, &content)
+	return content, err
+}
+
+// findContribution loads a contribution and maps repository errors to application errors
+func findContribution(ctx context.Context, contributionRepo repositories.ContributionRepository, contributionID uuid.UUID) (*entities.Contribution, error) {
+	contribution, err := contributionRepo.GetByID(ctx, contributionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Contribution not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get contribution")
+	}
+	return contribution, nil
+}
+
+// findContributionWeave loads the weave a contribution targets
+func findContributionWeave(ctx context.Context, weaveRepo repositories.WeaveRepository, contribution *entities.Contribution) (*entities.Weave, error) {
+	return lookup.Weave(ctx, weaveRepo, contribution.WeaveID)
+}
@@ -0,0 +1,130 @@
+package contribution
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+)
+
+// openContribution returns a weave with a maintainer and a contribution of 4 servings on it
+func openContribution() (*entities.Weave, uuid.UUID, *entities.Contribution) {
+	weave := publishedRecipe(uuid.New())
+	maintainer := entities.NewWeaveCollaborator(weave.ID, uuid.New(), weave.UserID, entities.CollaboratorRoleMaintainer)
+	maintainer.Accept()
+	weave.Collaborators = append(weave.Collaborators, maintainer)
+
+	proposed := publishedRecipe(weave.UserID).Content
+	proposed.Data["servings"] = 4
+	contribution := entities.NewSuggestion(weave, uuid.New(), "/data/servings", "More servings", nil, proposed, nil)
+	return weave, maintainer.UserID, contribution
+}
+
+func TestMergeContributionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+
+	t.Run("maintainer merge is a new version credited to the contributor", func(t *testing.T) {
+		weave, maintainerID, contribution := openContribution()
+		var mergedBy uuid.UUID
+
+		contributionRepo := &mockContributionRepository{
+			contribution: contribution,
+			mergeFunc: func(ctx context.Context, contributionID, by uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+				if review != nil {
+					t.Errorf("Expected no review to be stored with a plain merge, got %+v", review)
+				}
+				if content.Data["servings"] != float64(4) {
+					t.Errorf("Expected the merged content to have 4 servings, got %v", content.Data["servings"])
+				}
+				mergedBy = by
+				return entities.NewWeaveVersion(weave.ID, contribution.UserID, expectedVersion+1, weave.Title, content, nil), nil
+			},
+		}
+
+		useCase := NewMergeContributionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, &mockMergePolicyRepository{}, registry)
+		result, err := useCase.Execute(ctx, commands.MergeContributionCommand{
+			ContributionID: contribution.ID,
+			UserID:         maintainerID,
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !result.Merged || result.Version.Version != 4 {
+			t.Errorf("Expected the contribution to be merged as v4, got %+v", result)
+		}
+		if mergedBy != maintainerID {
+			t.Errorf("Expected the merge to be recorded as made by %s, got %s", maintainerID, mergedBy)
+		}
+		if result.Version.UserID != contribution.UserID {
+			t.Errorf("Expected the version to be credited to the contributor %s, got %s", contribution.UserID, result.Version.UserID)
+		}
+	})
+
+	t.Run("unmet merge policy refuses the merge", func(t *testing.T) {
+		weave, maintainerID, contribution := openContribution()
+
+		// The owner approved an earlier proposal, which no longer counts
+		approval := entities.NewContributionReview(contribution.ID, weave.UserID, entities.ReviewDecisionApprove, nil)
+		approval.CreatedAt = contribution.ProposedAt.Add(-1)
+
+		contributionRepo := &mockContributionRepository{
+			contribution: contribution,
+			reviews:      []*entities.ContributionReview{approval},
+			mergeFunc: func(ctx context.Context, contributionID, by uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+				t.Fatal("Expected no merge while the policy is unmet")
+				return nil, nil
+			},
+		}
+		policy := &mockMergePolicyRepository{policy: &entities.MergePolicy{WeaveID: weave.ID, RequireOwnerApproval: true}}
+
+		useCase := NewMergeContributionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, policy, registry)
+		_, err := useCase.Execute(ctx, commands.MergeContributionCommand{
+			ContributionID: contribution.ID,
+			UserID:         maintainerID,
+		})
+
+		expectStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("contribution closed during the merge is a conflict", func(t *testing.T) {
+		weave, maintainerID, contribution := openContribution()
+
+		contributionRepo := &mockContributionRepository{
+			contribution: contribution,
+			mergeFunc: func(ctx context.Context, contributionID, by uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+				return nil, repositories.ErrContributionNotOpen
+			},
+		}
+
+		useCase := NewMergeContributionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, &mockMergePolicyRepository{}, registry)
+		_, err := useCase.Execute(ctx, commands.MergeContributionCommand{
+			ContributionID: contribution.ID,
+			UserID:         maintainerID,
+		})
+
+		expectStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("editors cannot merge", func(t *testing.T) {
+		weave, _, contribution := openContribution()
+		editor := entities.NewWeaveCollaborator(weave.ID, uuid.New(), weave.UserID, entities.CollaboratorRoleEditor)
+		editor.Accept()
+		weave.Collaborators = append(weave.Collaborators, editor)
+
+		contributionRepo := &mockContributionRepository{contribution: contribution}
+
+		useCase := NewMergeContributionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, &mockMergePolicyRepository{}, registry)
+		_, err := useCase.Execute(ctx, commands.MergeContributionCommand{
+			ContributionID: contribution.ID,
+			UserID:         editor.UserID,
+		})
+
+		expectStatus(t, err, http.StatusForbidden)
+	})
+}
@@ -0,0 +1,118 @@
+package contribution
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// GetMergePolicyUseCase handles reading the merge policy of a weave
+type GetMergePolicyUseCase struct {
+	mergePolicyRepo repositories.MergePolicyRepository
+	weaveRepo       repositories.WeaveRepository
+}
+
+// NewGetMergePolicyUseCase creates a new GetMergePolicyUseCase
+func NewGetMergePolicyUseCase(mergePolicyRepo repositories.MergePolicyRepository, weaveRepo repositories.WeaveRepository) *GetMergePolicyUseCase {
+	return &GetMergePolicyUseCase{
+		mergePolicyRepo: mergePolicyRepo,
+		weaveRepo:       weaveRepo,
+	}
+}
+
+// Execute returns the policy of a weave the viewer can see, or the default one
+func (uc *GetMergePolicyUseCase) Execute(ctx context.Context, query queries.GetMergePolicyQuery) (*dto.MergePolicyResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, query.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !weave.IsVisibleTo(&query.ViewerID) {
+		return nil, appErrors.ErrWeaveNotFound
+	}
+
+	policy, err := findMergePolicy(ctx, uc.mergePolicyRepo, weave.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.MergePolicyToResponse(policy), nil
+}
+
+// UpdateMergePolicyUseCase handles changing the merge policy of a weave
+type UpdateMergePolicyUseCase struct {
+	mergePolicyRepo repositories.MergePolicyRepository
+	weaveRepo       repositories.WeaveRepository
+}
+
+// NewUpdateMergePolicyUseCase creates a new UpdateMergePolicyUseCase
+func NewUpdateMergePolicyUseCase(mergePolicyRepo repositories.MergePolicyRepository, weaveRepo repositories.WeaveRepository) *UpdateMergePolicyUseCase {
+	return &UpdateMergePolicyUseCase{
+		mergePolicyRepo: mergePolicyRepo,
+		weaveRepo:       weaveRepo,
+	}
+}
+
+// Execute replaces the policy of the owner's weave
+func (uc *UpdateMergePolicyUseCase) Execute(ctx context.Context, cmd commands.UpdateMergePolicyCommand) (*dto.MergePolicyResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if weave.UserID != cmd.UserID {
+		return nil, appErrors.Forbidden("Only the weave owner can change its merge policy")
+	}
+
+	now := time.Now()
+	policy := &entities.MergePolicy{
+		WeaveID:                 weave.ID,
+		RequireOwnerApproval:    cmd.RequireOwnerApproval,
+		RequiredApprovals:       cmd.RequiredApprovals,
+		MinVoteScore:            cmd.MinVoteScore,
+		BlockOnChangesRequested: cmd.BlockOnChangesRequested,
+		UpdatedBy:               &cmd.UserID,
+		UpdatedAt:               &now,
+	}
+	if err := uc.mergePolicyRepo.Save(ctx, policy); err != nil {
+		return nil, appErrors.InternalServerError("Failed to save merge policy")
+	}
+
+	return dto.MergePolicyToResponse(policy), nil
+}
+
+// findMergePolicy loads the policy of a weave, falling back to the default one
+func findMergePolicy(ctx context.Context, mergePolicyRepo repositories.MergePolicyRepository, weaveID uuid.UUID) (*entities.MergePolicy, error) {
+	policy, err := mergePolicyRepo.GetByWeave(ctx, weaveID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.DefaultMergePolicy(weaveID), nil
+		}
+		return nil, appErrors.InternalServerError("Failed to get merge policy")
+	}
+	return policy, nil
+}
+
+// mergeReadiness checks the contribution against its weave's merge policy,
+// counting pending reviews that are about to be stored along with the stored ones
+func mergeReadiness(ctx context.Context, contributionRepo repositories.ContributionRepository, mergePolicyRepo repositories.MergePolicyRepository, weave *entities.Weave, contribution *entities.Contribution, pending ...*entities.ContributionReview) (*dto.MergeReadinessResponse, error) {
+	policy, err := findMergePolicy(ctx, mergePolicyRepo, weave.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := contributionRepo.GetReviews(ctx, contribution.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get reviews")
+	}
+	reviews = append(reviews, pending...)
+
+	return dto.MergeReadinessToResponse(policy, policy.Unmet(weave, contribution, reviews)), nil
+}
@@ -0,0 +1,133 @@
+package contribution
+
+import (
+	"context"
+	"errors"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// GetContributionConflictsUseCase handles checking a contribution against the latest weave version
+type GetContributionConflictsUseCase struct {
+	contributionRepo repositories.ContributionRepository
+	weaveRepo        repositories.WeaveRepository
+}
+
+// NewGetContributionConflictsUseCase creates a new GetContributionConflictsUseCase
+func NewGetContributionConflictsUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository) *GetContributionConflictsUseCase {
+	return &GetContributionConflictsUseCase{
+		contributionRepo: contributionRepo,
+		weaveRepo:        weaveRepo,
+	}
+}
+
+// Execute returns the per-path conflicts a merge into the current weave would hit
+func (uc *GetContributionConflictsUseCase) Execute(ctx context.Context, query queries.GetContributionConflictsQuery) (*dto.ContributionConflictsResponse, error) {
+	contribution, err := findContribution(ctx, uc.contributionRepo, query.ContributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	weave, err := findContributionWeave(ctx, uc.weaveRepo, contribution)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contribution.CanBeResolvedBy(query.UserID) && !weave.HasPermission(query.UserID, entities.PermissionReview) {
+		return nil, appErrors.Forbidden("You do not have permission to view this contribution")
+	}
+	if !contribution.HasProposal() {
+		return nil, appErrors.BadRequest("Contribution has no proposed content")
+	}
+
+	result, err := mergeContent(contribution, weave)
+	if err != nil {
+		return nil, err
+	}
+
+	isStale := contribution.OriginalContent == nil || !diff.Equal(*contribution.OriginalContent, weave.Content)
+
+	return &dto.ContributionConflictsResponse{
+		ContributionID: contribution.ID,
+		WeaveID:        weave.ID,
+		WeaveVersion:   weave.Version,
+		IsStale:        isStale,
+		CurrentContent: weave.Content,
+		Conflicts:      result.Conflicts,
+	}, nil
+}
+
+// ResolveContributionConflictsUseCase handles rebasing a contribution onto the latest weave version
+type ResolveContributionConflictsUseCase struct {
+	contributionRepo repositories.ContributionRepository
+	weaveRepo        repositories.WeaveRepository
+	registry         *contenttypes.Registry
+}
+
+// NewResolveContributionConflictsUseCase creates a new ResolveContributionConflictsUseCase
+func NewResolveContributionConflictsUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository, registry *contenttypes.Registry) *ResolveContributionConflictsUseCase {
+	return &ResolveContributionConflictsUseCase{
+		contributionRepo: contributionRepo,
+		weaveRepo:        weaveRepo,
+		registry:         registry,
+	}
+}
+
+// Execute replaces the proposal with the resolved content, re-baselines it on the
+// current weave content and sends the contribution back for review. The resolved
+// content must be valid for its content type.
+func (uc *ResolveContributionConflictsUseCase) Execute(ctx context.Context, cmd commands.ResolveContributionConflictsCommand) (*dto.ContributionResponse, error) {
+	contribution, err := findContribution(ctx, uc.contributionRepo, cmd.ContributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contribution.CanBeResolvedBy(cmd.UserID) {
+		return nil, appErrors.Forbidden("Only the contributor can resolve conflicts")
+	}
+	if !contribution.IsOpen() {
+		return nil, appErrors.BadRequest("Only open contributions can be resolved")
+	}
+
+	weave, err := findContributionWeave(ctx, uc.weaveRepo, contribution)
+	if err != nil {
+		return nil, err
+	}
+
+	// The resolution must have been made against the content it will be based on
+	if weave.Version != cmd.BaseVersion {
+		return nil, appErrors.Conflict("The weave has a newer version, please resolve against the latest content")
+	}
+
+	resolved, err := uc.registry.PrepareContent(cmd.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	contentDiff, err := diff.Compare(weave.Content, resolved)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to compute contribution diff")
+	}
+
+	contribution.Rebase(weave.Content, resolved, contentDiff)
+
+	// The repository checks the version again while holding the weave row
+	if err := uc.contributionRepo.Rebase(ctx, contribution, weave.Version); err != nil {
+		if errors.Is(err, repositories.ErrWeaveVersionConflict) {
+			return nil, appErrors.Conflict("The weave has a newer version, please resolve against the latest content")
+		}
+		if errors.Is(err, repositories.ErrContributionNotOpen) {
+			return nil, appErrors.Conflict("Contribution was merged or rejected meanwhile")
+		}
+		return nil, appErrors.InternalServerError("Failed to update contribution")
+	}
+
+	return dto.ContributionToResponse(contribution), nil
+}
@@ -0,0 +1,97 @@
+package contribution
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+)
+
+func TestResolveContributionConflictsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+
+	// resolution returns the weave's content with the contributor's 6 servings
+	resolution := func(weave *entities.Weave) entities.WeaveContent {
+		resolved := publishedRecipe(weave.UserID).Content
+		resolved.Data["servings"] = 6
+		return resolved
+	}
+
+	t.Run("resolution is stored against the version it was made on", func(t *testing.T) {
+		weave, _, contribution := openContribution()
+		var rebasedOn int
+
+		contributionRepo := &mockContributionRepository{
+			contribution: contribution,
+			rebaseFunc: func(ctx context.Context, rebased *entities.Contribution, expectedVersion int) error {
+				rebasedOn = expectedVersion
+				return nil
+			},
+		}
+
+		useCase := NewResolveContributionConflictsUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, registry)
+		result, err := useCase.Execute(ctx, commands.ResolveContributionConflictsCommand{
+			ContributionID: contribution.ID,
+			UserID:         contribution.UserID,
+			Content:        resolution(weave),
+			BaseVersion:    weave.Version,
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if rebasedOn != weave.Version {
+			t.Errorf("Expected the rebase to require v%d, got v%d", weave.Version, rebasedOn)
+		}
+		if result.Status != entities.ContributionStatusReviewing {
+			t.Errorf("Expected the contribution to go back to review, got %s", result.Status)
+		}
+	})
+
+	t.Run("version written during the rebase is a conflict", func(t *testing.T) {
+		weave, _, contribution := openContribution()
+
+		contributionRepo := &mockContributionRepository{
+			contribution: contribution,
+			rebaseFunc: func(ctx context.Context, rebased *entities.Contribution, expectedVersion int) error {
+				return repositories.ErrWeaveVersionConflict
+			},
+		}
+
+		useCase := NewResolveContributionConflictsUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, registry)
+		_, err := useCase.Execute(ctx, commands.ResolveContributionConflictsCommand{
+			ContributionID: contribution.ID,
+			UserID:         contribution.UserID,
+			Content:        resolution(weave),
+			BaseVersion:    weave.Version,
+		})
+
+		expectStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("resolution made on an older version is not stored", func(t *testing.T) {
+		weave, _, contribution := openContribution()
+
+		contributionRepo := &mockContributionRepository{
+			contribution: contribution,
+			rebaseFunc: func(ctx context.Context, rebased *entities.Contribution, expectedVersion int) error {
+				t.Fatal("Expected no rebase onto a version the resolution was not made on")
+				return nil
+			},
+		}
+
+		useCase := NewResolveContributionConflictsUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, registry)
+		_, err := useCase.Execute(ctx, commands.ResolveContributionConflictsCommand{
+			ContributionID: contribution.ID,
+			UserID:         contribution.UserID,
+			Content:        resolution(weave),
+			BaseVersion:    weave.Version - 1,
+		})
+
+		expectStatus(t, err, http.StatusConflict)
+	})
+}
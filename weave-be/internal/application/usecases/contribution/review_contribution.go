@@ -0,0 +1,224 @@
+package contribution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+	appErrors "weave-module/errors"
+)
+
+// reviewNotificationTitles names the notification the contributor gets for each decision
+var reviewNotificationTitles = map[entities.ReviewDecision]string{
+	entities.ReviewDecisionApprove:        "Contribution Approved",
+	entities.ReviewDecisionRequestChanges: "Changes Requested",
+	entities.ReviewDecisionReject:         "Contribution Rejected",
+}
+
+// ReviewContributionUseCase handles reviewing a contribution
+type ReviewContributionUseCase struct {
+	contributionRepo    repositories.ContributionRepository
+	weaveRepo           repositories.WeaveRepository
+	notificationService services.NotificationService
+}
+
+// NewReviewContributionUseCase creates a new ReviewContributionUseCase
+func NewReviewContributionUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository, notificationService services.NotificationService) *ReviewContributionUseCase {
+	return &ReviewContributionUseCase{
+		contributionRepo:    contributionRepo,
+		weaveRepo:           weaveRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Execute records a review and moves the contribution along: approving accepts
+// it unless another reviewer still asks for changes, requesting changes sends
+// it back to review and rejecting closes it. Reviewers may review again; only
+// their latest review counts towards the merge policy.
+func (uc *ReviewContributionUseCase) Execute(ctx context.Context, cmd commands.ReviewContributionCommand) (*dto.ContributionResponse, error) {
+	contribution, weave, err := findReviewableContribution(ctx, uc.contributionRepo, uc.weaveRepo, cmd.ContributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !weave.HasPermission(cmd.UserID, entities.PermissionReview) {
+		return nil, appErrors.Forbidden("Only owners, maintainers and reviewers can review contributions")
+	}
+	if cmd.Decision == entities.ReviewDecisionReject && !weave.HasPermission(cmd.UserID, entities.PermissionMerge) {
+		return nil, appErrors.Forbidden("Only owners and maintainers can reject contributions")
+	}
+	if contribution.UserID == cmd.UserID {
+		return nil, appErrors.BadRequest("You cannot review your own contribution")
+	}
+
+	reviews, err := uc.contributionRepo.GetReviews(ctx, contribution.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get reviews")
+	}
+
+	// The new review replaces the reviewer's earlier one
+	changesRequested := false
+	for _, review := range entities.LatestReviews(contribution.CurrentReviews(reviews)) {
+		if review.ReviewerID != cmd.UserID &&
+			review.Decision == entities.ReviewDecisionRequestChanges &&
+			weave.HasPermission(review.ReviewerID, entities.PermissionReview) {
+			changesRequested = true
+		}
+	}
+
+	review := entities.NewContributionReview(contribution.ID, cmd.UserID, cmd.Decision, cmd.Comment)
+	contribution.ApplyReview(review, changesRequested)
+	if err := uc.contributionRepo.AddReview(ctx, contribution, review); err != nil {
+		return nil, appErrors.InternalServerError("Failed to review contribution")
+	}
+
+	// Notifications are best effort and must not fail the request
+	_ = uc.notificationService.Notify(ctx, contribution.UserID, "contribution",
+		reviewNotificationTitles[cmd.Decision],
+		fmt.Sprintf("Your contribution \"%s\" to \"%s\" was reviewed", contribution.Title, weave.Title),
+		map[string]interface{}{
+			"type":            "contribution_review",
+			"contribution_id": contribution.ID.String(),
+			"weave_id":        weave.ID.String(),
+			"reviewer_id":     cmd.UserID.String(),
+			"decision":        string(cmd.Decision),
+		},
+	)
+
+	return dto.ContributionToResponse(contribution), nil
+}
+
+// AssignReviewerUseCase handles putting a reviewer in charge of a contribution
+type AssignReviewerUseCase struct {
+	contributionRepo    repositories.ContributionRepository
+	weaveRepo           repositories.WeaveRepository
+	notificationService services.NotificationService
+}
+
+// NewAssignReviewerUseCase creates a new AssignReviewerUseCase
+func NewAssignReviewerUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository, notificationService services.NotificationService) *AssignReviewerUseCase {
+	return &AssignReviewerUseCase{
+		contributionRepo:    contributionRepo,
+		weaveRepo:           weaveRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Execute assigns someone who can review the weave to an open contribution and
+// starts its review
+func (uc *AssignReviewerUseCase) Execute(ctx context.Context, cmd commands.AssignReviewerCommand) (*dto.ContributionResponse, error) {
+	contribution, weave, err := findReviewableContribution(ctx, uc.contributionRepo, uc.weaveRepo, cmd.ContributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !weave.HasPermission(cmd.UserID, entities.PermissionMerge) {
+		return nil, appErrors.Forbidden("Only owners and maintainers can assign reviewers")
+	}
+	if !weave.HasPermission(cmd.ReviewerID, entities.PermissionReview) {
+		return nil, appErrors.BadRequest("The reviewer must be able to review contributions to this weave")
+	}
+	if contribution.UserID == cmd.ReviewerID {
+		return nil, appErrors.BadRequest("Contributors cannot review their own contributions")
+	}
+
+	contribution.AssignReviewer(cmd.ReviewerID)
+	if err := uc.contributionRepo.AssignReviewer(ctx, contribution); err != nil {
+		return nil, appErrors.InternalServerError("Failed to assign reviewer")
+	}
+
+	// Notifications are best effort and must not fail the request
+	if cmd.ReviewerID != cmd.UserID {
+		_ = uc.notificationService.Notify(ctx, cmd.ReviewerID, "contribution",
+			"Review Requested",
+			fmt.Sprintf("You were asked to review \"%s\" on \"%s\"", contribution.Title, weave.Title),
+			map[string]interface{}{
+				"type":            "review_request",
+				"contribution_id": contribution.ID.String(),
+				"weave_id":        weave.ID.String(),
+				"assigned_by":     cmd.UserID.String(),
+			},
+		)
+	}
+
+	return dto.ContributionToResponse(contribution), nil
+}
+
+// GetContributionReviewsUseCase handles listing the reviews of a contribution
+type GetContributionReviewsUseCase struct {
+	contributionRepo repositories.ContributionRepository
+	weaveRepo        repositories.WeaveRepository
+	mergePolicyRepo  repositories.MergePolicyRepository
+}
+
+// NewGetContributionReviewsUseCase creates a new GetContributionReviewsUseCase
+func NewGetContributionReviewsUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository, mergePolicyRepo repositories.MergePolicyRepository) *GetContributionReviewsUseCase {
+	return &GetContributionReviewsUseCase{
+		contributionRepo: contributionRepo,
+		weaveRepo:        weaveRepo,
+		mergePolicyRepo:  mergePolicyRepo,
+	}
+}
+
+// Execute returns every review of a contribution, oldest first, along with
+// what its weave's merge policy still requires
+func (uc *GetContributionReviewsUseCase) Execute(ctx context.Context, query queries.GetContributionReviewsQuery) (*dto.ContributionReviewsResponse, error) {
+	contribution, err := findContribution(ctx, uc.contributionRepo, query.ContributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	weave, err := findContributionWeave(ctx, uc.weaveRepo, contribution)
+	if err != nil {
+		return nil, err
+	}
+	if !weave.IsVisibleTo(&query.ViewerID) {
+		return nil, appErrors.NotFound("Contribution not found")
+	}
+
+	reviews, err := uc.contributionRepo.GetReviews(ctx, contribution.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get reviews")
+	}
+
+	policy, err := findMergePolicy(ctx, uc.mergePolicyRepo, weave.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ContributionReviewsResponse{
+		ContributionID: contribution.ID,
+		Status:         contribution.Status,
+		ReviewerID:     contribution.ReviewerID,
+		VoteScore:      contribution.VoteScore,
+		Reviews:        dto.ContributionReviewsToResponse(contribution, reviews),
+		Readiness:      dto.MergeReadinessToResponse(policy, policy.Unmet(weave, contribution, reviews)),
+	}, nil
+}
+
+// findReviewableContribution loads an open contribution together with its weave
+func findReviewableContribution(ctx context.Context, contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository, contributionID uuid.UUID) (*entities.Contribution, *entities.Weave, error) {
+	contribution, err := findContribution(ctx, contributionRepo, contributionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	weave, err := findContributionWeave(ctx, weaveRepo, contribution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if contribution.IsMerged() {
+		return nil, nil, appErrors.Conflict("Contribution has already been merged")
+	}
+	if !contribution.IsOpen() {
+		return nil, nil, appErrors.BadRequest("Rejected contributions cannot be reviewed")
+	}
+	return contribution, weave, nil
+}
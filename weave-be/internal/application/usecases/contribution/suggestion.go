@@ -0,0 +1,200 @@
+package contribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// CreateSuggestionUseCase handles suggesting a change to a single value of a weave
+type CreateSuggestionUseCase struct {
+	contributionRepo    repositories.ContributionRepository
+	weaveRepo           repositories.WeaveRepository
+	registry            *contenttypes.Registry
+	notificationService services.NotificationService
+}
+
+// NewCreateSuggestionUseCase creates a new CreateSuggestionUseCase
+func NewCreateSuggestionUseCase(
+	contributionRepo repositories.ContributionRepository,
+	weaveRepo repositories.WeaveRepository,
+	registry *contenttypes.Registry,
+	notificationService services.NotificationService,
+) *CreateSuggestionUseCase {
+	return &CreateSuggestionUseCase{
+		contributionRepo:    contributionRepo,
+		weaveRepo:           weaveRepo,
+		registry:            registry,
+		notificationService: notificationService,
+	}
+}
+
+// Execute builds the proposed content by replacing the value at the path in
+// the weave's current content, opens a suggestion with it and notifies the owner.
+// The proposed content must be valid for the weave's content type. Users who
+// can edit the weave change it directly instead.
+func (uc *CreateSuggestionUseCase) Execute(ctx context.Context, cmd commands.CreateSuggestionCommand) (*dto.ContributionResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !weave.IsVisibleTo(&cmd.UserID) {
+		return nil, appErrors.ErrWeaveNotFound
+	}
+	if weave.HasPermission(cmd.UserID, entities.PermissionEdit) {
+		return nil, appErrors.BadRequest("You can edit this weave directly")
+	}
+
+	current, err := diff.Normalize(weave.Content)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to read weave content")
+	}
+	replaced, err := diff.Replace(current, cmd.Path, cmd.Value)
+	if err != nil {
+		if errors.Is(err, diff.ErrPathNotFound) {
+			return nil, appErrors.BadRequest("Path does not exist in the weave content")
+		}
+		return nil, appErrors.BadRequest("Invalid suggested value")
+	}
+
+	contentDiff := diff.Values(current, replaced)
+	if contentDiff.IsEmpty() {
+		return nil, appErrors.BadRequest("Suggested value is the same as the current one")
+	}
+	proposed, err := toWeaveContent(replaced)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to build proposed content")
+	}
+	if proposed, err = uc.registry.PrepareContent(proposed); err != nil {
+		return nil, err
+	}
+
+	contribution := entities.NewSuggestion(weave, cmd.UserID, cmd.Path, cmd.Title, cmd.Description, proposed, contentDiff)
+	if err := uc.contributionRepo.Create(ctx, contribution); err != nil {
+		return nil, appErrors.InternalServerError("Failed to create suggestion")
+	}
+
+	// Notifications are best effort and must not fail the request
+	_ = uc.notificationService.Notify(ctx, weave.UserID, "contribution",
+		"New Suggestion",
+		fmt.Sprintf("A change was suggested to your weave \"%s\": %s", weave.Title, contribution.Title),
+		map[string]interface{}{
+			"type":            "suggestion",
+			"contribution_id": contribution.ID.String(),
+			"weave_id":        weave.ID.String(),
+			"contributor_id":  cmd.UserID.String(),
+			"path":            cmd.Path,
+		},
+	)
+
+	return dto.ContributionToResponse(contribution), nil
+}
+
+// AcceptSuggestionUseCase handles approving and applying a suggestion in one step
+type AcceptSuggestionUseCase struct {
+	contributionRepo    repositories.ContributionRepository
+	weaveRepo           repositories.WeaveRepository
+	mergePolicyRepo     repositories.MergePolicyRepository
+	registry            *contenttypes.Registry
+	notificationService services.NotificationService
+}
+
+// NewAcceptSuggestionUseCase creates a new AcceptSuggestionUseCase
+func NewAcceptSuggestionUseCase(
+	contributionRepo repositories.ContributionRepository,
+	weaveRepo repositories.WeaveRepository,
+	mergePolicyRepo repositories.MergePolicyRepository,
+	registry *contenttypes.Registry,
+	notificationService services.NotificationService,
+) *AcceptSuggestionUseCase {
+	return &AcceptSuggestionUseCase{
+		contributionRepo:    contributionRepo,
+		weaveRepo:           weaveRepo,
+		mergePolicyRepo:     mergePolicyRepo,
+		registry:            registry,
+		notificationService: notificationService,
+	}
+}
+
+// Execute approves the suggestion on behalf of the user and merges it as the
+// weave's next version, credited to the suggester. The approval counts towards
+// the merge policy and is stored in the same transaction as the merge; nothing
+// is stored when the policy is still unmet, the suggested value conflicts with
+// changes made since or the merged content is invalid.
+func (uc *AcceptSuggestionUseCase) Execute(ctx context.Context, cmd commands.AcceptSuggestionCommand) (*dto.MergeContributionResponse, error) {
+	contribution, err := findContribution(ctx, uc.contributionRepo, cmd.ContributionID)
+	if err != nil {
+		return nil, err
+	}
+	if !contribution.IsSuggestion() {
+		return nil, appErrors.BadRequest("Contribution is not a suggestion")
+	}
+
+	weave, err := findContributionWeave(ctx, uc.weaveRepo, contribution)
+	if err != nil {
+		return nil, err
+	}
+
+	if !weave.HasPermission(cmd.UserID, entities.PermissionMerge) {
+		return nil, appErrors.Forbidden("Only owners and maintainers can accept suggestions")
+	}
+	if contribution.UserID == cmd.UserID {
+		return nil, appErrors.BadRequest("You cannot accept your own suggestion")
+	}
+	if contribution.IsMerged() {
+		return nil, appErrors.Conflict("Suggestion has already been accepted")
+	}
+	if !contribution.IsOpen() {
+		return nil, appErrors.BadRequest("Rejected suggestions cannot be accepted")
+	}
+	if !contribution.HasProposal() {
+		return nil, appErrors.BadRequest("Contribution has no proposed content")
+	}
+
+	review := entities.NewContributionReview(contribution.ID, cmd.UserID, entities.ReviewDecisionApprove, nil)
+	readiness, err := mergeReadiness(ctx, uc.contributionRepo, uc.mergePolicyRepo, weave, contribution, review)
+	if err != nil {
+		return nil, err
+	}
+	if !readiness.Mergeable {
+		return nil, appErrors.Conflict("Suggestion does not meet the merge policy of this weave").WithData(readiness)
+	}
+
+	result, err := mergeContent(contribution, weave)
+	if err != nil {
+		return nil, err
+	}
+	if result.HasConflicts() {
+		return mergeResponse(contribution, weave, result), nil
+	}
+
+	response, err := applyMerge(ctx, uc.contributionRepo, uc.registry, contribution, weave, cmd.UserID, result, review)
+	if err != nil {
+		return nil, err
+	}
+
+	// Notifications are best effort and must not fail the request
+	_ = uc.notificationService.Notify(ctx, contribution.UserID, "contribution",
+		"Suggestion Accepted",
+		fmt.Sprintf("Your suggestion \"%s\" was applied to \"%s\"", contribution.Title, weave.Title),
+		map[string]interface{}{
+			"type":            "suggestion_accepted",
+			"contribution_id": contribution.ID.String(),
+			"weave_id":        weave.ID.String(),
+			"accepted_by":     cmd.UserID.String(),
+			"version":         response.Version.Version,
+		},
+	)
+
+	return response, nil
+}
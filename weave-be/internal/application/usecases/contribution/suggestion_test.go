@@ -0,0 +1,292 @@
+package contribution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// Mock ContributionRepository for testing; methods a test does not set panic
+type mockContributionRepository struct {
+	repositories.ContributionRepository
+	contribution *entities.Contribution
+	reviews      []*entities.ContributionReview
+	createFunc   func(ctx context.Context, contribution *entities.Contribution) error
+	mergeFunc    func(ctx context.Context, contributionID, mergedBy uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error)
+	rebaseFunc   func(ctx context.Context, contribution *entities.Contribution, expectedVersion int) error
+}
+
+func (m *mockContributionRepository) Create(ctx context.Context, contribution *entities.Contribution) error {
+	return m.createFunc(ctx, contribution)
+}
+
+func (m *mockContributionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Contribution, error) {
+	if m.contribution == nil || m.contribution.ID != id {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return m.contribution, nil
+}
+
+func (m *mockContributionRepository) GetReviews(ctx context.Context, contributionID uuid.UUID) ([]*entities.ContributionReview, error) {
+	return m.reviews, nil
+}
+
+func (m *mockContributionRepository) Merge(ctx context.Context, contributionID, mergedBy uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+	return m.mergeFunc(ctx, contributionID, mergedBy, content, expectedVersion, review)
+}
+
+func (m *mockContributionRepository) Rebase(ctx context.Context, contribution *entities.Contribution, expectedVersion int) error {
+	return m.rebaseFunc(ctx, contribution, expectedVersion)
+}
+
+// Mock WeaveRepository for testing
+type mockWeaveRepository struct {
+	repositories.WeaveRepository
+	weave *entities.Weave
+}
+
+func (m *mockWeaveRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+	if m.weave.ID != id {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return m.weave, nil
+}
+
+// Mock MergePolicyRepository for testing; a nil policy is the default one
+type mockMergePolicyRepository struct {
+	repositories.MergePolicyRepository
+	policy *entities.MergePolicy
+}
+
+func (m *mockMergePolicyRepository) GetByWeave(ctx context.Context, weaveID uuid.UUID) (*entities.MergePolicy, error) {
+	if m.policy == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return m.policy, nil
+}
+
+// Mock NotificationService for testing
+type mockNotificationService struct{}
+
+func (m *mockNotificationService) Notify(ctx context.Context, userID uuid.UUID, notificationType, title, message string, data map[string]interface{}) error {
+	return nil
+}
+
+func newTestRegistry(t *testing.T) *contenttypes.Registry {
+	t.Helper()
+	registry, err := contenttypes.NewDefaultRegistry()
+	if err != nil {
+		t.Fatalf("Expected built-in content types to load, got %v", err)
+	}
+	return registry
+}
+
+// publishedRecipe returns a published recipe weave at version 3
+func publishedRecipe(ownerID uuid.UUID) *entities.Weave {
+	weave := entities.NewWeave(ownerID, uuid.New(), "Weekend ramen", entities.WeaveContent{
+		Type: "recipe",
+		Data: map[string]interface{}{
+			"ingredients": []interface{}{
+				map[string]interface{}{"name": "Ramen noodles", "amount": "2", "unit": "packs"},
+			},
+			"instructions": []interface{}{
+				map[string]interface{}{"step": 1, "description": "Boil water"},
+			},
+			"servings":   2,
+			"difficulty": "easy",
+		},
+	})
+	weave.Status = entities.WeaveStatusPublished
+	weave.Version = 3
+	return weave
+}
+
+// expectStatus checks that err is an application error with the HTTP status
+func expectStatus(t *testing.T, err error, status int) *appErrors.AppError {
+	t.Helper()
+	appErr, ok := err.(*appErrors.AppError)
+	if !ok || appErr.Code != status {
+		t.Fatalf("Expected a %d error, got %v", status, err)
+	}
+	return appErr
+}
+
+func TestCreateSuggestionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+
+	t.Run("valid value opens a suggestion with its diff", func(t *testing.T) {
+		weave := publishedRecipe(uuid.New())
+		suggesterID := uuid.New()
+		var created *entities.Contribution
+
+		contributionRepo := &mockContributionRepository{
+			createFunc: func(ctx context.Context, contribution *entities.Contribution) error {
+				created = contribution
+				return nil
+			},
+		}
+
+		useCase := NewCreateSuggestionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, registry, &mockNotificationService{})
+		result, err := useCase.Execute(ctx, commands.CreateSuggestionCommand{
+			WeaveID: weave.ID,
+			UserID:  suggesterID,
+			Path:    "/data/servings",
+			Value:   json.RawMessage(`4`),
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if created == nil || created.UserID != suggesterID || !created.IsSuggestion() {
+			t.Fatalf("Expected a suggestion by %s to be stored, got %+v", suggesterID, created)
+		}
+		if servings := created.ProposedContent.Data["servings"]; servings != float64(4) {
+			t.Errorf("Expected 4 servings to be proposed, got %v", servings)
+		}
+		if result.ContentDiff == nil || result.ContentDiff.IsEmpty() {
+			t.Error("Expected the suggestion to carry its diff")
+		}
+	})
+
+	t.Run("value the content type rejects is not stored", func(t *testing.T) {
+		weave := publishedRecipe(uuid.New())
+
+		contributionRepo := &mockContributionRepository{
+			createFunc: func(ctx context.Context, contribution *entities.Contribution) error {
+				t.Fatal("Expected no suggestion to be stored for invalid content")
+				return nil
+			},
+		}
+
+		useCase := NewCreateSuggestionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, registry, &mockNotificationService{})
+		_, err := useCase.Execute(ctx, commands.CreateSuggestionCommand{
+			WeaveID: weave.ID,
+			UserID:  uuid.New(),
+			Path:    "/data/servings",
+			Value:   json.RawMessage(`"lots"`),
+		})
+
+		expectStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("editors change the weave directly", func(t *testing.T) {
+		weave := publishedRecipe(uuid.New())
+		editor := entities.NewWeaveCollaborator(weave.ID, uuid.New(), weave.UserID, entities.CollaboratorRoleEditor)
+		editor.Accept()
+		weave.Collaborators = append(weave.Collaborators, editor)
+
+		useCase := NewCreateSuggestionUseCase(&mockContributionRepository{}, &mockWeaveRepository{weave: weave}, registry, &mockNotificationService{})
+		_, err := useCase.Execute(ctx, commands.CreateSuggestionCommand{
+			WeaveID: weave.ID,
+			UserID:  editor.UserID,
+			Path:    "/data/servings",
+			Value:   json.RawMessage(`4`),
+		})
+
+		expectStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestAcceptSuggestionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+
+	// openSuggestion returns a weave and a suggestion of 4 servings on it
+	openSuggestion := func() (*entities.Weave, *entities.Contribution) {
+		weave := publishedRecipe(uuid.New())
+		proposed := publishedRecipe(weave.UserID).Content
+		proposed.Data["servings"] = 4
+		return weave, entities.NewSuggestion(weave, uuid.New(), "/data/servings", "", nil, proposed, nil)
+	}
+
+	t.Run("accepting stores the approval with the merge", func(t *testing.T) {
+		weave, suggestion := openSuggestion()
+		var mergedBy uuid.UUID
+		var stored *entities.ContributionReview
+
+		contributionRepo := &mockContributionRepository{
+			contribution: suggestion,
+			mergeFunc: func(ctx context.Context, contributionID, by uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+				if expectedVersion != 3 {
+					t.Errorf("Expected the merge to be based on v3, got v%d", expectedVersion)
+				}
+				mergedBy, stored = by, review
+				return entities.NewWeaveVersion(weave.ID, suggestion.UserID, 4, weave.Title, content, nil), nil
+			},
+		}
+
+		useCase := NewAcceptSuggestionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, &mockMergePolicyRepository{}, registry, &mockNotificationService{})
+		result, err := useCase.Execute(ctx, commands.AcceptSuggestionCommand{
+			ContributionID: suggestion.ID,
+			UserID:         weave.UserID,
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !result.Merged || result.Version.Version != 4 {
+			t.Errorf("Expected the suggestion to be merged as v4, got %+v", result)
+		}
+		if mergedBy != weave.UserID {
+			t.Errorf("Expected the merge to be made by the owner %s, got %s", weave.UserID, mergedBy)
+		}
+		if stored == nil || stored.ReviewerID != weave.UserID || stored.Decision != entities.ReviewDecisionApprove {
+			t.Errorf("Expected the owner's approval to be stored with the merge, got %+v", stored)
+		}
+		if result.Version.UserID != suggestion.UserID {
+			t.Errorf("Expected the version to be credited to the suggester %s, got %s", suggestion.UserID, result.Version.UserID)
+		}
+	})
+
+	t.Run("unmet merge policy stores nothing", func(t *testing.T) {
+		weave, suggestion := openSuggestion()
+
+		contributionRepo := &mockContributionRepository{
+			contribution: suggestion,
+			mergeFunc: func(ctx context.Context, contributionID, by uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+				t.Fatal("Expected no merge while the policy is unmet")
+				return nil, nil
+			},
+		}
+		policy := &mockMergePolicyRepository{policy: &entities.MergePolicy{WeaveID: weave.ID, RequiredApprovals: 2}}
+
+		useCase := NewAcceptSuggestionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, policy, registry, &mockNotificationService{})
+		_, err := useCase.Execute(ctx, commands.AcceptSuggestionCommand{
+			ContributionID: suggestion.ID,
+			UserID:         weave.UserID,
+		})
+
+		expectStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("merged content the content type rejects is not stored", func(t *testing.T) {
+		weave, suggestion := openSuggestion()
+		suggestion.ProposedContent.Data["difficulty"] = "impossible"
+
+		contributionRepo := &mockContributionRepository{
+			contribution: suggestion,
+			mergeFunc: func(ctx context.Context, contributionID, by uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+				t.Fatal("Expected no merge of invalid content")
+				return nil, nil
+			},
+		}
+
+		useCase := NewAcceptSuggestionUseCase(contributionRepo, &mockWeaveRepository{weave: weave}, &mockMergePolicyRepository{}, registry, &mockNotificationService{})
+		_, err := useCase.Execute(ctx, commands.AcceptSuggestionCommand{
+			ContributionID: suggestion.ID,
+			UserID:         weave.UserID,
+		})
+
+		expectStatus(t, err, http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,108 @@
+package contribution
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// VoteContributionUseCase handles casting or changing a vote on a contribution
+type VoteContributionUseCase struct {
+	contributionRepo repositories.ContributionRepository
+	weaveRepo        repositories.WeaveRepository
+}
+
+// NewVoteContributionUseCase creates a new VoteContributionUseCase
+func NewVoteContributionUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository) *VoteContributionUseCase {
+	return &VoteContributionUseCase{
+		contributionRepo: contributionRepo,
+		weaveRepo:        weaveRepo,
+	}
+}
+
+// Execute records the user's vote on an open contribution to a weave they can
+// see. Each user holds one vote per contribution; voting again replaces it.
+func (uc *VoteContributionUseCase) Execute(ctx context.Context, cmd commands.VoteContributionCommand) (*dto.ContributionVoteResponse, error) {
+	contribution, err := findVotableContribution(ctx, uc.contributionRepo, uc.weaveRepo, cmd.ContributionID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := uc.contributionRepo.Vote(ctx, contribution.ID, cmd.UserID, cmd.Vote)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to record vote")
+	}
+
+	vote := cmd.Vote
+	return &dto.ContributionVoteResponse{
+		ContributionID: contribution.ID,
+		Vote:           &vote,
+		VoteScore:      score,
+	}, nil
+}
+
+// RemoveContributionVoteUseCase handles withdrawing a vote on a contribution
+type RemoveContributionVoteUseCase struct {
+	contributionRepo repositories.ContributionRepository
+	weaveRepo        repositories.WeaveRepository
+}
+
+// NewRemoveContributionVoteUseCase creates a new RemoveContributionVoteUseCase
+func NewRemoveContributionVoteUseCase(contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository) *RemoveContributionVoteUseCase {
+	return &RemoveContributionVoteUseCase{
+		contributionRepo: contributionRepo,
+		weaveRepo:        weaveRepo,
+	}
+}
+
+// Execute withdraws the user's vote from an open contribution
+func (uc *RemoveContributionVoteUseCase) Execute(ctx context.Context, cmd commands.RemoveContributionVoteCommand) (*dto.ContributionVoteResponse, error) {
+	contribution, err := findVotableContribution(ctx, uc.contributionRepo, uc.weaveRepo, cmd.ContributionID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := uc.contributionRepo.RemoveVote(ctx, contribution.ID, cmd.UserID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrVoteNotFound) {
+			return nil, appErrors.BadRequest("You have not voted on this contribution")
+		}
+		return nil, appErrors.InternalServerError("Failed to remove vote")
+	}
+
+	return &dto.ContributionVoteResponse{
+		ContributionID: contribution.ID,
+		VoteScore:      score,
+	}, nil
+}
+
+// findVotableContribution loads an open contribution the user may vote on;
+// scores are frozen once a contribution is merged or rejected
+func findVotableContribution(ctx context.Context, contributionRepo repositories.ContributionRepository, weaveRepo repositories.WeaveRepository, contributionID, userID uuid.UUID) (*entities.Contribution, error) {
+	contribution, err := findContribution(ctx, contributionRepo, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	weave, err := findContributionWeave(ctx, weaveRepo, contribution)
+	if err != nil {
+		return nil, err
+	}
+	if !weave.IsVisibleTo(&userID) {
+		return nil, appErrors.NotFound("Contribution not found")
+	}
+
+	if contribution.UserID == userID {
+		return nil, appErrors.BadRequest("You cannot vote on your own contribution")
+	}
+	if !contribution.IsOpen() {
+		return nil, appErrors.BadRequest("Only open contributions can be voted on")
+	}
+	return contribution, nil
+}
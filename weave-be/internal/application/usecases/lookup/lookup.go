@@ -0,0 +1,26 @@
+// Package lookup loads entities shared by several use case packages and maps
+// repository errors to application errors the same way everywhere.
+package lookup
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// Weave loads a weave and maps repository errors to application errors
+func Weave(ctx context.Context, weaveRepo repositories.WeaveRepository, weaveID uuid.UUID) (*entities.Weave, error) {
+	weave, err := weaveRepo.GetByID(ctx, weaveID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.ErrWeaveNotFound
+		}
+		return nil, appErrors.InternalServerError("Failed to get weave")
+	}
+	return weave, nil
+}
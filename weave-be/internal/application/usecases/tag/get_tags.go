@@ -0,0 +1,138 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+const (
+	defaultTagSuggestions = 10
+	maxTagSuggestions     = 50
+)
+
+// AutocompleteTagsUseCase handles suggesting tags while a user types
+type AutocompleteTagsUseCase struct {
+	tagRepo repositories.TagRepository
+}
+
+// NewAutocompleteTagsUseCase creates a new AutocompleteTagsUseCase
+func NewAutocompleteTagsUseCase(tagRepo repositories.TagRepository) *AutocompleteTagsUseCase {
+	return &AutocompleteTagsUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute suggests tags in use that start with the typed prefix, or whose known
+// aliases do, the most used first. Without a prefix the most used tags are returned.
+func (uc *AutocompleteTagsUseCase) Execute(ctx context.Context, query queries.AutocompleteTagsQuery) ([]*dto.TagResponse, error) {
+	prefix := entities.NormalizeTag(query.Prefix)
+
+	tags, err := uc.tagRepo.Autocomplete(ctx, prefix, entities.TagsAliasedBy(prefix), suggestionLimit(query.Limit))
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get tags")
+	}
+	return dto.TagsToResponse(tags), nil
+}
+
+// GetTagWeavesUseCase handles listing the published weaves carrying a tag
+type GetTagWeavesUseCase struct {
+	tagRepo   repositories.TagRepository
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetTagWeavesUseCase creates a new GetTagWeavesUseCase
+func NewGetTagWeavesUseCase(tagRepo repositories.TagRepository, weaveRepo repositories.WeaveRepository) *GetTagWeavesUseCase {
+	return &GetTagWeavesUseCase{
+		tagRepo:   tagRepo,
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists the published weaves carrying the tag, the most liked first.
+// Any spelling of the tag that normalizes or aliases to it may be used.
+func (uc *GetTagWeavesUseCase) Execute(ctx context.Context, query queries.GetTagWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	tag, err := findTag(ctx, uc.tagRepo, query.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.SearchByTags(ctx, []string{tag.Name}, query.Limit, offset)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get tagged weaves")
+	}
+
+	total, err := uc.weaveRepo.CountByTags(ctx, []string{tag.Name})
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count tagged weaves")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// GetRelatedTagsUseCase handles suggesting tags that go together with a tag
+type GetRelatedTagsUseCase struct {
+	tagRepo repositories.TagRepository
+}
+
+// NewGetRelatedTagsUseCase creates a new GetRelatedTagsUseCase
+func NewGetRelatedTagsUseCase(tagRepo repositories.TagRepository) *GetRelatedTagsUseCase {
+	return &GetRelatedTagsUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute returns the tags found most often on the same published weaves as the tag
+func (uc *GetRelatedTagsUseCase) Execute(ctx context.Context, query queries.GetRelatedTagsQuery) ([]*dto.RelatedTagResponse, error) {
+	tag, err := findTag(ctx, uc.tagRepo, query.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	related, err := uc.tagRepo.GetRelated(ctx, tag.ID, suggestionLimit(query.Limit))
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get related tags")
+	}
+	return dto.RelatedTagsToResponse(related), nil
+}
+
+// findTag loads a tag by any spelling that normalizes to its name or to a name it absorbed
+func findTag(ctx context.Context, tagRepo repositories.TagRepository, name string) (*entities.Tag, error) {
+	normalized := entities.NormalizeTag(name)
+	if normalized == "" {
+		return nil, appErrors.NotFound("Tag not found")
+	}
+
+	tag, err := tagRepo.GetByName(ctx, normalized)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Tag not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get tag")
+	}
+	return tag, nil
+}
+
+// suggestionLimit applies the default and the cap to the number of suggested tags
+func suggestionLimit(limit int) int {
+	if limit <= 0 {
+		return defaultTagSuggestions
+	}
+	if limit > maxTagSuggestions {
+		return maxTagSuggestions
+	}
+	return limit
+}
@@ -0,0 +1,75 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// MergeTagsUseCase handles folding a duplicate tag into another one
+type MergeTagsUseCase struct {
+	tagRepo  repositories.TagRepository
+	userRepo repositories.UserRepository
+}
+
+// NewMergeTagsUseCase creates a new MergeTagsUseCase
+func NewMergeTagsUseCase(tagRepo repositories.TagRepository, userRepo repositories.UserRepository) *MergeTagsUseCase {
+	return &MergeTagsUseCase{
+		tagRepo:  tagRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Execute moves every weave of the source tag onto the target and deletes the source.
+// The source's name keeps working as an alias, so later uses land on the target.
+func (uc *MergeTagsUseCase) Execute(ctx context.Context, cmd commands.MergeTagsCommand) (*dto.TagResponse, error) {
+	if err := requireModerator(ctx, uc.userRepo, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	source, err := findTag(ctx, uc.tagRepo, cmd.Source)
+	if err != nil {
+		return nil, err
+	}
+	target, err := findTag(ctx, uc.tagRepo, cmd.Target)
+	if err != nil {
+		return nil, err
+	}
+	if source.ID == target.ID {
+		return nil, appErrors.BadRequest("A tag cannot be merged into itself")
+	}
+
+	if err := uc.tagRepo.Merge(ctx, source, target); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Tag not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to merge tags")
+	}
+
+	merged, err := findTag(ctx, uc.tagRepo, target.Name)
+	if err != nil {
+		return nil, err
+	}
+	return dto.TagToResponse(merged), nil
+}
+
+// requireModerator ensures the user may curate tags
+func requireModerator(ctx context.Context, userRepo repositories.UserRepository, userID uuid.UUID) error {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.ErrUserNotFound
+		}
+		return appErrors.InternalServerError("Failed to get user")
+	}
+	if !user.CanModerateContent() {
+		return appErrors.Forbidden("Only moderators can merge tags")
+	}
+	return nil
+}
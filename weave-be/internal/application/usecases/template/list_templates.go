@@ -0,0 +1,72 @@
+package template
+
+import (
+	"context"
+
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// ListTemplatesUseCase handles browsing published templates
+type ListTemplatesUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewListTemplatesUseCase creates a new ListTemplatesUseCase
+func NewListTemplatesUseCase(weaveRepo repositories.WeaveRepository) *ListTemplatesUseCase {
+	return &ListTemplatesUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists published templates, the most used first
+func (uc *ListTemplatesUseCase) Execute(ctx context.Context, query queries.ListTemplatesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetTemplates(ctx, query.ChannelID, query.Limit, offset)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get templates")
+	}
+
+	total, err := uc.weaveRepo.CountTemplates(ctx, query.ChannelID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count templates")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// ListChannelTemplatesUseCase handles getting the official templates of a channel
+type ListChannelTemplatesUseCase struct {
+	channelRepo  repositories.ChannelRepository
+	templateRepo repositories.TemplateRepository
+}
+
+// NewListChannelTemplatesUseCase creates a new ListChannelTemplatesUseCase
+func NewListChannelTemplatesUseCase(channelRepo repositories.ChannelRepository, templateRepo repositories.TemplateRepository) *ListChannelTemplatesUseCase {
+	return &ListChannelTemplatesUseCase{
+		channelRepo:  channelRepo,
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute lists the channel's pinned templates in position order
+func (uc *ListChannelTemplatesUseCase) Execute(ctx context.Context, query queries.ListChannelTemplatesQuery) ([]*dto.ChannelTemplateResponse, error) {
+	channel, err := findChannel(ctx, uc.channelRepo, query.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	pins, err := uc.templateRepo.ListPinned(ctx, channel.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get channel templates")
+	}
+	return dto.ChannelTemplatesToResponse(pins), nil
+}
@@ -0,0 +1,133 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// maxPinnedTemplates keeps a channel's official templates a short, curated list
+const maxPinnedTemplates = 20
+
+// PinTemplateUseCase handles pinning a template as one of a channel's official templates
+type PinTemplateUseCase struct {
+	weaveRepo    repositories.WeaveRepository
+	channelRepo  repositories.ChannelRepository
+	templateRepo repositories.TemplateRepository
+	userRepo     repositories.UserRepository
+}
+
+// NewPinTemplateUseCase creates a new PinTemplateUseCase
+func NewPinTemplateUseCase(
+	weaveRepo repositories.WeaveRepository,
+	channelRepo repositories.ChannelRepository,
+	templateRepo repositories.TemplateRepository,
+	userRepo repositories.UserRepository,
+) *PinTemplateUseCase {
+	return &PinTemplateUseCase{
+		weaveRepo:    weaveRepo,
+		channelRepo:  channelRepo,
+		templateRepo: templateRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Execute pins a published template whose content type the channel accepts.
+// Templates from other channels may be pinned too.
+func (uc *PinTemplateUseCase) Execute(ctx context.Context, cmd commands.PinTemplateCommand) (*dto.ChannelTemplateResponse, error) {
+	if err := requireModerator(ctx, uc.userRepo, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	channel, err := findChannel(ctx, uc.channelRepo, cmd.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !weave.IsUsableTemplate() {
+		return nil, appErrors.BadRequest("Only published templates can be pinned")
+	}
+	if !channel.Accepts(weave.Content.Type) {
+		return nil, appErrors.BadRequest(fmt.Sprintf("'%s' is not accepted in the %s channel", weave.Content.Type, channel.Name))
+	}
+
+	pinned, err := uc.templateRepo.CountPinned(ctx, channel.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count pinned templates")
+	}
+	if pinned >= maxPinnedTemplates {
+		return nil, appErrors.Conflict(fmt.Sprintf("A channel can pin at most %d templates", maxPinnedTemplates))
+	}
+
+	position := int(pinned)
+	if cmd.Position != nil {
+		position = *cmd.Position
+	}
+
+	pin := entities.NewChannelTemplate(channel.ID, weave.ID, cmd.UserID, position)
+	if err := uc.templateRepo.Pin(ctx, pin); err != nil {
+		if errors.Is(err, repositories.ErrTemplateAlreadyPinned) {
+			return nil, appErrors.Conflict("Template is already pinned in this channel")
+		}
+		return nil, appErrors.InternalServerError("Failed to pin template")
+	}
+	pin.Weave = weave
+
+	return dto.ChannelTemplateToResponse(pin), nil
+}
+
+// UnpinTemplateUseCase handles removing a template from a channel's official templates
+type UnpinTemplateUseCase struct {
+	templateRepo repositories.TemplateRepository
+	userRepo     repositories.UserRepository
+}
+
+// NewUnpinTemplateUseCase creates a new UnpinTemplateUseCase
+func NewUnpinTemplateUseCase(templateRepo repositories.TemplateRepository, userRepo repositories.UserRepository) *UnpinTemplateUseCase {
+	return &UnpinTemplateUseCase{
+		templateRepo: templateRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Execute removes the pin; the weave stays a template
+func (uc *UnpinTemplateUseCase) Execute(ctx context.Context, cmd commands.UnpinTemplateCommand) error {
+	if err := requireModerator(ctx, uc.userRepo, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := uc.templateRepo.Unpin(ctx, cmd.ChannelID, cmd.WeaveID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.NotFound("Template is not pinned in this channel")
+		}
+		return appErrors.InternalServerError("Failed to unpin template")
+	}
+	return nil
+}
+
+// requireModerator ensures the user may curate channel content
+func requireModerator(ctx context.Context, userRepo repositories.UserRepository, userID uuid.UUID) error {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return appErrors.ErrUserNotFound
+		}
+		return appErrors.InternalServerError("Failed to get user")
+	}
+	if !user.CanModerateContent() {
+		return appErrors.Forbidden("Only moderators can manage channel templates")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package template
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// SetTemplateUseCase handles offering a weave as a template and withdrawing it
+type SetTemplateUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewSetTemplateUseCase creates a new SetTemplateUseCase
+func NewSetTemplateUseCase(weaveRepo repositories.WeaveRepository) *SetTemplateUseCase {
+	return &SetTemplateUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute marks a published weave as a template or withdraws it.
+// Withdrawing also removes the weave from every channel that pinned it.
+func (uc *SetTemplateUseCase) Execute(ctx context.Context, cmd commands.SetTemplateCommand) (*dto.WeaveResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !weave.IsVisibleTo(&cmd.UserID) {
+		return nil, appErrors.ErrWeaveNotFound
+	}
+
+	if err := weave.SetTemplate(cmd.IsTemplate, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.weaveRepo.UpdateTemplateStatus(ctx, weave, cmd.UserID); err != nil {
+		if errors.Is(err, repositories.ErrWeaveStatusConflict) {
+			return nil, appErrors.Conflict("Weave is no longer published")
+		}
+		return nil, appErrors.InternalServerError("Failed to update template status")
+	}
+
+	return dto.WeaveToResponse(weave), nil
+}
+
+// findChannel loads a channel and maps repository errors to application errors
+func findChannel(ctx context.Context, channelRepo repositories.ChannelRepository, channelID uuid.UUID) (*entities.Channel, error) {
+	channel, err := channelRepo.GetByID(ctx, channelID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.ErrChannelNotFound
+		}
+		return nil, appErrors.InternalServerError("Failed to get channel")
+	}
+	return channel, nil
+}
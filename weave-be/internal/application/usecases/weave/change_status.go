@@ -0,0 +1,56 @@
+package weave
+
+import (
+	"context"
+	"errors"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// ChangeWeaveStatusUseCase handles moving a weave through its lifecycle
+type ChangeWeaveStatusUseCase struct {
+	weaveRepo repositories.WeaveRepository
+	registry  *contenttypes.Registry
+}
+
+// NewChangeWeaveStatusUseCase creates a new ChangeWeaveStatusUseCase
+func NewChangeWeaveStatusUseCase(weaveRepo repositories.WeaveRepository, registry *contenttypes.Registry) *ChangeWeaveStatusUseCase {
+	return &ChangeWeaveStatusUseCase{
+		weaveRepo: weaveRepo,
+		registry:  registry,
+	}
+}
+
+// Execute applies a status transition allowed by the weave state machine.
+// Publishing re-validates the content, since schemas may have tightened since the last edit.
+func (uc *ChangeWeaveStatusUseCase) Execute(ctx context.Context, cmd commands.ChangeWeaveStatusCommand) (*dto.WeaveResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := weave.Status
+	if err := weave.TransitionTo(cmd.Status, cmd.UserID); err != nil {
+		return nil, err
+	}
+	if cmd.Status == entities.WeaveStatusPublished {
+		if _, err := uc.registry.PrepareContent(weave.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.weaveRepo.UpdateStatus(ctx, weave, from, cmd.UserID); err != nil {
+		if errors.Is(err, repositories.ErrWeaveStatusConflict) {
+			return nil, appErrors.Conflict("The weave status was changed by someone else, please retry")
+		}
+		return nil, appErrors.InternalServerError("Failed to update weave status")
+	}
+
+	return dto.WeaveToResponse(weave), nil
+}
@@ -0,0 +1,87 @@
+package weave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// GetContentTypesUseCase handles listing content type schemas for editors
+type GetContentTypesUseCase struct {
+	channelRepo repositories.ChannelRepository
+	registry    *contenttypes.Registry
+}
+
+// NewGetContentTypesUseCase creates a new GetContentTypesUseCase
+func NewGetContentTypesUseCase(channelRepo repositories.ChannelRepository, registry *contenttypes.Registry) *GetContentTypesUseCase {
+	return &GetContentTypesUseCase{
+		channelRepo: channelRepo,
+		registry:    registry,
+	}
+}
+
+// Execute lists the registered content types, narrowed to a channel when one is given
+func (uc *GetContentTypesUseCase) Execute(ctx context.Context, query queries.GetContentTypesQuery) ([]dto.ContentTypeResponse, error) {
+	var channel *entities.Channel
+	if query.ChannelID != nil {
+		var err error
+		if channel, err = findChannel(ctx, uc.channelRepo, *query.ChannelID); err != nil {
+			return nil, err
+		}
+	}
+
+	responses := []dto.ContentTypeResponse{}
+	for _, contentType := range uc.registry.List() {
+		if channel != nil && !channel.Accepts(contentType.Name) {
+			continue
+		}
+		responses = append(responses, *dto.ContentTypeToResponse(contentType))
+	}
+	return responses, nil
+}
+
+func findChannel(ctx context.Context, channelRepo repositories.ChannelRepository, channelID uuid.UUID) (*entities.Channel, error) {
+	channel, err := channelRepo.GetByID(ctx, channelID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.ErrChannelNotFound
+		}
+		return nil, appErrors.InternalServerError("Failed to get channel")
+	}
+	return channel, nil
+}
+
+// findOpenChannel loads a channel new weaves can be created in. Inactive
+// channels keep their weaves editable but take no new ones.
+func findOpenChannel(ctx context.Context, channelRepo repositories.ChannelRepository, channelID uuid.UUID) (*entities.Channel, error) {
+	channel, err := findChannel(ctx, channelRepo, channelID)
+	if err != nil {
+		return nil, err
+	}
+	if !channel.IsActive {
+		return nil, appErrors.BadRequest("Channel is not accepting new weaves")
+	}
+	return channel, nil
+}
+
+// prepareChannelContent upgrades submitted content to the current schema of its
+// type and validates it, requiring the channel to accept the type. The returned
+// content is the one to store.
+func prepareChannelContent(registry *contenttypes.Registry, channel *entities.Channel, content entities.WeaveContent) (entities.WeaveContent, error) {
+	if content.Type != "" && !channel.Accepts(content.Type) {
+		return content, contenttypes.InvalidContentError(content.Type, []contenttypes.FieldError{{
+			Field:   "/type",
+			Message: fmt.Sprintf("is not accepted in the %s channel", channel.Name),
+		}})
+	}
+	return registry.PrepareContent(content)
+}
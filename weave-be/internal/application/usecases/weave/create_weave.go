@@ -0,0 +1,94 @@
+package weave
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+	"weave-module/errors"
+)
+
+// CreateWeaveUseCase handles weave creation business logic
+type CreateWeaveUseCase struct {
+	weaveRepo         repositories.WeaveRepository
+	channelRepo       repositories.ChannelRepository
+	registry          *contenttypes.Registry
+	userDomainService services.UserDomainService
+}
+
+// NewCreateWeaveUseCase creates a new CreateWeaveUseCase
+func NewCreateWeaveUseCase(weaveRepo repositories.WeaveRepository, channelRepo repositories.ChannelRepository, registry *contenttypes.Registry, userDomainService services.UserDomainService) *CreateWeaveUseCase {
+	return &CreateWeaveUseCase{
+		weaveRepo:         weaveRepo,
+		channelRepo:       channelRepo,
+		registry:          registry,
+		userDomainService: userDomainService,
+	}
+}
+
+// Execute creates a new draft weave with its first version.
+// Weaves started from a template copy its current content but none of its history.
+func (uc *CreateWeaveUseCase) Execute(ctx context.Context, cmd commands.CreateWeaveCommand) (*dto.WeaveResponse, error) {
+	canCreate, err := uc.userDomainService.CanUserCreateWeave(ctx, cmd.UserID)
+	if err != nil {
+		return nil, errors.ErrUserNotFound
+	}
+	if !canCreate {
+		return nil, errors.Forbidden("User is not allowed to create weaves")
+	}
+
+	tags, err := entities.NormalizeTags(cmd.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var template *entities.Weave
+	content := cmd.Content
+	if cmd.TemplateID != nil {
+		if template, err = uc.findTemplate(ctx, *cmd.TemplateID); err != nil {
+			return nil, err
+		}
+		content = template.Content
+	}
+
+	channel, err := findOpenChannel(ctx, uc.channelRepo, cmd.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	content, err = prepareChannelContent(uc.registry, channel, content)
+	if err != nil {
+		return nil, err
+	}
+
+	weave := entities.NewWeave(cmd.UserID, cmd.ChannelID, cmd.Title, content)
+	weave.Description = cmd.Description
+	weave.CoverImage = cmd.CoverImage
+	weave.Tags = tags
+	if template != nil {
+		weave.StartFromTemplate(template)
+	}
+
+	if err := uc.weaveRepo.Create(ctx, weave); err != nil {
+		return nil, errors.InternalServerError("Failed to create weave")
+	}
+
+	return dto.WeaveToResponse(weave), nil
+}
+
+// findTemplate loads a weave that may be used as a template
+func (uc *CreateWeaveUseCase) findTemplate(ctx context.Context, templateID uuid.UUID) (*entities.Weave, error) {
+	template, err := lookup.Weave(ctx, uc.weaveRepo, templateID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || !template.IsUsableTemplate() {
+		return nil, errors.NotFound("Template not found")
+	}
+	return template, nil
+}
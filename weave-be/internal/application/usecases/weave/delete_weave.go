@@ -0,0 +1,45 @@
+package weave
+
+import (
+	"context"
+	"errors"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// DeleteWeaveUseCase handles weave deletion business logic
+type DeleteWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewDeleteWeaveUseCase creates a new DeleteWeaveUseCase
+func NewDeleteWeaveUseCase(weaveRepo repositories.WeaveRepository) *DeleteWeaveUseCase {
+	return &DeleteWeaveUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute soft deletes a weave so forks and history stay intact
+func (uc *DeleteWeaveUseCase) Execute(ctx context.Context, cmd commands.DeleteWeaveCommand) error {
+	weave, err := findEditableWeave(ctx, uc.weaveRepo, cmd.WeaveID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	from := weave.Status
+	if err := weave.TransitionTo(entities.WeaveStatusDeleted, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := uc.weaveRepo.UpdateStatus(ctx, weave, from, cmd.UserID); err != nil {
+		if errors.Is(err, repositories.ErrWeaveStatusConflict) {
+			return appErrors.Conflict("The weave status was changed by someone else, please retry")
+		}
+		return appErrors.InternalServerError("Failed to delete weave")
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package weave
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/export"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+	"weave-module/utils"
+)
+
+// exportContentTypes maps each export format to the media type it is served as
+var exportContentTypes = map[export.Format]string{
+	export.FormatMarkdown: "text/markdown; charset=utf-8",
+	export.FormatHTML:     "text/html; charset=utf-8",
+	export.FormatJSON:     "application/json; charset=utf-8",
+}
+
+var exportExtensions = map[export.Format]string{
+	export.FormatMarkdown: "md",
+	export.FormatHTML:     "html",
+	export.FormatJSON:     "json",
+}
+
+// ExportWeaveUseCase handles exporting a weave as Markdown, HTML or a JSON bundle
+type ExportWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+	userRepo  repositories.UserRepository
+}
+
+// NewExportWeaveUseCase creates a new ExportWeaveUseCase
+func NewExportWeaveUseCase(weaveRepo repositories.WeaveRepository, userRepo repositories.UserRepository) *ExportWeaveUseCase {
+	return &ExportWeaveUseCase{
+		weaveRepo: weaveRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute renders a visible weave in the requested format. Markdown and HTML
+// show a single version; JSON bundles always carry the full history.
+func (uc *ExportWeaveUseCase) Execute(ctx context.Context, query queries.ExportWeaveQuery) (*dto.ExportedWeaveResponse, error) {
+	format := export.Format(query.Format)
+	if !format.IsValid() {
+		return nil, appErrors.BadRequest(fmt.Sprintf("Unsupported export format '%s', use markdown, html or json", query.Format))
+	}
+	if format == export.FormatJSON && query.Version != nil {
+		return nil, appErrors.BadRequest("JSON bundles always contain every version; omit the version parameter")
+	}
+
+	weave, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	author := uc.authorName(ctx, weave)
+
+	var body []byte
+	version := weave.Version
+	if format == export.FormatJSON {
+		body, err = uc.bundle(ctx, weave, author)
+	} else {
+		var doc *export.Document
+		doc, version, err = uc.document(ctx, weave, author, query.Version)
+		if err == nil {
+			body = renderDocument(format, doc)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ExportedWeaveResponse{
+		Filename:    exportFilename(weave, version, format),
+		ContentType: exportContentTypes[format],
+		Body:        body,
+	}, nil
+}
+
+// document lays out the current version, or the requested one, of the weave
+func (uc *ExportWeaveUseCase) document(ctx context.Context, weave *entities.Weave, author string, number *int) (*export.Document, int, error) {
+	if number == nil || *number == weave.Version {
+		doc := export.NewDocument(weave.Title, weave.Content)
+		if weave.Description != nil {
+			doc.Description = *weave.Description
+		}
+		doc.Meta = exportMeta(author, weave.Version, weave.UpdatedAt.Format("2006-01-02"))
+		return doc, weave.Version, nil
+	}
+
+	version, err := findVersion(ctx, uc.weaveRepo, weave.ID, *number)
+	if err != nil {
+		return nil, 0, err
+	}
+	doc := export.NewDocument(version.Title, version.Content)
+	doc.Meta = exportMeta(author, version.Version, version.CreatedAt.Format("2006-01-02"))
+	return doc, version.Version, nil
+}
+
+// bundle archives the weave with its version history and timeline as indented JSON
+func (uc *ExportWeaveUseCase) bundle(ctx context.Context, weave *entities.Weave, author string) ([]byte, error) {
+	versions, err := uc.weaveRepo.GetVersions(ctx, weave.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get weave versions")
+	}
+	timeline, err := uc.weaveRepo.GetTimeline(ctx, weave.ID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get weave timeline")
+	}
+
+	body, err := json.MarshalIndent(export.NewBundle(weave, author, versions, timeline), "", "  ")
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to encode weave bundle")
+	}
+	return body, nil
+}
+
+// authorName looks up the author's username; exports of deleted accounts go without one
+func (uc *ExportWeaveUseCase) authorName(ctx context.Context, weave *entities.Weave) string {
+	if user, err := uc.userRepo.GetByID(ctx, weave.UserID); err == nil {
+		return user.Username
+	}
+	return ""
+}
+
+func exportMeta(author string, version int, updated string) []export.Field {
+	var meta []export.Field
+	if author != "" {
+		meta = append(meta, export.Field{Label: "Author", Value: author})
+	}
+	return append(meta,
+		export.Field{Label: "Version", Value: strconv.Itoa(version)},
+		export.Field{Label: "Updated", Value: updated},
+	)
+}
+
+func renderDocument(format export.Format, doc *export.Document) []byte {
+	if format == export.FormatHTML {
+		return export.RenderHTML(doc)
+	}
+	return export.RenderMarkdown(doc)
+}
+
+// exportFilename names the download after the weave's title. Titles without
+// any ASCII letters or digits fall back to the weave ID.
+func exportFilename(weave *entities.Weave, version int, format export.Format) string {
+	name := strings.Trim(utils.ToSlug(weave.Title), "-")
+	if name == "" {
+		name = "weave-" + weave.ID.String()
+	}
+	return fmt.Sprintf("%s-v%d.%s", name, version, exportExtensions[format])
+}
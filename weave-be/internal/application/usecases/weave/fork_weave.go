@@ -0,0 +1,41 @@
+package weave
+
+import (
+	"context"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/repositories"
+	"weave-module/errors"
+)
+
+// ForkWeaveUseCase handles weave forking business logic
+type ForkWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewForkWeaveUseCase creates a new ForkWeaveUseCase
+func NewForkWeaveUseCase(weaveRepo repositories.WeaveRepository) *ForkWeaveUseCase {
+	return &ForkWeaveUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute forks a published weave into a new draft owned by the user
+func (uc *ForkWeaveUseCase) Execute(ctx context.Context, cmd commands.ForkWeaveCommand) (*dto.WeaveResponse, error) {
+	original, err := findVisibleWeave(ctx, uc.weaveRepo, cmd.WeaveID, &cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if original.UserID == cmd.UserID {
+		return nil, errors.BadRequest("Cannot fork your own weave")
+	}
+
+	forked, err := uc.weaveRepo.Fork(ctx, original.ID, cmd.UserID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to fork weave")
+	}
+
+	return dto.WeaveToResponse(forked), nil
+}
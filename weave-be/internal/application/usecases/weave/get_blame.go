@@ -0,0 +1,137 @@
+package weave
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+	"weave-module/errors"
+)
+
+// GetWeaveBlameUseCase handles attributing weave content to the people who wrote it
+type GetWeaveBlameUseCase struct {
+	weaveRepo        repositories.WeaveRepository
+	contributionRepo repositories.ContributionRepository
+	userRepo         repositories.UserRepository
+	blameCache       repositories.WeaveBlameCache
+}
+
+// NewGetWeaveBlameUseCase creates a new GetWeaveBlameUseCase
+func NewGetWeaveBlameUseCase(
+	weaveRepo repositories.WeaveRepository,
+	contributionRepo repositories.ContributionRepository,
+	userRepo repositories.UserRepository,
+	blameCache repositories.WeaveBlameCache,
+) *GetWeaveBlameUseCase {
+	return &GetWeaveBlameUseCase{
+		weaveRepo:        weaveRepo,
+		contributionRepo: contributionRepo,
+		userRepo:         userRepo,
+		blameCache:       blameCache,
+	}
+}
+
+// Execute returns, for every field of the current content, the version and user
+// that last changed it. Results are cached per weave version.
+func (uc *GetWeaveBlameUseCase) Execute(ctx context.Context, query queries.GetWeaveBlameQuery) (*dto.WeaveBlameResponse, error) {
+	weave, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cache is an optimization; fall back to computing on any error
+	if blame, err := uc.blameCache.Get(ctx, weave.ID, weave.Version); err == nil && blame != nil {
+		return dto.WeaveBlameToResponse(blame), nil
+	}
+
+	blame, err := uc.computeBlame(ctx, weave)
+	if err != nil {
+		return nil, err
+	}
+	_ = uc.blameCache.Set(ctx, blame)
+
+	return dto.WeaveBlameToResponse(blame), nil
+}
+
+func (uc *GetWeaveBlameUseCase) computeBlame(ctx context.Context, weave *entities.Weave) (*entities.WeaveBlame, error) {
+	versions, err := uc.weaveRepo.GetVersions(ctx, weave.ID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get weave versions")
+	}
+
+	// Versions come newest first; blame replays them oldest first
+	history := make([]*entities.WeaveVersion, 0, len(versions)+1)
+	for i := len(versions) - 1; i >= 0; i-- {
+		history = append(history, versions[i])
+	}
+
+	// Weaves edited without recording a version still need their current content credited
+	if len(history) == 0 || !diff.Equal(history[len(history)-1].Content.Data, weave.Content.Data) {
+		history = append(history, &entities.WeaveVersion{
+			WeaveID:   weave.ID,
+			UserID:    weave.UserID,
+			Version:   weave.Version,
+			Content:   weave.Content,
+			CreatedAt: weave.UpdatedAt,
+		})
+	}
+
+	revisions := make([]interface{}, len(history))
+	for i, version := range history {
+		revisions[i] = version.Content.Data
+	}
+	attributions, err := diff.Blame(revisions...)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to compute weave blame")
+	}
+
+	merged, err := uc.contributionRepo.GetMergedVersions(ctx, weave.ID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get merged contributions")
+	}
+
+	blame := &entities.WeaveBlame{
+		WeaveID: weave.ID,
+		Version: weave.Version,
+		Fields:  make([]entities.FieldAttribution, len(attributions)),
+		Authors: []entities.BlameAuthor{},
+	}
+	fieldCounts := make(map[uuid.UUID]int)
+	for i, attribution := range attributions {
+		version := history[attribution.Revision]
+		field := entities.FieldAttribution{
+			// Paths point into the content document, like validation errors do
+			Path:      "/data" + attribution.Path,
+			Version:   version.Version,
+			UserID:    version.UserID,
+			ChangedAt: version.CreatedAt,
+		}
+		if contributionID, ok := merged[version.Version]; ok {
+			field.ContributionID = &contributionID
+		}
+		blame.Fields[i] = field
+		fieldCounts[version.UserID]++
+	}
+
+	for userID, count := range fieldCounts {
+		author := entities.BlameAuthor{UserID: userID, FieldCount: count}
+		// Deleted accounts still own their fields, just without a name
+		if user, err := uc.userRepo.GetByID(ctx, userID); err == nil {
+			author.Username = user.Username
+		}
+		blame.Authors = append(blame.Authors, author)
+	}
+	sort.Slice(blame.Authors, func(i, j int) bool {
+		if blame.Authors[i].FieldCount != blame.Authors[j].FieldCount {
+			return blame.Authors[i].FieldCount > blame.Authors[j].FieldCount
+		}
+		return blame.Authors[i].Username < blame.Authors[j].Username
+	})
+
+	return blame, nil
+}
@@ -0,0 +1,108 @@
+package weave
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/repositories"
+	"weave-module/errors"
+)
+
+const (
+	defaultLineageDepth = 3
+	maxLineageDepth     = 10
+	defaultLineageLimit = 100
+	maxLineageLimit     = 500
+
+	// Ancestor chains never branch, so a generous fixed bound is enough
+	maxAncestorDepth = 100
+)
+
+// GetWeaveLineageUseCase handles building the fork family tree of a weave
+type GetWeaveLineageUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetWeaveLineageUseCase creates a new GetWeaveLineageUseCase
+func NewGetWeaveLineageUseCase(weaveRepo repositories.WeaveRepository) *GetWeaveLineageUseCase {
+	return &GetWeaveLineageUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute returns the ancestors and the descendant fork tree of a weave.
+// Drafts are only shown to their owner, and hiding a draft hides its forks too.
+func (uc *GetWeaveLineageUseCase) Execute(ctx context.Context, query queries.GetWeaveLineageQuery) (*dto.WeaveLineageResponse, error) {
+	if _, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID); err != nil {
+		return nil, err
+	}
+
+	depth := clamp(query.Depth, defaultLineageDepth, maxLineageDepth)
+	limit := clamp(query.Limit, defaultLineageLimit, maxLineageLimit)
+
+	ancestors, err := uc.weaveRepo.GetAncestors(ctx, query.WeaveID, maxAncestorDepth)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get weave ancestors")
+	}
+
+	// One extra row for the weave itself and one to detect truncation
+	nodes, err := uc.weaveRepo.GetDescendants(ctx, query.WeaveID, depth, limit+2)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get weave forks")
+	}
+	if len(nodes) == 0 {
+		return nil, errors.ErrWeaveNotFound
+	}
+
+	response := &dto.WeaveLineageResponse{
+		Ancestors: []*dto.LineageNodeResponse{},
+	}
+
+	// Ancestors come nearest first; the response lists them from the root down
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		if ancestors[i].IsVisibleTo(query.ViewerID) {
+			response.Ancestors = append(response.Ancestors, dto.LineageNodeToResponse(ancestors[i]))
+		}
+	}
+
+	descendants := nodes[1:]
+	if len(descendants) > limit {
+		descendants = descendants[:limit]
+		response.Truncated = true
+	}
+
+	// Nodes arrive breadth first, so every parent is placed before its children
+	response.Tree = dto.LineageNodeToResponse(nodes[0])
+	placed := map[uuid.UUID]*dto.LineageNodeResponse{
+		nodes[0].WeaveID: response.Tree,
+	}
+	for _, node := range descendants {
+		if !node.IsVisibleTo(query.ViewerID) || node.ParentWeaveID == nil {
+			continue
+		}
+		parent, ok := placed[*node.ParentWeaveID]
+		if !ok {
+			continue
+		}
+
+		child := dto.LineageNodeToResponse(node)
+		parent.Children = append(parent.Children, child)
+		placed[node.WeaveID] = child
+		response.DescendantCount++
+	}
+
+	return response, nil
+}
+
+// clamp applies a default to unset values and caps them at max
+func clamp(value, defaultValue, max int) int {
+	if value <= 0 {
+		return defaultValue
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
@@ -0,0 +1,182 @@
+package weave
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// GetWeaveUseCase handles retrieving a single weave
+type GetWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetWeaveUseCase creates a new GetWeaveUseCase
+func NewGetWeaveUseCase(weaveRepo repositories.WeaveRepository) *GetWeaveUseCase {
+	return &GetWeaveUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute retrieves a weave and records the view
+func (uc *GetWeaveUseCase) Execute(ctx context.Context, query queries.GetWeaveQuery) (*dto.WeaveDetailResponse, error) {
+	weave, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// View counting is best effort and must not fail the read
+	_ = uc.weaveRepo.IncrementViewCount(ctx, weave.ID)
+
+	response := &dto.WeaveDetailResponse{
+		Weave: *dto.WeaveToResponse(weave),
+	}
+	if query.ViewerID != nil {
+		response.IsLiked, _ = uc.weaveRepo.IsLiked(ctx, weave.ID, *query.ViewerID)
+		response.CanEdit = weave.CanBeEditedBy(*query.ViewerID)
+	}
+
+	return response, nil
+}
+
+// GetWeaveVersionsUseCase handles retrieving the version history of a weave
+type GetWeaveVersionsUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetWeaveVersionsUseCase creates a new GetWeaveVersionsUseCase
+func NewGetWeaveVersionsUseCase(weaveRepo repositories.WeaveRepository) *GetWeaveVersionsUseCase {
+	return &GetWeaveVersionsUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute retrieves all versions of a weave, newest first
+func (uc *GetWeaveVersionsUseCase) Execute(ctx context.Context, query queries.GetWeaveVersionsQuery) ([]dto.WeaveVersionResponse, error) {
+	if _, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID); err != nil {
+		return nil, err
+	}
+
+	versions, err := uc.weaveRepo.GetVersions(ctx, query.WeaveID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get weave versions")
+	}
+
+	return dto.WeaveVersionsToResponse(versions), nil
+}
+
+// GetWeaveVersionUseCase handles retrieving a specific version of a weave
+type GetWeaveVersionUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetWeaveVersionUseCase creates a new GetWeaveVersionUseCase
+func NewGetWeaveVersionUseCase(weaveRepo repositories.WeaveRepository) *GetWeaveVersionUseCase {
+	return &GetWeaveVersionUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute retrieves a single version of a weave
+func (uc *GetWeaveVersionUseCase) Execute(ctx context.Context, query queries.GetWeaveVersionQuery) (*dto.WeaveVersionResponse, error) {
+	if _, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID); err != nil {
+		return nil, err
+	}
+
+	version, err := findVersion(ctx, uc.weaveRepo, query.WeaveID, query.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.WeaveVersionToResponse(version), nil
+}
+
+// GetWeaveVersionDiffUseCase handles comparing two versions of a weave
+type GetWeaveVersionDiffUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetWeaveVersionDiffUseCase creates a new GetWeaveVersionDiffUseCase
+func NewGetWeaveVersionDiffUseCase(weaveRepo repositories.WeaveRepository) *GetWeaveVersionDiffUseCase {
+	return &GetWeaveVersionDiffUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute computes the structural content diff from one version to another
+func (uc *GetWeaveVersionDiffUseCase) Execute(ctx context.Context, query queries.GetWeaveVersionDiffQuery) (*dto.WeaveVersionDiffResponse, error) {
+	if _, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID); err != nil {
+		return nil, err
+	}
+
+	from, err := findVersion(ctx, uc.weaveRepo, query.WeaveID, query.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := findVersion(ctx, uc.weaveRepo, query.WeaveID, query.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	contentDiff, err := diff.Compare(from.Content, to.Content)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to compare weave versions")
+	}
+
+	return &dto.WeaveVersionDiffResponse{
+		WeaveID:      query.WeaveID,
+		FromVersion:  from.Version,
+		ToVersion:    to.Version,
+		TitleChanged: from.Title != to.Title,
+		Diff:         contentDiff,
+	}, nil
+}
+
+// findVersion loads a single version and maps repository errors to application errors
+func findVersion(ctx context.Context, weaveRepo repositories.WeaveRepository, weaveID uuid.UUID, number int) (*entities.WeaveVersion, error) {
+	version, err := weaveRepo.GetVersion(ctx, weaveID, number)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Weave version not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get weave version")
+	}
+	return version, nil
+}
+
+// findVisibleWeave loads a weave, hiding unpublished weaves from everyone but their owner
+func findVisibleWeave(ctx context.Context, weaveRepo repositories.WeaveRepository, weaveID uuid.UUID, viewerID *uuid.UUID) (*entities.Weave, error) {
+	weave, err := lookup.Weave(ctx, weaveRepo, weaveID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !weave.IsVisibleTo(viewerID) {
+		return nil, appErrors.ErrWeaveNotFound
+	}
+
+	return weave, nil
+}
+
+// findEditableWeave loads a weave and ensures the user is allowed to modify it
+func findEditableWeave(ctx context.Context, weaveRepo repositories.WeaveRepository, weaveID, userID uuid.UUID) (*entities.Weave, error) {
+	weave, err := lookup.Weave(ctx, weaveRepo, weaveID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !weave.CanBeEditedBy(userID) {
+		return nil, appErrors.Forbidden("You do not have permission to modify this weave")
+	}
+
+	return weave, nil
+}
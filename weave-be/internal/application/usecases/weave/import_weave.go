@@ -0,0 +1,182 @@
+package weave
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/export"
+	"weave-be/internal/domain/repositories"
+	"weave-be/internal/domain/services"
+	"weave-module/bundle"
+	"weave-module/errors"
+)
+
+// ImportWeaveBundleTask is the worker task that restores bundles too large to import inline
+const ImportWeaveBundleTask = "import_weave_bundle"
+
+const (
+	// Bundles beyond either limit are imported by the worker
+	maxInlineImportVersions = 20
+	maxInlineImportBytes    = 1 << 20
+)
+
+// ImportWeaveUseCase handles importing weaves from JSON bundles and Markdown files
+type ImportWeaveUseCase struct {
+	weaveRepo         repositories.WeaveRepository
+	channelRepo       repositories.ChannelRepository
+	registry          *contenttypes.Registry
+	userDomainService services.UserDomainService
+	taskService       services.TaskService
+}
+
+// NewImportWeaveUseCase creates a new ImportWeaveUseCase
+func NewImportWeaveUseCase(
+	weaveRepo repositories.WeaveRepository,
+	channelRepo repositories.ChannelRepository,
+	registry *contenttypes.Registry,
+	userDomainService services.UserDomainService,
+	taskService services.TaskService,
+) *ImportWeaveUseCase {
+	return &ImportWeaveUseCase{
+		weaveRepo:         weaveRepo,
+		channelRepo:       channelRepo,
+		registry:          registry,
+		userDomainService: userDomainService,
+		taskService:       taskService,
+	}
+}
+
+// Execute validates the import against the target channel and creates a draft weave
+// owned by the importer. Large bundles are validated here but restored by the worker.
+func (uc *ImportWeaveUseCase) Execute(ctx context.Context, cmd commands.ImportWeaveCommand) (*dto.ImportWeaveResponse, error) {
+	canCreate, err := uc.userDomainService.CanUserCreateWeave(ctx, cmd.UserID)
+	if err != nil {
+		return nil, errors.ErrUserNotFound
+	}
+	if !canCreate {
+		return nil, errors.Forbidden("User is not allowed to create weaves")
+	}
+
+	b, err := uc.readBundle(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.Weave.Title) > 200 {
+		return nil, errors.BadRequest("Weave title cannot exceed 200 characters")
+	}
+
+	// The current content has to be valid in the channel; history only has to be of accepted types
+	channel, err := findOpenChannel(ctx, uc.channelRepo, cmd.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := prepareChannelContent(uc.registry, channel, weaveContent(b.Weave.Content))
+	if err != nil {
+		return nil, err
+	}
+	b.Weave.Content = bundle.Content{Type: content.Type, SchemaVersion: content.SchemaVersion, Data: content.Data}
+	if err := uc.checkHistoryTypes(ctx, cmd.ChannelID, b); err != nil {
+		return nil, err
+	}
+
+	if len(b.Versions) > maxInlineImportVersions || len(cmd.Bundle) > maxInlineImportBytes {
+		return uc.enqueue(ctx, cmd, b)
+	}
+
+	weave, err := uc.weaveRepo.Import(ctx, b, cmd.UserID, cmd.ChannelID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to import weave")
+	}
+	return &dto.ImportWeaveResponse{
+		Status: "imported",
+		Weave:  dto.WeaveToResponse(weave),
+	}, nil
+}
+
+// readBundle decodes a JSON bundle, or reads Markdown into a bundle without history
+func (uc *ImportWeaveUseCase) readBundle(cmd commands.ImportWeaveCommand) (*bundle.Bundle, error) {
+	if cmd.Format == string(export.FormatJSON) {
+		b, err := bundle.Parse(cmd.Bundle)
+		if err != nil {
+			return nil, errors.BadRequestWithDetails("Invalid weave bundle", err.Error())
+		}
+		return b, nil
+	}
+
+	if _, ok := uc.registry.Get(cmd.ContentType); !ok {
+		return nil, errors.BadRequest(fmt.Sprintf("Unknown content type '%s'", cmd.ContentType))
+	}
+	doc, err := export.ParseMarkdown(cmd.Markdown)
+	if err != nil {
+		return nil, errors.BadRequestWithDetails("Invalid markdown", err.Error())
+	}
+	content := export.ParseContent(cmd.ContentType, doc)
+
+	b := &bundle.Bundle{
+		Format:     bundle.Format,
+		Version:    bundle.CurrentVersion,
+		ExportedAt: time.Now(),
+		Weave: bundle.Weave{
+			Title:   doc.Title,
+			Content: bundle.Content{Type: content.Type, Data: content.Data},
+		},
+	}
+	if doc.Description != "" {
+		b.Weave.Description = &doc.Description
+	}
+	return b, nil
+}
+
+// checkHistoryTypes rejects bundles whose history uses content types the channel does not accept
+func (uc *ImportWeaveUseCase) checkHistoryTypes(ctx context.Context, channelID uuid.UUID, b *bundle.Bundle) error {
+	channel, err := findChannel(ctx, uc.channelRepo, channelID)
+	if err != nil {
+		return err
+	}
+
+	var fieldErrors []contenttypes.FieldError
+	for i, version := range b.Versions {
+		if !channel.Accepts(version.Content.Type) {
+			fieldErrors = append(fieldErrors, contenttypes.FieldError{
+				Field:   fmt.Sprintf("/versions/%d/content/type", i),
+				Message: fmt.Sprintf("'%s' is not accepted in the %s channel", version.Content.Type, channel.Name),
+			})
+		}
+	}
+	if len(fieldErrors) > 0 {
+		return contenttypes.InvalidContentError(b.Weave.Content.Type, fieldErrors)
+	}
+	return nil
+}
+
+// enqueue hands a validated bundle to the worker, which notifies the importer when done
+func (uc *ImportWeaveUseCase) enqueue(ctx context.Context, cmd commands.ImportWeaveCommand, b *bundle.Bundle) (*dto.ImportWeaveResponse, error) {
+	importID := uuid.New()
+	err := uc.taskService.Enqueue(ctx, ImportWeaveBundleTask, cmd.UserID, map[string]interface{}{
+		"import_id":  importID.String(),
+		"channel_id": cmd.ChannelID.String(),
+		"bundle":     b,
+	})
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to queue weave import")
+	}
+
+	return &dto.ImportWeaveResponse{
+		Status:   "queued",
+		ImportID: &importID,
+	}, nil
+}
+
+func weaveContent(content bundle.Content) entities.WeaveContent {
+	return entities.WeaveContent{
+		Type:          content.Type,
+		SchemaVersion: content.SchemaVersion,
+		Data:          content.Data,
+	}
+}
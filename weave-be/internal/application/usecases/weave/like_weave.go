@@ -0,0 +1,72 @@
+package weave
+
+import (
+	"context"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/domain/repositories"
+	"weave-module/errors"
+)
+
+// LikeWeaveUseCase handles liking a weave
+type LikeWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewLikeWeaveUseCase creates a new LikeWeaveUseCase
+func NewLikeWeaveUseCase(weaveRepo repositories.WeaveRepository) *LikeWeaveUseCase {
+	return &LikeWeaveUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute likes a weave
+func (uc *LikeWeaveUseCase) Execute(ctx context.Context, cmd commands.LikeWeaveCommand) error {
+	weave, err := findVisibleWeave(ctx, uc.weaveRepo, cmd.WeaveID, &cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	isLiked, err := uc.weaveRepo.IsLiked(ctx, weave.ID, cmd.UserID)
+	if err != nil {
+		return errors.InternalServerError("Failed to check like status")
+	}
+	if isLiked {
+		return errors.Conflict("Already liked this weave")
+	}
+
+	if err := uc.weaveRepo.Like(ctx, weave.ID, cmd.UserID); err != nil {
+		return errors.InternalServerError("Failed to like weave")
+	}
+
+	return nil
+}
+
+// UnlikeWeaveUseCase handles removing a like from a weave
+type UnlikeWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewUnlikeWeaveUseCase creates a new UnlikeWeaveUseCase
+func NewUnlikeWeaveUseCase(weaveRepo repositories.WeaveRepository) *UnlikeWeaveUseCase {
+	return &UnlikeWeaveUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute removes the user's like from a weave
+func (uc *UnlikeWeaveUseCase) Execute(ctx context.Context, cmd commands.UnlikeWeaveCommand) error {
+	isLiked, err := uc.weaveRepo.IsLiked(ctx, cmd.WeaveID, cmd.UserID)
+	if err != nil {
+		return errors.InternalServerError("Failed to check like status")
+	}
+	if !isLiked {
+		return errors.BadRequest("Not liking this weave")
+	}
+
+	if err := uc.weaveRepo.Unlike(ctx, cmd.WeaveID, cmd.UserID); err != nil {
+		return errors.InternalServerError("Failed to unlike weave")
+	}
+
+	return nil
+}
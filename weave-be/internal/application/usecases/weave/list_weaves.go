@@ -0,0 +1,283 @@
+package weave
+
+import (
+	"context"
+
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/repositories"
+	"weave-module/errors"
+)
+
+// GetPublishedWeavesUseCase handles listing published weaves
+type GetPublishedWeavesUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetPublishedWeavesUseCase creates a new GetPublishedWeavesUseCase
+func NewGetPublishedWeavesUseCase(weaveRepo repositories.WeaveRepository) *GetPublishedWeavesUseCase {
+	return &GetPublishedWeavesUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists published weaves, newest first
+func (uc *GetPublishedWeavesUseCase) Execute(ctx context.Context, query queries.ListWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetPublished(ctx, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get weaves")
+	}
+
+	total, err := uc.weaveRepo.Count(ctx)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count weaves")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// GetFeaturedWeavesUseCase handles listing featured weaves
+type GetFeaturedWeavesUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetFeaturedWeavesUseCase creates a new GetFeaturedWeavesUseCase
+func NewGetFeaturedWeavesUseCase(weaveRepo repositories.WeaveRepository) *GetFeaturedWeavesUseCase {
+	return &GetFeaturedWeavesUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists featured weaves
+func (uc *GetFeaturedWeavesUseCase) Execute(ctx context.Context, query queries.ListWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetFeatured(ctx, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get featured weaves")
+	}
+
+	total, err := uc.weaveRepo.CountFeatured(ctx)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count featured weaves")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// GetTrendingWeavesUseCase handles listing trending weaves
+type GetTrendingWeavesUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetTrendingWeavesUseCase creates a new GetTrendingWeavesUseCase
+func NewGetTrendingWeavesUseCase(weaveRepo repositories.WeaveRepository) *GetTrendingWeavesUseCase {
+	return &GetTrendingWeavesUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists weaves ranked by recent activity within the timeframe
+func (uc *GetTrendingWeavesUseCase) Execute(ctx context.Context, query queries.GetTrendingWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetTrending(ctx, query.Timeframe, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get trending weaves")
+	}
+
+	// Every published weave takes part in the ranking
+	total, err := uc.weaveRepo.Count(ctx)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count weaves")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// GetPopularWeavesUseCase handles listing popular weaves
+type GetPopularWeavesUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetPopularWeavesUseCase creates a new GetPopularWeavesUseCase
+func NewGetPopularWeavesUseCase(weaveRepo repositories.WeaveRepository) *GetPopularWeavesUseCase {
+	return &GetPopularWeavesUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists weaves ranked by all-time engagement
+func (uc *GetPopularWeavesUseCase) Execute(ctx context.Context, query queries.ListWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetPopular(ctx, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get popular weaves")
+	}
+
+	total, err := uc.weaveRepo.Count(ctx)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count weaves")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// SearchWeavesUseCase handles weave search
+type SearchWeavesUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewSearchWeavesUseCase creates a new SearchWeavesUseCase
+func NewSearchWeavesUseCase(weaveRepo repositories.WeaveRepository) *SearchWeavesUseCase {
+	return &SearchWeavesUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute searches published weaves by title and description
+func (uc *SearchWeavesUseCase) Execute(ctx context.Context, query queries.SearchWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.Search(ctx, query.Query, query.ChannelID, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to search weaves")
+	}
+
+	total, err := uc.weaveRepo.SearchCount(ctx, query.Query, query.ChannelID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count search results")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// GetWeaveForksUseCase handles listing the forks of a weave
+type GetWeaveForksUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetWeaveForksUseCase creates a new GetWeaveForksUseCase
+func NewGetWeaveForksUseCase(weaveRepo repositories.WeaveRepository) *GetWeaveForksUseCase {
+	return &GetWeaveForksUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists published forks of a weave
+func (uc *GetWeaveForksUseCase) Execute(ctx context.Context, query queries.GetWeaveForksQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetForked(ctx, query.WeaveID, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get forks")
+	}
+
+	total, err := uc.weaveRepo.CountForks(ctx, query.WeaveID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count forks")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// GetDraftsUseCase handles listing a user's drafts
+type GetDraftsUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetDraftsUseCase creates a new GetDraftsUseCase
+func NewGetDraftsUseCase(weaveRepo repositories.WeaveRepository) *GetDraftsUseCase {
+	return &GetDraftsUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists the user's unpublished weaves
+func (uc *GetDraftsUseCase) Execute(ctx context.Context, query queries.GetUserWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetDrafts(ctx, query.UserID, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get drafts")
+	}
+
+	total, err := uc.weaveRepo.CountDrafts(ctx, query.UserID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count drafts")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// GetLikedWeavesUseCase handles listing weaves liked by a user
+type GetLikedWeavesUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetLikedWeavesUseCase creates a new GetLikedWeavesUseCase
+func NewGetLikedWeavesUseCase(weaveRepo repositories.WeaveRepository) *GetLikedWeavesUseCase {
+	return &GetLikedWeavesUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute lists weaves the user has liked, most recent like first
+func (uc *GetLikedWeavesUseCase) Execute(ctx context.Context, query queries.GetUserWeavesQuery) (*dto.PaginatedWeavesResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetLikedBy(ctx, query.UserID, query.Limit, offset)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to get liked weaves")
+	}
+
+	total, err := uc.weaveRepo.CountLikedByUser(ctx, query.UserID)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to count liked weaves")
+	}
+
+	return &dto.PaginatedWeavesResponse{
+		Weaves: dto.WeavesToResponse(weaves),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package weave
+
+import (
+	"context"
+	"fmt"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+	"weave-module/errors"
+)
+
+// RevertWeaveUseCase handles restoring an earlier version of a weave
+type RevertWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewRevertWeaveUseCase creates a new RevertWeaveUseCase
+func NewRevertWeaveUseCase(weaveRepo repositories.WeaveRepository) *RevertWeaveUseCase {
+	return &RevertWeaveUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute records a new version whose content matches the chosen one
+func (uc *RevertWeaveUseCase) Execute(ctx context.Context, cmd commands.RevertWeaveCommand) (*dto.WeaveVersionResponse, error) {
+	weave, err := findEditableWeave(ctx, uc.weaveRepo, cmd.WeaveID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := findVersion(ctx, uc.weaveRepo, weave.ID, cmd.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Title == weave.Title && diff.Equal(target.Content, weave.Content) {
+		return nil, errors.BadRequest(fmt.Sprintf("Weave already matches v%d", target.Version))
+	}
+
+	version, err := uc.weaveRepo.RevertToVersion(ctx, weave.ID, cmd.UserID, target.Version)
+	if err != nil {
+		return nil, errors.InternalServerError("Failed to revert weave")
+	}
+
+	return dto.WeaveVersionToResponse(version), nil
+}
@@ -0,0 +1,83 @@
+package weave
+
+import (
+	"context"
+	"errors"
+
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// SchedulePublicationUseCase handles scheduling and rescheduling a weave's publication
+type SchedulePublicationUseCase struct {
+	weaveRepo repositories.WeaveRepository
+	registry  *contenttypes.Registry
+}
+
+// NewSchedulePublicationUseCase creates a new SchedulePublicationUseCase
+func NewSchedulePublicationUseCase(weaveRepo repositories.WeaveRepository, registry *contenttypes.Registry) *SchedulePublicationUseCase {
+	return &SchedulePublicationUseCase{
+		weaveRepo: weaveRepo,
+		registry:  registry,
+	}
+}
+
+// Execute sets the time the scheduler publishes the weave. The content is validated
+// now, since nobody is around to fix it when the schedule fires.
+func (uc *SchedulePublicationUseCase) Execute(ctx context.Context, cmd commands.SchedulePublicationCommand) (*dto.WeaveResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := weave.SchedulePublication(cmd.PublishAt, cmd.UserID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.registry.PrepareContent(weave.Content); err != nil {
+		return nil, err
+	}
+
+	return savePublishSchedule(ctx, uc.weaveRepo, weave)
+}
+
+// CancelScheduledPublicationUseCase handles dropping a weave's publication schedule
+type CancelScheduledPublicationUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewCancelScheduledPublicationUseCase creates a new CancelScheduledPublicationUseCase
+func NewCancelScheduledPublicationUseCase(weaveRepo repositories.WeaveRepository) *CancelScheduledPublicationUseCase {
+	return &CancelScheduledPublicationUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute leaves the weave in its current status without a schedule
+func (uc *CancelScheduledPublicationUseCase) Execute(ctx context.Context, cmd commands.CancelScheduledPublicationCommand) (*dto.WeaveResponse, error) {
+	weave, err := lookup.Weave(ctx, uc.weaveRepo, cmd.WeaveID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := weave.CancelScheduledPublication(cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	return savePublishSchedule(ctx, uc.weaveRepo, weave)
+}
+
+func savePublishSchedule(ctx context.Context, weaveRepo repositories.WeaveRepository, weave *entities.Weave) (*dto.WeaveResponse, error) {
+	if err := weaveRepo.UpdatePublishSchedule(ctx, weave); err != nil {
+		if errors.Is(err, repositories.ErrWeaveStatusConflict) {
+			return nil, appErrors.Conflict("The weave was published or changed status in the meantime")
+		}
+		return nil, appErrors.InternalServerError("Failed to update publication schedule")
+	}
+
+	return dto.WeaveToResponse(weave), nil
+}
@@ -0,0 +1,175 @@
+package weave
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// GetUpstreamStatusUseCase handles comparing a fork with its parent weave
+type GetUpstreamStatusUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewGetUpstreamStatusUseCase creates a new GetUpstreamStatusUseCase
+func NewGetUpstreamStatusUseCase(weaveRepo repositories.WeaveRepository) *GetUpstreamStatusUseCase {
+	return &GetUpstreamStatusUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute reports how many versions a fork is ahead of and behind its parent
+func (uc *GetUpstreamStatusUseCase) Execute(ctx context.Context, query queries.GetUpstreamStatusQuery) (*dto.UpstreamStatusResponse, error) {
+	fork, err := findVisibleWeave(ctx, uc.weaveRepo, query.WeaveID, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := findUpstream(ctx, uc.weaveRepo, fork, query.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return upstreamStatus(fork, parent), nil
+}
+
+// SyncWeaveUseCase handles merging new upstream changes into a fork
+type SyncWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+	registry  *contenttypes.Registry
+}
+
+// NewSyncWeaveUseCase creates a new SyncWeaveUseCase
+func NewSyncWeaveUseCase(weaveRepo repositories.WeaveRepository, registry *contenttypes.Registry) *SyncWeaveUseCase {
+	return &SyncWeaveUseCase{
+		weaveRepo: weaveRepo,
+		registry:  registry,
+	}
+}
+
+// Execute three-way merges the parent's changes since the last sync into the fork.
+// Conflicting merges are reported without touching the fork. The merged content
+// is upgraded to the current schema of its type and validated like any edit.
+func (uc *SyncWeaveUseCase) Execute(ctx context.Context, cmd commands.SyncWeaveCommand) (*dto.SyncWeaveResponse, error) {
+	fork, err := findEditableWeave(ctx, uc.weaveRepo, cmd.WeaveID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := findUpstream(ctx, uc.weaveRepo, fork, &cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SyncWeaveResponse{
+		WeaveID:         fork.ID,
+		UpstreamVersion: parent.Version,
+		Conflicts:       []diff.Conflict{},
+	}
+	if fork.VersionsBehind(parent) <= 0 {
+		response.Synced = true
+		return response, nil
+	}
+
+	weaveID, number := fork.UpstreamBase()
+	base, err := findVersion(ctx, uc.weaveRepo, weaveID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := diff.Merge(base.Content, fork.Content, parent.Content)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to merge upstream content")
+	}
+	if result.HasConflicts() {
+		response.Conflicts = result.Conflicts
+		return response, nil
+	}
+
+	merged, err := toWeaveContent(result.Merged)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to merge upstream content")
+	}
+	if merged, err = uc.registry.PrepareContent(merged); err != nil {
+		return nil, err
+	}
+
+	version, err := uc.weaveRepo.SyncWithUpstream(ctx, fork.ID, cmd.UserID, merged, parent.Version, fork.Version)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWeaveVersionConflict) {
+			return nil, appErrors.Conflict("The fork was updated while syncing, please retry")
+		}
+		return nil, appErrors.InternalServerError("Failed to sync weave")
+	}
+
+	response.Synced = true
+	if version != nil {
+		response.Version = dto.WeaveVersionToResponse(version)
+	}
+	return response, nil
+}
+
+// findUpstream loads the parent of a fork, hiding drafts from anyone but their owner
+func findUpstream(ctx context.Context, weaveRepo repositories.WeaveRepository, fork *entities.Weave, viewerID *uuid.UUID) (*entities.Weave, error) {
+	if !fork.IsForked() {
+		return nil, appErrors.BadRequest("Weave is not a fork")
+	}
+
+	parent, err := weaveRepo.GetByID(ctx, *fork.ParentWeaveID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Upstream weave not found")
+		}
+		return nil, appErrors.InternalServerError("Failed to get upstream weave")
+	}
+	if !parent.IsVisibleTo(viewerID) {
+		return nil, appErrors.NotFound("Upstream weave not found")
+	}
+
+	return parent, nil
+}
+
+func upstreamStatus(fork, parent *entities.Weave) *dto.UpstreamStatusResponse {
+	upstreamVersion := 0
+	if fork.UpstreamVersion != nil {
+		upstreamVersion = *fork.UpstreamVersion
+	}
+
+	behind := fork.VersionsBehind(parent)
+	if behind < 0 {
+		behind = 0
+	}
+
+	return &dto.UpstreamStatusResponse{
+		WeaveID:         fork.ID,
+		ParentWeaveID:   parent.ID,
+		Version:         fork.Version,
+		UpstreamVersion: upstreamVersion,
+		ParentVersion:   parent.Version,
+		Ahead:           fork.VersionsAhead(),
+		Behind:          behind,
+		HasDiverged:     !diff.Equal(fork.Content, parent.Content),
+	}
+}
+
+// toWeaveContent converts a normalized JSON document back into weave content
+func toWeaveContent(doc interface{}) (entities.WeaveContent, error) {
+	var content entities.WeaveContent
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return content, err
+	}
+	err = json.Unmarshal(data, &content)
+	return content, err
+}
@@ -0,0 +1,88 @@
+package weave
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/queries"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// GetTrashUseCase handles listing a user's deleted weaves
+type GetTrashUseCase struct {
+	weaveRepo repositories.WeaveRepository
+	retention time.Duration
+}
+
+// NewGetTrashUseCase creates a new GetTrashUseCase
+func NewGetTrashUseCase(weaveRepo repositories.WeaveRepository, retention time.Duration) *GetTrashUseCase {
+	return &GetTrashUseCase{
+		weaveRepo: weaveRepo,
+		retention: retention,
+	}
+}
+
+// Execute lists the user's deleted weaves, most recently deleted first,
+// with the time each one will be removed permanently
+func (uc *GetTrashUseCase) Execute(ctx context.Context, query queries.GetUserWeavesQuery) (*dto.PaginatedTrashResponse, error) {
+	offset := (query.Page - 1) * query.Limit
+
+	weaves, err := uc.weaveRepo.GetTrashed(ctx, query.UserID, query.Limit, offset)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to get trash")
+	}
+
+	total, err := uc.weaveRepo.CountTrashed(ctx, query.UserID)
+	if err != nil {
+		return nil, appErrors.InternalServerError("Failed to count trash")
+	}
+
+	return &dto.PaginatedTrashResponse{
+		Weaves: dto.TrashedWeavesToResponse(weaves, uc.retention),
+		Page:   query.Page,
+		Limit:  query.Limit,
+		Total:  int(total),
+	}, nil
+}
+
+// RestoreWeaveUseCase handles taking a weave out of the trash
+type RestoreWeaveUseCase struct {
+	weaveRepo repositories.WeaveRepository
+}
+
+// NewRestoreWeaveUseCase creates a new RestoreWeaveUseCase
+func NewRestoreWeaveUseCase(weaveRepo repositories.WeaveRepository) *RestoreWeaveUseCase {
+	return &RestoreWeaveUseCase{
+		weaveRepo: weaveRepo,
+	}
+}
+
+// Execute returns a deleted weave to the status it was deleted from
+func (uc *RestoreWeaveUseCase) Execute(ctx context.Context, cmd commands.RestoreWeaveCommand) (*dto.WeaveResponse, error) {
+	weave, err := uc.weaveRepo.GetTrashedByID(ctx, cmd.WeaveID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErrors.NotFound("Weave not found in trash")
+		}
+		return nil, appErrors.InternalServerError("Failed to get weave")
+	}
+
+	if err := weave.Restore(cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.weaveRepo.UpdateStatus(ctx, weave, entities.WeaveStatusDeleted, cmd.UserID); err != nil {
+		if errors.Is(err, repositories.ErrWeaveStatusConflict) {
+			return nil, appErrors.Conflict("The weave was already restored")
+		}
+		return nil, appErrors.InternalServerError("Failed to restore weave")
+	}
+
+	return dto.WeaveToResponse(weave), nil
+}
@@ -0,0 +1,125 @@
+package weave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/usecases/lookup"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// UpdateWeaveUseCase handles weave update business logic
+type UpdateWeaveUseCase struct {
+	weaveRepo   repositories.WeaveRepository
+	channelRepo repositories.ChannelRepository
+	tagRepo     repositories.TagRepository
+	registry    *contenttypes.Registry
+}
+
+// NewUpdateWeaveUseCase creates a new UpdateWeaveUseCase
+func NewUpdateWeaveUseCase(weaveRepo repositories.WeaveRepository, channelRepo repositories.ChannelRepository, tagRepo repositories.TagRepository, registry *contenttypes.Registry) *UpdateWeaveUseCase {
+	return &UpdateWeaveUseCase{
+		weaveRepo:   weaveRepo,
+		channelRepo: channelRepo,
+		tagRepo:     tagRepo,
+		registry:    registry,
+	}
+}
+
+// Execute updates a weave and records the edit as its next version, credited to
+// the editor. Edits are rejected unless the current version is one of the
+// expected ones, so concurrent saves cannot overwrite each other; AnyVersion
+// skips that check but still never overwrites a save made after the weave was
+// read. Tags are not versioned.
+func (uc *UpdateWeaveUseCase) Execute(ctx context.Context, cmd commands.UpdateWeaveCommand) (*dto.WeaveResponse, error) {
+	weave, err := findEditableWeave(ctx, uc.weaveRepo, cmd.WeaveID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !cmd.AnyVersion && !slices.Contains(cmd.ExpectedVersions, weave.Version) {
+		return nil, staleVersionError(weave.ID, latestExpected(cmd.ExpectedVersions), weave.Version)
+	}
+	baseVersion := weave.Version
+
+	var tags []string
+	if cmd.Tags != nil {
+		if tags, err = entities.NormalizeTags(*cmd.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Content != nil {
+		channel, err := findChannel(ctx, uc.channelRepo, weave.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+		content, err := prepareChannelContent(uc.registry, channel, *cmd.Content)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Content = &content
+	}
+
+	edited := cmd.Title != nil || cmd.Description != nil || cmd.CoverImage != nil || cmd.Content != nil
+	if cmd.Title != nil {
+		weave.Title = *cmd.Title
+	}
+	if cmd.Description != nil {
+		weave.Description = cmd.Description
+	}
+	if cmd.CoverImage != nil {
+		weave.CoverImage = cmd.CoverImage
+	}
+	if cmd.Content != nil {
+		weave.UpdateContent(*cmd.Content)
+	}
+
+	if edited {
+		weave.NextVersion()
+		if _, err := uc.weaveRepo.Update(ctx, weave, baseVersion, cmd.UserID, cmd.ChangeLog); err != nil {
+			if errors.Is(err, repositories.ErrWeaveVersionConflict) {
+				// Someone saved in between; report the version they left behind
+				current, findErr := lookup.Weave(ctx, uc.weaveRepo, weave.ID)
+				if findErr != nil {
+					return nil, findErr
+				}
+				return nil, staleVersionError(weave.ID, baseVersion, current.Version)
+			}
+			return nil, appErrors.InternalServerError("Failed to update weave")
+		}
+	}
+
+	if cmd.Tags != nil {
+		if weave.Tags, err = uc.tagRepo.SetWeaveTags(ctx, weave.ID, tags); err != nil {
+			return nil, appErrors.InternalServerError("Failed to update weave tags")
+		}
+	}
+
+	return dto.WeaveToResponse(weave), nil
+}
+
+// latestExpected picks the version a rejected edit is reported against
+func latestExpected(versions []int) int {
+	if len(versions) == 0 {
+		return 0
+	}
+	return slices.Max(versions)
+}
+
+// staleVersionError reports an edit based on an outdated version along with the current one
+func staleVersionError(weaveID uuid.UUID, expected, current int) error {
+	message := fmt.Sprintf("Weave has changed since v%d, the current version is v%d", expected, current)
+	return appErrors.Conflict(message).WithData(dto.StaleVersionResponse{
+		WeaveID:         weaveID,
+		ExpectedVersion: expected,
+		CurrentVersion:  current,
+	})
+}
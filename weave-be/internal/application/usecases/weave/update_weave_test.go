@@ -0,0 +1,259 @@
+package weave
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"weave-be/internal/application/commands"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/domain/contenttypes"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	appErrors "weave-module/errors"
+)
+
+// Mock WeaveRepository for testing; methods a test does not set panic
+type mockWeaveRepository struct {
+	repositories.WeaveRepository
+	getByIDFunc func(ctx context.Context, id uuid.UUID) (*entities.Weave, error)
+	updateFunc  func(ctx context.Context, weave *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error)
+}
+
+func (m *mockWeaveRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+	return m.getByIDFunc(ctx, id)
+}
+
+func (m *mockWeaveRepository) Update(ctx context.Context, weave *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+	return m.updateFunc(ctx, weave, expectedVersion, userID, changeLog)
+}
+
+// Mock ChannelRepository for testing
+type mockChannelRepository struct {
+	repositories.ChannelRepository
+	channel *entities.Channel
+}
+
+func (m *mockChannelRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Channel, error) {
+	return m.channel, nil
+}
+
+// storedWeave returns a weave at version 3 with an accepted editor
+func storedWeave(ownerID uuid.UUID) (*entities.Weave, uuid.UUID) {
+	weave := entities.NewWeave(ownerID, uuid.New(), "Weekend ramen", entities.WeaveContent{
+		Type: "recipe",
+		Data: map[string]interface{}{"servings": 2},
+	})
+	weave.Version = 3
+
+	editor := entities.NewWeaveCollaborator(weave.ID, uuid.New(), ownerID, entities.CollaboratorRoleEditor)
+	editor.Accept()
+	weave.Collaborators = append(weave.Collaborators, editor)
+	return weave, editor.UserID
+}
+
+// expectConflict checks that err is a 409 reporting the expected and current versions
+func expectConflict(t *testing.T, err error, expected, current int) {
+	t.Helper()
+	appErr, ok := err.(*appErrors.AppError)
+	if !ok || appErr.Code != http.StatusConflict {
+		t.Fatalf("Expected a conflict error, got %v", err)
+	}
+	stale, ok := appErr.Data.(dto.StaleVersionResponse)
+	if !ok {
+		t.Fatalf("Expected stale version data, got %T", appErr.Data)
+	}
+	if stale.ExpectedVersion != expected || stale.CurrentVersion != current {
+		t.Errorf("Expected v%d against v%d, got v%d against v%d", expected, current, stale.ExpectedVersion, stale.CurrentVersion)
+	}
+}
+
+func TestUpdateWeaveUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	title := "Midweek ramen"
+
+	t.Run("metadata edit by a collaborator is a new version credited to them", func(t *testing.T) {
+		weave, editorID := storedWeave(uuid.New())
+		var savedBy uuid.UUID
+		var savedVersion int
+
+		repo := &mockWeaveRepository{
+			getByIDFunc: func(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+				return weave, nil
+			},
+			updateFunc: func(ctx context.Context, w *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+				if expectedVersion != 3 {
+					t.Errorf("Expected the save to be based on v3, got v%d", expectedVersion)
+				}
+				savedBy, savedVersion = userID, w.Version
+				return entities.NewWeaveVersion(w.ID, userID, w.Version, w.Title, w.Content, changeLog), nil
+			},
+		}
+
+		useCase := NewUpdateWeaveUseCase(repo, nil, nil, nil)
+		result, err := useCase.Execute(ctx, commands.UpdateWeaveCommand{
+			WeaveID:          weave.ID,
+			UserID:           editorID,
+			Title:            &title,
+			ExpectedVersions: []int{3},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if savedBy != editorID {
+			t.Errorf("Expected the version to be credited to the editor %s, got %s", editorID, savedBy)
+		}
+		if savedVersion != 4 || result.Version != 4 {
+			t.Errorf("Expected a title edit to move the weave to v4, saved v%d and returned v%d", savedVersion, result.Version)
+		}
+	})
+
+	t.Run("edit based on an old version is rejected before saving", func(t *testing.T) {
+		weave, editorID := storedWeave(uuid.New())
+
+		repo := &mockWeaveRepository{
+			getByIDFunc: func(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+				return weave, nil
+			},
+			updateFunc: func(ctx context.Context, w *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+				t.Fatal("Expected no save for a stale edit")
+				return nil, nil
+			},
+		}
+
+		useCase := NewUpdateWeaveUseCase(repo, nil, nil, nil)
+		_, err := useCase.Execute(ctx, commands.UpdateWeaveCommand{
+			WeaveID:          weave.ID,
+			UserID:           editorID,
+			Title:            &title,
+			ExpectedVersions: []int{2},
+		})
+
+		expectConflict(t, err, 2, 3)
+	})
+
+	t.Run("concurrent save reports the version it left behind", func(t *testing.T) {
+		weave, editorID := storedWeave(uuid.New())
+		reads := 0
+
+		repo := &mockWeaveRepository{
+			getByIDFunc: func(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+				reads++
+				current := *weave
+				current.Version = 3 + reads - 1 // The second read sees the other save
+				return &current, nil
+			},
+			updateFunc: func(ctx context.Context, w *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+				return nil, repositories.ErrWeaveVersionConflict
+			},
+		}
+
+		useCase := NewUpdateWeaveUseCase(repo, nil, nil, nil)
+		_, err := useCase.Execute(ctx, commands.UpdateWeaveCommand{
+			WeaveID:          weave.ID,
+			UserID:           editorID,
+			Title:            &title,
+			ExpectedVersions: []int{3},
+		})
+
+		expectConflict(t, err, 3, 4)
+	})
+
+	t.Run("edit accepting any version is based on the version read", func(t *testing.T) {
+		weave, editorID := storedWeave(uuid.New())
+
+		repo := &mockWeaveRepository{
+			getByIDFunc: func(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+				return weave, nil
+			},
+			updateFunc: func(ctx context.Context, w *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+				if expectedVersion != 3 {
+					t.Errorf("Expected the save to be based on the v3 read, got v%d", expectedVersion)
+				}
+				return entities.NewWeaveVersion(w.ID, userID, w.Version, w.Title, w.Content, changeLog), nil
+			},
+		}
+
+		useCase := NewUpdateWeaveUseCase(repo, nil, nil, nil)
+		result, err := useCase.Execute(ctx, commands.UpdateWeaveCommand{
+			WeaveID:    weave.ID,
+			UserID:     editorID,
+			Title:      &title,
+			AnyVersion: true,
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Version != 4 {
+			t.Errorf("Expected the weave to move to v4, got v%d", result.Version)
+		}
+	})
+
+	t.Run("content of a weave in an inactive channel can still be edited", func(t *testing.T) {
+		weave, editorID := storedWeave(uuid.New())
+		channels := &mockChannelRepository{channel: &entities.Channel{
+			ID:                   weave.ChannelID,
+			Name:                 "Recipes",
+			IsActive:             false,
+			AcceptedContentTypes: []string{"recipe"},
+		}}
+		registry, err := contenttypes.NewDefaultRegistry()
+		if err != nil {
+			t.Fatalf("Expected built-in content types to load, got %v", err)
+		}
+
+		repo := &mockWeaveRepository{
+			getByIDFunc: func(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+				return weave, nil
+			},
+			updateFunc: func(ctx context.Context, w *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+				return entities.NewWeaveVersion(w.ID, userID, w.Version, w.Title, w.Content, changeLog), nil
+			},
+		}
+
+		useCase := NewUpdateWeaveUseCase(repo, channels, nil, registry)
+		_, err = useCase.Execute(ctx, commands.UpdateWeaveCommand{
+			WeaveID:          weave.ID,
+			UserID:           editorID,
+			ExpectedVersions: []int{3},
+			Content: &entities.WeaveContent{
+				Type: "recipe",
+				Data: map[string]interface{}{
+					"ingredients":  []interface{}{map[string]interface{}{"name": "Ramen noodles", "amount": "2"}},
+					"instructions": []interface{}{map[string]interface{}{"step": 1, "description": "Boil water"}},
+					"servings":     3,
+					"difficulty":   "easy",
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected the edit to be saved, got %v", err)
+		}
+	})
+
+	t.Run("readers cannot edit", func(t *testing.T) {
+		weave, _ := storedWeave(uuid.New())
+
+		repo := &mockWeaveRepository{
+			getByIDFunc: func(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+				return weave, nil
+			},
+		}
+
+		useCase := NewUpdateWeaveUseCase(repo, nil, nil, nil)
+		_, err := useCase.Execute(ctx, commands.UpdateWeaveCommand{
+			WeaveID:          weave.ID,
+			UserID:           uuid.New(),
+			Title:            &title,
+			ExpectedVersions: []int{3},
+		})
+
+		if !appErrors.IsForbidden(err) {
+			t.Errorf("Expected a forbidden error, got %v", err)
+		}
+	})
+}
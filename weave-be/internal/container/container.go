@@ -1,12 +1,18 @@
 package container
 
 import (
-	"weave-module/config"
+	"log"
+	"time"
+
 	"weave-be/internal/application/services"
+	"weave-be/internal/domain/contenttypes"
 	"weave-be/internal/domain/repositories"
 	domainServices "weave-be/internal/domain/services"
+	"weave-be/internal/infrastructure/cache"
 	infraDB "weave-be/internal/infrastructure/database"
+	"weave-be/internal/infrastructure/messaging"
 	"weave-be/internal/presentation/handlers"
+	"weave-module/config"
 )
 
 // Container holds all application dependencies
@@ -17,16 +23,46 @@ type Container struct {
 	userRepo              repositories.UserRepository
 	weaveRepo             repositories.WeaveRepository
 	emailVerificationRepo repositories.EmailVerificationRepository
+	contributionRepo      repositories.ContributionRepository
+	channelRepo           repositories.ChannelRepository
+	collaboratorRepo      repositories.CollaboratorRepository
+	templateRepo          repositories.TemplateRepository
+	collectionRepo        repositories.CollectionRepository
+	tagRepo               repositories.TagRepository
+	commentRepo           repositories.CommentRepository
+	mergePolicyRepo       repositories.MergePolicyRepository
+
+	// Caches
+	blameCache repositories.WeaveBlameCache
+
+	// Content type schemas weave content is validated against
+	contentTypes *contenttypes.Registry
 
 	// Domain Services
-	userDomainService domainServices.UserDomainService
+	userDomainService   domainServices.UserDomainService
+	notificationService domainServices.NotificationService
+	taskService         domainServices.TaskService
 
 	// Application Services (Use Case Based)
-	userService *services.UserApplicationService
+	userService         *services.UserApplicationService
+	weaveService        *services.WeaveApplicationService
+	contributionService *services.ContributionApplicationService
+	collaboratorService *services.CollaboratorApplicationService
+	templateService     *services.TemplateApplicationService
+	collectionService   *services.CollectionApplicationService
+	tagService          *services.TagApplicationService
+	commentService      *services.CommentApplicationService
 
 	// Handlers
-	userHandler  *handlers.UserHandler
-	oauthHandler *handlers.OAuthHandler
+	userHandler         *handlers.UserHandler
+	oauthHandler        *handlers.OAuthHandler
+	weaveHandler        *handlers.WeaveHandler
+	contributionHandler *handlers.ContributionHandler
+	collaboratorHandler *handlers.CollaboratorHandler
+	templateHandler     *handlers.TemplateHandler
+	collectionHandler   *handlers.CollectionHandler
+	tagHandler          *handlers.TagHandler
+	commentHandler      *handlers.CommentHandler
 }
 
 // NewContainer creates and initializes the dependency injection container
@@ -36,6 +72,7 @@ func NewContainer(cfg *config.Config) *Container {
 	}
 
 	container.initializeRepositories()
+	container.initializeContentTypes()
 	container.initializeDomainServices()
 	container.initializeApplicationServices()
 	container.initializeHandlers()
@@ -46,21 +83,58 @@ func NewContainer(cfg *config.Config) *Container {
 func (c *Container) initializeRepositories() {
 	c.userRepo = infraDB.NewUserRepository()
 	c.emailVerificationRepo = infraDB.NewEmailVerificationRepository()
-	// TODO: Implement WeaveRepository when ready
-	// c.weaveRepo = infraDB.NewWeaveRepository()
+	c.weaveRepo = infraDB.NewWeaveRepository()
+	c.contributionRepo = infraDB.NewContributionRepository()
+	c.channelRepo = infraDB.NewChannelRepository()
+	c.collaboratorRepo = infraDB.NewCollaboratorRepository()
+	c.templateRepo = infraDB.NewTemplateRepository()
+	c.collectionRepo = infraDB.NewCollectionRepository()
+	c.tagRepo = infraDB.NewTagRepository()
+	c.commentRepo = infraDB.NewCommentRepository()
+	c.mergePolicyRepo = infraDB.NewMergePolicyRepository()
+	c.blameCache = cache.NewWeaveBlameCache()
+}
+
+func (c *Container) initializeContentTypes() {
+	registry, err := contenttypes.NewDefaultRegistry()
+	if err != nil {
+		log.Fatalf("Failed to load content types: %v", err)
+	}
+	c.contentTypes = registry
 }
 
 func (c *Container) initializeDomainServices() {
 	c.userDomainService = domainServices.NewUserDomainService(c.userRepo, c.cfg)
+	c.notificationService = messaging.NewNotificationPublisher()
+	c.taskService = messaging.NewTaskPublisher()
 }
 
 func (c *Container) initializeApplicationServices() {
 	c.userService = services.NewUserApplicationService(c.userRepo, c.weaveRepo, c.userDomainService, c.emailVerificationRepo, c.cfg)
+	c.weaveService = services.NewWeaveApplicationService(c.weaveRepo, c.channelRepo, c.contributionRepo, c.userRepo, c.tagRepo, c.blameCache, c.contentTypes, c.userDomainService, c.taskService, c.trashRetention())
+	c.contributionService = services.NewContributionApplicationService(c.contributionRepo, c.weaveRepo, c.mergePolicyRepo, c.contentTypes, c.notificationService)
+	c.collaboratorService = services.NewCollaboratorApplicationService(c.collaboratorRepo, c.weaveRepo, c.userRepo, c.notificationService)
+	c.templateService = services.NewTemplateApplicationService(c.templateRepo, c.weaveRepo, c.channelRepo, c.userRepo)
+	c.collectionService = services.NewCollectionApplicationService(c.collectionRepo, c.weaveRepo, c.userRepo)
+	c.tagService = services.NewTagApplicationService(c.tagRepo, c.weaveRepo, c.userRepo)
+	c.commentService = services.NewCommentApplicationService(c.commentRepo, c.weaveRepo)
+}
+
+// trashRetention is how long deleted weaves stay restorable before the scheduler purges them
+func (c *Container) trashRetention() time.Duration {
+	return time.Duration(c.cfg.Trash.RetentionDays) * 24 * time.Hour
 }
 
 func (c *Container) initializeHandlers() {
 	c.userHandler = handlers.NewUserHandler(c.userService)
 	c.oauthHandler = handlers.NewOAuthHandler(c.userService, c.cfg)
+	c.weaveHandler = handlers.NewWeaveHandler(c.weaveService)
+	c.contributionHandler = handlers.NewContributionHandler(c.contributionService)
+	c.collaboratorHandler = handlers.NewCollaboratorHandler(c.collaboratorService)
+	c.templateHandler = handlers.NewTemplateHandler(c.templateService)
+	c.collectionHandler = handlers.NewCollectionHandler(c.collectionService)
+	c.tagHandler = handlers.NewTagHandler(c.tagService)
+	c.commentHandler = handlers.NewCommentHandler(c.commentService)
 }
 
 // Getters for accessing dependencies
@@ -82,4 +156,40 @@ func (c *Container) UserService() *services.UserApplicationService {
 
 func (c *Container) OAuthHandler() *handlers.OAuthHandler {
 	return c.oauthHandler
-}
\ No newline at end of file
+}
+
+func (c *Container) WeaveHandler() *handlers.WeaveHandler {
+	return c.weaveHandler
+}
+
+func (c *Container) WeaveRepository() repositories.WeaveRepository {
+	return c.weaveRepo
+}
+
+func (c *Container) WeaveService() *services.WeaveApplicationService {
+	return c.weaveService
+}
+
+func (c *Container) ContributionHandler() *handlers.ContributionHandler {
+	return c.contributionHandler
+}
+
+func (c *Container) CollaboratorHandler() *handlers.CollaboratorHandler {
+	return c.collaboratorHandler
+}
+
+func (c *Container) TemplateHandler() *handlers.TemplateHandler {
+	return c.templateHandler
+}
+
+func (c *Container) CollectionHandler() *handlers.CollectionHandler {
+	return c.collectionHandler
+}
+
+func (c *Container) TagHandler() *handlers.TagHandler {
+	return c.tagHandler
+}
+
+func (c *Container) CommentHandler() *handlers.CommentHandler {
+	return c.commentHandler
+}
@@ -0,0 +1,146 @@
+package contenttypes
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"weave-module/contentschema"
+	"weave-module/diff"
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// NewDefaultRegistry creates a registry with the content types shipped with Weave
+func NewDefaultRegistry() (*Registry, error) {
+	registry := NewRegistry(contentschema.Default())
+
+	builtins := []*ContentType{
+		{
+			Name:        "freeform",
+			Description: "Unstructured content for channels without a dedicated type",
+		},
+		{
+			Name:        "recipe",
+			Description: "Ingredients and numbered cooking steps",
+			Hooks:       []ValidationHook{uniqueIngredients, sequentialSteps},
+		},
+		{
+			Name:        "travel-plan",
+			Description: "A day-by-day itinerary for a trip",
+			Hooks:       []ValidationHook{itineraryWithinDuration},
+		},
+		{
+			Name:        "workout",
+			Description: "A routine of exercises with sets, reps or durations",
+			Hooks:       []ValidationHook{exercisesAreMeasurable},
+		},
+	}
+
+	for _, contentType := range builtins {
+		schema, err := loadSchema(contentType.Name)
+		if err != nil {
+			return nil, err
+		}
+		contentType.Schema = schema
+		contentType.Title = schema.Title
+
+		if err := registry.Register(contentType); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+func loadSchema(name string) (*Schema, error) {
+	data, err := schemaFiles.ReadFile("schemas/" + name + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("schema for content type %q not found: %w", name, err)
+	}
+
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema for content type %q: %w", name, err)
+	}
+	return &schema, nil
+}
+
+// objects returns the object items of a list field, which the schema has already checked
+func objects(data map[string]interface{}, field string) []map[string]interface{} {
+	items, _ := data[field].([]interface{})
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		obj, _ := item.(map[string]interface{})
+		result = append(result, obj)
+	}
+	return result
+}
+
+func uniqueIngredients(data map[string]interface{}) []FieldError {
+	var errs []FieldError
+	seen := make(map[string]int)
+	for i, ingredient := range objects(data, "ingredients") {
+		name, _ := ingredient["name"].(string)
+		key := strings.ToLower(strings.TrimSpace(name))
+		if first, ok := seen[key]; ok {
+			errs = append(errs, FieldError{
+				Field:   diff.JoinPath(diff.JoinIndex("/ingredients", i), "name"),
+				Message: fmt.Sprintf("duplicates ingredient %d", first),
+			})
+			continue
+		}
+		seen[key] = i
+	}
+	return errs
+}
+
+func sequentialSteps(data map[string]interface{}) []FieldError {
+	var errs []FieldError
+	for i, instruction := range objects(data, "instructions") {
+		step, _ := instruction["step"].(float64)
+		if int(step) != i+1 {
+			errs = append(errs, FieldError{
+				Field:   diff.JoinPath(diff.JoinIndex("/instructions", i), "step"),
+				Message: fmt.Sprintf("must be %d", i+1),
+			})
+		}
+	}
+	return errs
+}
+
+func itineraryWithinDuration(data map[string]interface{}) []FieldError {
+	duration, _ := data["duration"].(float64)
+
+	var errs []FieldError
+	seen := make(map[int]bool)
+	for i, day := range objects(data, "itinerary") {
+		number, _ := day["day"].(float64)
+		field := diff.JoinPath(diff.JoinIndex("/itinerary", i), "day")
+		switch {
+		case number > duration:
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("must not exceed the %g day duration", duration)})
+		case seen[int(number)]:
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("day %g is planned twice", number)})
+		}
+		seen[int(number)] = true
+	}
+	return errs
+}
+
+func exercisesAreMeasurable(data map[string]interface{}) []FieldError {
+	var errs []FieldError
+	for i, exercise := range objects(data, "exercises") {
+		_, hasReps := exercise["reps"]
+		_, hasDuration := exercise["duration"]
+		if !hasReps && !hasDuration {
+			errs = append(errs, FieldError{
+				Field:   diff.JoinIndex("/exercises", i),
+				Message: "needs reps or a duration",
+			})
+		}
+	}
+	return errs
+}
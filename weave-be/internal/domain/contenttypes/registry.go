@@ -0,0 +1,181 @@
+package contenttypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"weave-be/internal/domain/entities"
+	"weave-module/contentschema"
+	"weave-module/diff"
+	appErrors "weave-module/errors"
+)
+
+// ValidationHook checks rules a schema cannot express, such as relations
+// between fields. data has already passed the schema; paths are relative to it.
+type ValidationHook func(data map[string]interface{}) []FieldError
+
+// ContentType describes one kind of weave content
+type ContentType struct {
+	Name        string
+	Title       string
+	Description string
+	Version     int     // Current schema version, filled in on registration
+	Schema      *Schema // Describes the current schema version
+	Hooks       []ValidationHook
+}
+
+// Registry holds the content types weaves may use
+type Registry struct {
+	mu       sync.RWMutex
+	types    map[string]*ContentType
+	migrator *contentschema.Migrator
+}
+
+// NewRegistry creates an empty registry whose types are versioned by migrator
+func NewRegistry(migrator *contentschema.Migrator) *Registry {
+	return &Registry{
+		types:    make(map[string]*ContentType),
+		migrator: migrator,
+	}
+}
+
+// Register adds a content type, refusing duplicates and types without a schema
+func (r *Registry) Register(contentType *ContentType) error {
+	if contentType.Name == "" {
+		return fmt.Errorf("content type name is required")
+	}
+	if contentType.Schema == nil {
+		return fmt.Errorf("content type %q has no schema", contentType.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.types[contentType.Name]; exists {
+		return fmt.Errorf("content type %q is already registered", contentType.Name)
+	}
+	contentType.Version = r.migrator.CurrentVersion(contentType.Name)
+	r.types[contentType.Name] = contentType
+	return nil
+}
+
+// Get returns the content type registered under name
+func (r *Registry) Get(name string) (*ContentType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contentType, ok := r.types[name]
+	return contentType, ok
+}
+
+// List returns every registered content type ordered by name
+func (r *Registry) List() []*ContentType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]*ContentType, 0, len(r.types))
+	for _, contentType := range r.types {
+		types = append(types, contentType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name < types[j].Name
+	})
+	return types
+}
+
+// Validate checks weave content against its registered type. Paths in the
+// returned errors point into the content document, e.g. /data/ingredients/0.
+func (r *Registry) Validate(content entities.WeaveContent) []FieldError {
+	if content.Type == "" {
+		return []FieldError{{Field: "/type", Message: "is required"}}
+	}
+
+	contentType, ok := r.Get(content.Type)
+	if !ok {
+		return []FieldError{{Field: "/type", Message: fmt.Sprintf("unknown content type %q", content.Type)}}
+	}
+	if content.SchemaVersion > contentType.Version {
+		return []FieldError{{Field: "/schema_version", Message: fmt.Sprintf("must not be newer than v%d", contentType.Version)}}
+	}
+	if content.SchemaVersion < contentType.Version && contentType.Version > 1 {
+		return []FieldError{{Field: "/schema_version", Message: fmt.Sprintf("is outdated, the current version is v%d", contentType.Version)}}
+	}
+
+	// Hooks see the same generic JSON values the schema was checked against
+	normalized, err := diff.Normalize(content.Data)
+	if err != nil {
+		return []FieldError{{Field: "/data", Message: "is not valid JSON"}}
+	}
+	data, _ := normalized.(map[string]interface{})
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	if errs := contentType.Schema.Validate(data, "/data"); len(errs) > 0 {
+		return errs
+	}
+
+	var errs []FieldError
+	for _, hook := range contentType.Hooks {
+		for _, err := range hook(data) {
+			err.Field = "/data" + err.Field
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Prepare upgrades content written against an older schema of its type and
+// validates the result. The returned content is stamped with the current
+// schema version and is what should be stored.
+func (r *Registry) Prepare(content entities.WeaveContent) (entities.WeaveContent, []FieldError) {
+	if _, ok := r.Get(content.Type); ok {
+		if content.Data == nil {
+			content.Data = map[string]interface{}{}
+		}
+		data, version, err := r.migrator.Upgrade(content.Type, content.SchemaVersion, content.Data)
+		if err != nil {
+			return content, []FieldError{{Field: "/schema_version", Message: err.Error()}}
+		}
+		content.Data = data
+		content.SchemaVersion = version
+	}
+	return content, r.Validate(content)
+}
+
+// InvalidContent lists every problem found in weave content. It is the data of
+// the error PrepareContent returns, so editors can highlight them at once.
+type InvalidContent struct {
+	ContentType string       `json:"content_type"`
+	Errors      []FieldError `json:"errors"`
+}
+
+// InvalidContentError is the 400 for content with the given field problems
+func InvalidContentError(contentType string, fieldErrors []FieldError) error {
+	return appErrors.BadRequest("Weave content is invalid").WithData(InvalidContent{
+		ContentType: contentType,
+		Errors:      fieldErrors,
+	})
+}
+
+// PrepareContent runs Prepare for use cases that store or publish content.
+// Upgrades may rewrite the data they are given, so they run on a copy and the
+// caller's content is left as it was; problems come back as InvalidContentError.
+func (r *Registry) PrepareContent(content entities.WeaveContent) (entities.WeaveContent, error) {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return content, appErrors.InternalServerError("Failed to read weave content")
+	}
+	var upgradable entities.WeaveContent
+	if err := json.Unmarshal(raw, &upgradable); err != nil {
+		return content, appErrors.InternalServerError("Failed to read weave content")
+	}
+
+	prepared, fieldErrors := r.Prepare(upgradable)
+	if len(fieldErrors) > 0 {
+		return content, InvalidContentError(content.Type, fieldErrors)
+	}
+	return prepared, nil
+}
@@ -0,0 +1,196 @@
+package contenttypes
+
+import (
+	"net/http"
+	"testing"
+
+	"weave-be/internal/domain/entities"
+	"weave-module/contentschema"
+	appErrors "weave-module/errors"
+)
+
+func newTestRegistry(t *testing.T) *Registry {
+	registry, err := NewDefaultRegistry()
+	if err != nil {
+		t.Fatalf("Expected built-in content types to load, got %v", err)
+	}
+	return registry
+}
+
+func validRecipe() entities.WeaveContent {
+	return entities.WeaveContent{
+		Type: "recipe",
+		Data: map[string]interface{}{
+			"ingredients": []interface{}{
+				map[string]interface{}{"name": "Ramen noodles", "amount": "2", "unit": "packs"},
+				map[string]interface{}{"name": "Egg", "amount": "1"},
+			},
+			"instructions": []map[string]interface{}{
+				{"step": 1, "description": "Boil water"},
+				{"step": 2, "description": "Cook noodles", "duration": 4},
+			},
+			"servings":   2,
+			"difficulty": "easy",
+		},
+	}
+}
+
+func fields(errs []FieldError) map[string]bool {
+	result := make(map[string]bool)
+	for _, err := range errs {
+		result[err.Field] = true
+	}
+	return result
+}
+
+func TestRegistry_ValidateAcceptsValidContent(t *testing.T) {
+	registry := newTestRegistry(t)
+
+	if errs := registry.Validate(validRecipe()); len(errs) > 0 {
+		t.Fatalf("Expected valid recipe, got %v", errs)
+	}
+}
+
+func TestRegistry_ValidateReportsFieldErrors(t *testing.T) {
+	registry := newTestRegistry(t)
+	content := validRecipe()
+	content.Data["difficulty"] = "impossible"
+	content.Data["servings"] = 0
+	delete(content.Data, "instructions")
+
+	got := fields(registry.Validate(content))
+	for _, field := range []string{"/data/difficulty", "/data/servings", "/data/instructions"} {
+		if !got[field] {
+			t.Errorf("Expected an error for %s, got %v", field, got)
+		}
+	}
+}
+
+func TestRegistry_ValidateRunsHooksAfterSchema(t *testing.T) {
+	registry := newTestRegistry(t)
+	content := validRecipe()
+	content.Data["instructions"] = []interface{}{
+		map[string]interface{}{"step": 1, "description": "Boil water"},
+		map[string]interface{}{"step": 3, "description": "Cook noodles"},
+	}
+
+	errs := registry.Validate(content)
+	if len(errs) != 1 || errs[0].Field != "/data/instructions/1/step" {
+		t.Fatalf("Expected a single step numbering error, got %v", errs)
+	}
+}
+
+func TestRegistry_ValidateUnknownType(t *testing.T) {
+	registry := newTestRegistry(t)
+
+	errs := registry.Validate(entities.WeaveContent{Type: "spaceship", Data: map[string]interface{}{}})
+	if len(errs) != 1 || errs[0].Field != "/type" {
+		t.Fatalf("Expected an unknown type error, got %v", errs)
+	}
+}
+
+func TestRegistry_WorkoutNeedsRepsOrDuration(t *testing.T) {
+	registry := newTestRegistry(t)
+	content := entities.WeaveContent{
+		Type: "workout",
+		Data: map[string]interface{}{
+			"type":       "strength",
+			"difficulty": "beginner",
+			"exercises": []interface{}{
+				map[string]interface{}{"name": "Squat", "sets": 3, "reps": 10},
+				map[string]interface{}{"name": "Plank"},
+			},
+		},
+	}
+
+	errs := registry.Validate(content)
+	if len(errs) != 1 || errs[0].Field != "/data/exercises/1" {
+		t.Fatalf("Expected the plank to be rejected, got %v", errs)
+	}
+}
+
+func TestRegistry_RegisterRejectsDuplicates(t *testing.T) {
+	registry := newTestRegistry(t)
+
+	err := registry.Register(&ContentType{Name: "recipe", Schema: &Schema{Type: "object"}})
+	if err == nil {
+		t.Fatal("Expected registering recipe twice to fail")
+	}
+}
+
+func TestRegistry_PrepareUpgradesOldContent(t *testing.T) {
+	migrator := contentschema.NewMigrator()
+	migrator.Register("note", 1, func(data map[string]interface{}) (map[string]interface{}, error) {
+		data["body"] = data["text"]
+		delete(data, "text")
+		return data, nil
+	})
+
+	registry := NewRegistry(migrator)
+	registry.Register(&ContentType{
+		Name: "note",
+		Schema: &Schema{
+			Type:       "object",
+			Required:   []string{"body"},
+			Properties: map[string]*Schema{"body": {Type: "string"}},
+		},
+	})
+
+	old := entities.WeaveContent{Type: "note", Data: map[string]interface{}{"text": "hello"}}
+	if errs := registry.Validate(old); len(errs) != 1 || errs[0].Field != "/schema_version" {
+		t.Fatalf("Expected v1 content to be reported as outdated, got %v", errs)
+	}
+
+	prepared, errs := registry.Prepare(old)
+	if len(errs) > 0 {
+		t.Fatalf("Expected upgraded content to validate, got %v", errs)
+	}
+	if prepared.SchemaVersion != 2 || prepared.Data["body"] != "hello" {
+		t.Errorf("Expected content upgraded to v2, got %+v", prepared)
+	}
+
+	newer := entities.WeaveContent{Type: "note", SchemaVersion: 3, Data: map[string]interface{}{"body": "hi"}}
+	if _, errs := registry.Prepare(newer); len(errs) != 1 || errs[0].Field != "/schema_version" {
+		t.Errorf("Expected content from a newer schema to be refused, got %v", errs)
+	}
+}
+
+func TestRegistry_PrepareContentLeavesInputUntouched(t *testing.T) {
+	migrator := contentschema.NewMigrator()
+	migrator.Register("note", 1, func(data map[string]interface{}) (map[string]interface{}, error) {
+		data["body"] = data["text"]
+		delete(data, "text")
+		return data, nil
+	})
+
+	registry := NewRegistry(migrator)
+	registry.Register(&ContentType{
+		Name: "note",
+		Schema: &Schema{
+			Type:       "object",
+			Required:   []string{"body"},
+			Properties: map[string]*Schema{"body": {Type: "string"}},
+		},
+	})
+
+	old := entities.WeaveContent{Type: "note", Data: map[string]interface{}{"text": "hello"}}
+	prepared, err := registry.PrepareContent(old)
+	if err != nil {
+		t.Fatalf("Expected upgraded content to validate, got %v", err)
+	}
+	if prepared.SchemaVersion != 2 || prepared.Data["body"] != "hello" {
+		t.Errorf("Expected content upgraded to v2, got %+v", prepared)
+	}
+	if old.Data["text"] != "hello" || old.Data["body"] != nil {
+		t.Errorf("Expected the given content to be left as it was, got %+v", old)
+	}
+
+	_, err = registry.PrepareContent(entities.WeaveContent{Type: "note", SchemaVersion: 2, Data: map[string]interface{}{}})
+	appErr, ok := err.(*appErrors.AppError)
+	if !ok || appErr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected a 400 for content missing a required field, got %v", err)
+	}
+	if data, ok := appErr.Data.(InvalidContent); !ok || len(data.Errors) != 1 || data.Errors[0].Field != "/data/body" {
+		t.Errorf("Expected the missing field to be listed, got %+v", appErr.Data)
+	}
+}
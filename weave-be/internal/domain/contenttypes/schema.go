@@ -0,0 +1,182 @@
+package contenttypes
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"weave-module/diff"
+)
+
+// Schema is the subset of JSON Schema used to describe content types. It
+// serializes back to standard JSON Schema so editors can consume it directly.
+//
+// Supported keywords: type, properties, required, additionalProperties, items,
+// minItems, maxItems, minLength, maxLength, minimum, maximum, enum and the
+// "date" format.
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Format               string             `json:"format,omitempty"`
+}
+
+// FieldError is a validation failure at a JSON Pointer path
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Validate checks a value against the schema, reporting every failure under path
+func (s *Schema) Validate(value interface{}, path string) []FieldError {
+	normalized, err := diff.Normalize(value)
+	if err != nil {
+		return []FieldError{{Field: path, Message: "is not valid JSON"}}
+	}
+
+	var errs []FieldError
+	s.validate(normalized, path, &errs)
+	return errs
+}
+
+func (s *Schema) validate(value interface{}, path string, errs *[]FieldError) {
+	fail := func(format string, args ...interface{}) {
+		*errs = append(*errs, FieldError{Field: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if s.Type != "" && !matchesType(s.Type, value) {
+		fail("must be of type %s", s.Type)
+		return
+	}
+
+	if len(s.Enum) > 0 && !s.allows(value) {
+		fail("must be one of %s", formatEnum(s.Enum))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		s.validateObject(v, path, errs)
+	case []interface{}:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			fail("must contain at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			fail("must contain at most %d items", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(item, diff.JoinIndex(path, i), errs)
+			}
+		}
+	case string:
+		length := len([]rune(v))
+		if s.MinLength != nil && length < *s.MinLength {
+			if *s.MinLength == 1 {
+				fail("must not be empty")
+			} else {
+				fail("must be at least %d characters", *s.MinLength)
+			}
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			fail("must be at most %d characters", *s.MaxLength)
+		}
+		if s.Format == "date" {
+			if _, err := time.Parse("2006-01-02", v); err != nil {
+				fail("must be a date in YYYY-MM-DD format")
+			}
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			fail("must be at least %s", formatNumber(*s.Minimum))
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			fail("must be at most %s", formatNumber(*s.Maximum))
+		}
+	}
+}
+
+func (s *Schema) validateObject(obj map[string]interface{}, path string, errs *[]FieldError) {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			*errs = append(*errs, FieldError{Field: diff.JoinPath(path, name), Message: "is required"})
+		}
+	}
+
+	// Walk keys in order so the reported errors are stable
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property, ok := s.Properties[key]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				*errs = append(*errs, FieldError{Field: diff.JoinPath(path, key), Message: "is not allowed"})
+			}
+			continue
+		}
+		property.validate(obj[key], diff.JoinPath(path, key), errs)
+	}
+}
+
+func (s *Schema) allows(value interface{}) bool {
+	for _, candidate := range s.Enum {
+		if diff.Equal(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	}
+	return false
+}
+
+func formatEnum(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = fmt.Sprintf("%v", value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatNumber(n float64) string {
+	return fmt.Sprintf("%g", n)
+}
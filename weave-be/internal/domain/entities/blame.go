@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WeaveBlame attributes every field of a weave's content to the version that last changed it.
+// It is computed for one version of the weave and cached under it, hence the JSON tags.
+type WeaveBlame struct {
+	WeaveID uuid.UUID          `json:"weave_id"`
+	Version int                `json:"version"`
+	Fields  []FieldAttribution `json:"fields"`
+	Authors []BlameAuthor      `json:"authors"`
+}
+
+// FieldAttribution credits the value at Path to a version and its author.
+// ContributionID is set when the version came from a merged contribution.
+type FieldAttribution struct {
+	Path           string     `json:"path"`
+	Version        int        `json:"version"`
+	UserID         uuid.UUID  `json:"user_id"`
+	ContributionID *uuid.UUID `json:"contribution_id,omitempty"`
+	ChangedAt      time.Time  `json:"changed_at"`
+}
+
+// BlameAuthor summarizes how much of the current content one user wrote
+type BlameAuthor struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Username   string    `json:"username"`
+	FieldCount int       `json:"field_count"`
+}
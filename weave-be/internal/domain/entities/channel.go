@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+)
+
+// Channel groups weaves of a topic and decides which content types they may use
+type Channel struct {
+	ID                   uuid.UUID
+	Name                 string
+	Slug                 string
+	Description          *string
+	IsActive             bool
+	IsPublic             bool
+	AcceptedContentTypes []string // Empty accepts every registered content type
+}
+
+// Accepts reports whether weaves of the given content type may be posted to the channel
+func (c *Channel) Accepts(contentType string) bool {
+	if len(c.AcceptedContentTypes) == 0 {
+		return true
+	}
+	for _, accepted := range c.AcceptedContentTypes {
+		if accepted == contentType {
+			return true
+		}
+	}
+	return false
+}
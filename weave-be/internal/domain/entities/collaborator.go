@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CollaboratorRole is what a user may do with a weave they do not own
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleOwner      CollaboratorRole = "owner"
+	CollaboratorRoleMaintainer CollaboratorRole = "maintainer"
+	CollaboratorRoleEditor     CollaboratorRole = "editor"
+	CollaboratorRoleReviewer   CollaboratorRole = "reviewer"
+)
+
+// CollaboratorStatus tracks an invitation until the invitee accepts it
+type CollaboratorStatus string
+
+const (
+	CollaboratorStatusInvited CollaboratorStatus = "invited"
+	CollaboratorStatusActive  CollaboratorStatus = "active"
+)
+
+// WeavePermission is a single action guarded by collaborator roles
+type WeavePermission string
+
+const (
+	PermissionEdit                WeavePermission = "edit"                 // Change content, revert, sync
+	PermissionPublish             WeavePermission = "publish"              // Move between statuses
+	PermissionReview              WeavePermission = "review"               // Review contributions
+	PermissionMerge               WeavePermission = "merge"                // Merge contributions
+	PermissionManageCollaborators WeavePermission = "manage_collaborators" // Invite and remove collaborators
+	PermissionDelete              WeavePermission = "delete"
+)
+
+var rolePermissions = map[CollaboratorRole][]WeavePermission{
+	CollaboratorRoleOwner: {
+		PermissionEdit, PermissionPublish, PermissionReview, PermissionMerge,
+		PermissionManageCollaborators, PermissionDelete,
+	},
+	CollaboratorRoleMaintainer: {
+		PermissionEdit, PermissionPublish, PermissionReview, PermissionMerge,
+		PermissionManageCollaborators,
+	},
+	CollaboratorRoleEditor:   {PermissionEdit},
+	CollaboratorRoleReviewer: {PermissionReview},
+}
+
+// IsValid reports whether the role is one of the known roles
+func (r CollaboratorRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can reports whether the role grants the permission
+func (r CollaboratorRole) Can(permission WeavePermission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAssign reports whether someone holding the role may grant or revoke other.
+// Owners manage everyone; maintainers manage editors and reviewers.
+func (r CollaboratorRole) CanAssign(other CollaboratorRole) bool {
+	switch r {
+	case CollaboratorRoleOwner:
+		return true
+	case CollaboratorRoleMaintainer:
+		return other == CollaboratorRoleEditor || other == CollaboratorRoleReviewer
+	default:
+		return false
+	}
+}
+
+// WeaveCollaborator grants a user a role on a weave besides its creator
+type WeaveCollaborator struct {
+	ID         uuid.UUID
+	WeaveID    uuid.UUID
+	UserID     uuid.UUID
+	Username   string // Filled in when listing collaborators
+	Role       CollaboratorRole
+	Status     CollaboratorStatus
+	InvitedBy  uuid.UUID
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewWeaveCollaborator(weaveID, userID, invitedBy uuid.UUID, role CollaboratorRole) *WeaveCollaborator {
+	now := time.Now()
+	return &WeaveCollaborator{
+		ID:        uuid.New(),
+		WeaveID:   weaveID,
+		UserID:    userID,
+		Role:      role,
+		Status:    CollaboratorStatusInvited,
+		InvitedBy: invitedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (c *WeaveCollaborator) IsActive() bool {
+	return c.Status == CollaboratorStatusActive
+}
+
+// Accept activates the invitation
+func (c *WeaveCollaborator) Accept() {
+	now := time.Now()
+	c.Status = CollaboratorStatusActive
+	c.AcceptedAt = &now
+	c.UpdatedAt = now
+}
@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func addCollaborator(weave *Weave, role CollaboratorRole, accepted bool) uuid.UUID {
+	collaborator := NewWeaveCollaborator(weave.ID, uuid.New(), weave.UserID, role)
+	if accepted {
+		collaborator.Accept()
+	}
+	weave.Collaborators = append(weave.Collaborators, collaborator)
+	return collaborator.UserID
+}
+
+func TestWeave_RoleOf(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+	editorID := addCollaborator(weave, CollaboratorRoleEditor, true)
+	invitedID := addCollaborator(weave, CollaboratorRoleMaintainer, false)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		want   CollaboratorRole
+	}{
+		{"creator is owner", ownerID, CollaboratorRoleOwner},
+		{"accepted collaborator", editorID, CollaboratorRoleEditor},
+		{"pending invitation grants nothing", invitedID, ""},
+		{"stranger", uuid.New(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := weave.RoleOf(tt.userID); got != tt.want {
+				t.Errorf("Expected role %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWeave_HasPermission(t *testing.T) {
+	weave := newPublishableWeave(uuid.New())
+	maintainerID := addCollaborator(weave, CollaboratorRoleMaintainer, true)
+	editorID := addCollaborator(weave, CollaboratorRoleEditor, true)
+	reviewerID := addCollaborator(weave, CollaboratorRoleReviewer, true)
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		permission WeavePermission
+		want       bool
+	}{
+		{"maintainer merges", maintainerID, PermissionMerge, true},
+		{"maintainer cannot delete", maintainerID, PermissionDelete, false},
+		{"editor edits", editorID, PermissionEdit, true},
+		{"editor cannot publish", editorID, PermissionPublish, false},
+		{"editor cannot merge", editorID, PermissionMerge, false},
+		{"reviewer reviews", reviewerID, PermissionReview, true},
+		{"reviewer cannot edit", reviewerID, PermissionEdit, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := weave.HasPermission(tt.userID, tt.permission); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCollaboratorRole_CanAssign(t *testing.T) {
+	if !CollaboratorRoleOwner.CanAssign(CollaboratorRoleMaintainer) {
+		t.Error("Expected owners to assign maintainers")
+	}
+	if !CollaboratorRoleMaintainer.CanAssign(CollaboratorRoleEditor) {
+		t.Error("Expected maintainers to assign editors")
+	}
+	if CollaboratorRoleMaintainer.CanAssign(CollaboratorRoleMaintainer) {
+		t.Error("Expected maintainers not to assign other maintainers")
+	}
+	if CollaboratorRoleEditor.CanAssign(CollaboratorRoleReviewer) {
+		t.Error("Expected editors not to assign roles")
+	}
+}
+
+func TestWeave_TransitionTo_CollaboratorRoles(t *testing.T) {
+	weave := newPublishableWeave(uuid.New())
+	maintainerID := addCollaborator(weave, CollaboratorRoleMaintainer, true)
+	editorID := addCollaborator(weave, CollaboratorRoleEditor, true)
+
+	if err := weave.TransitionTo(WeaveStatusPublished, editorID); err == nil {
+		t.Fatal("Expected an editor to be unable to publish")
+	}
+	if err := weave.TransitionTo(WeaveStatusPublished, maintainerID); err != nil {
+		t.Fatalf("Expected a maintainer to publish, got %v", err)
+	}
+	if err := weave.TransitionTo(WeaveStatusDeleted, maintainerID); err == nil {
+		t.Fatal("Expected a maintainer to be unable to delete")
+	}
+	if weave.Status != WeaveStatusPublished {
+		t.Errorf("Expected status to stay published, got %s", weave.Status)
+	}
+}
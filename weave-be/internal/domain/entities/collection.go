@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"weave-module/errors"
+)
+
+// MaxCollectionWeaves caps how many weaves one collection can hold
+const MaxCollectionWeaves = 500
+
+// Collection is a user's named, ordered list of weaves
+type Collection struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Name        string
+	Description *string
+	IsPublic    bool
+	WeaveCount  int // Filled in when loading collections
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewCollection(userID uuid.UUID, name string, description *string, isPublic bool) *Collection {
+	now := time.Now()
+	return &Collection{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+		IsPublic:    isPublic,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsVisibleTo reports whether the viewer may see the collection.
+// Private collections are only visible to their owner.
+func (c *Collection) IsVisibleTo(viewerID *uuid.UUID) bool {
+	return c.IsPublic || (viewerID != nil && *viewerID == c.UserID)
+}
+
+func (c *Collection) CanBeEditedBy(userID uuid.UUID) bool {
+	return c.UserID == userID
+}
+
+// Update applies the fields that were given, leaving the others unchanged
+func (c *Collection) Update(name, description *string, isPublic *bool) {
+	if name != nil {
+		c.Name = *name
+	}
+	if description != nil {
+		c.Description = description
+	}
+	if isPublic != nil {
+		c.IsPublic = *isPublic
+	}
+	c.UpdatedAt = time.Now()
+}
+
+// CanAddWeave checks that another weave fits in the collection
+func (c *Collection) CanAddWeave() error {
+	if c.WeaveCount >= MaxCollectionWeaves {
+		return errors.Conflict("Collection is full")
+	}
+	return nil
+}
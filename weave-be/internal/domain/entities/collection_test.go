@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"weave-module/errors"
+)
+
+func TestCollection_IsVisibleTo(t *testing.T) {
+	ownerID := uuid.New()
+	strangerID := uuid.New()
+	collection := NewCollection(ownerID, "Weeknight dinners", nil, false)
+
+	if !collection.IsVisibleTo(&ownerID) {
+		t.Error("Expected the owner to see their private collection")
+	}
+	if collection.IsVisibleTo(&strangerID) || collection.IsVisibleTo(nil) {
+		t.Error("Expected a private collection to be hidden from others")
+	}
+
+	collection.IsPublic = true
+	if !collection.IsVisibleTo(nil) {
+		t.Error("Expected a public collection to be visible anonymously")
+	}
+}
+
+func TestCollection_Update(t *testing.T) {
+	description := "Quick meals"
+	collection := NewCollection(uuid.New(), "Dinners", &description, false)
+
+	name := "Weeknight dinners"
+	isPublic := true
+	collection.Update(&name, nil, &isPublic)
+
+	if collection.Name != name || !collection.IsPublic {
+		t.Errorf("Expected name and visibility to change, got %q public=%v", collection.Name, collection.IsPublic)
+	}
+	if collection.Description == nil || *collection.Description != description {
+		t.Errorf("Expected the description to be kept, got %v", collection.Description)
+	}
+}
+
+func TestCollection_CanAddWeave(t *testing.T) {
+	collection := NewCollection(uuid.New(), "Everything", nil, true)
+	collection.WeaveCount = MaxCollectionWeaves - 1
+	if err := collection.CanAddWeave(); err != nil {
+		t.Fatalf("Expected room for one more weave, got %v", err)
+	}
+
+	collection.WeaveCount = MaxCollectionWeaves
+	if err := collection.CanAddWeave(); err == nil || !errors.IsConflict(err) {
+		t.Errorf("Expected a full collection to conflict, got %v", err)
+	}
+}
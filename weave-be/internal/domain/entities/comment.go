@@ -0,0 +1,132 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"weave-module/diff"
+)
+
+// CommentType describes what a lab comment is for
+type CommentType string
+
+const (
+	CommentTypeGeneral    CommentType = "general"
+	CommentTypeSuggestion CommentType = "suggestion"
+	CommentTypeQuestion   CommentType = "question"
+	CommentTypeIssue      CommentType = "issue"
+	CommentTypeApproval   CommentType = "approval"
+)
+
+// MaxCommentDepth is how many levels of replies a thread can nest below its first comment
+const MaxCommentDepth = 5
+
+// IsValid reports whether t is a known comment type
+func (t CommentType) IsValid() bool {
+	switch t {
+	case CommentTypeGeneral, CommentTypeSuggestion, CommentTypeQuestion, CommentTypeIssue, CommentTypeApproval:
+		return true
+	}
+	return false
+}
+
+// LabComment is a comment left on a weave in the lab, optionally anchored to a
+// position in its content. Anchors follow the content from version to version;
+// a comment whose anchored content was removed becomes outdated. A comment
+// without a parent starts a thread; it holds the anchor and the resolution
+// state for all of its replies.
+type LabComment struct {
+	ID              uuid.UUID
+	WeaveID         uuid.UUID
+	UserID          uuid.UUID
+	ParentCommentID *uuid.UUID
+	Type            CommentType
+	Content         string
+	Anchor          *diff.Anchor // Position in the weave content, nil for comments on the whole weave
+	IsOutdated      bool
+	IsResolved      bool
+	ResolvedBy      *uuid.UUID
+	ResolvedAt      *time.Time
+	LikeCount       int
+	ReplyCount      int // Direct replies, only filled in for thread listings
+	EditedAt        *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// CommentRevision is the content a comment had before it was edited
+type CommentRevision struct {
+	ID        uuid.UUID
+	CommentID uuid.UUID
+	Content   string
+	CreatedAt time.Time // When the content was replaced
+}
+
+func NewLabComment(weaveID, userID uuid.UUID, commentType CommentType, content string, anchor *diff.Anchor) *LabComment {
+	now := time.Now()
+	return &LabComment{
+		ID:        uuid.New(),
+		WeaveID:   weaveID,
+		UserID:    userID,
+		Type:      commentType,
+		Content:   content,
+		Anchor:    anchor,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func NewReply(parent *LabComment, userID uuid.UUID, commentType CommentType, content string) *LabComment {
+	reply := NewLabComment(parent.WeaveID, userID, commentType, content, nil)
+	reply.ParentCommentID = &parent.ID
+	return reply
+}
+
+func (c *LabComment) IsReply() bool {
+	return c.ParentCommentID != nil
+}
+
+// CanBeResolvedBy reports whether the user may resolve or reopen the thread:
+// its author or the owner of the weave
+func (c *LabComment) CanBeResolvedBy(userID uuid.UUID, weave *Weave) bool {
+	return c.UserID == userID || weave.UserID == userID
+}
+
+func (c *LabComment) Resolve(userID uuid.UUID) {
+	now := time.Now()
+	c.IsResolved = true
+	c.ResolvedBy = &userID
+	c.ResolvedAt = &now
+	c.UpdatedAt = now
+}
+
+func (c *LabComment) Reopen() {
+	c.IsResolved = false
+	c.ResolvedBy = nil
+	c.ResolvedAt = nil
+	c.UpdatedAt = time.Now()
+}
+
+// Edit replaces the content and returns the revision keeping the previous one
+func (c *LabComment) Edit(content string) *CommentRevision {
+	now := time.Now()
+	revision := &CommentRevision{
+		ID:        uuid.New(),
+		CommentID: c.ID,
+		Content:   c.Content,
+		CreatedAt: now,
+	}
+	c.Content = content
+	c.EditedAt = &now
+	c.UpdatedAt = now
+	return revision
+}
+
+// IssueSummary counts the open threads of a weave for its owner
+type IssueSummary struct {
+	OpenByType        map[CommentType]int
+	OpenIssues        int
+	OutdatedIssues    int // Open issues whose anchored content was removed
+	OldestOpenIssueAt *time.Time
+	Issues            []*LabComment // The longest open issues, oldest first
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestLabComment_CanBeResolvedBy(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+	editorID := addCollaborator(weave, CollaboratorRoleEditor, true)
+	authorID := uuid.New()
+	thread := NewLabComment(weave.ID, authorID, CommentTypeIssue, "The oven temperature is missing", nil)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		want   bool
+	}{
+		{"thread author", authorID, true},
+		{"weave owner", ownerID, true},
+		{"collaborator", editorID, false},
+		{"stranger", uuid.New(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := thread.CanBeResolvedBy(tt.userID, weave); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLabComment_ResolveAndReopen(t *testing.T) {
+	thread := NewLabComment(uuid.New(), uuid.New(), CommentTypeQuestion, "How long should it rest?", nil)
+	resolverID := uuid.New()
+
+	thread.Resolve(resolverID)
+	if !thread.IsResolved || thread.ResolvedBy == nil || *thread.ResolvedBy != resolverID || thread.ResolvedAt == nil {
+		t.Fatalf("Expected the thread to be resolved by %s, got %+v", resolverID, thread)
+	}
+
+	thread.Reopen()
+	if thread.IsResolved || thread.ResolvedBy != nil || thread.ResolvedAt != nil {
+		t.Errorf("Expected reopening to clear the resolution, got %+v", thread)
+	}
+}
+
+func TestLabComment_Edit(t *testing.T) {
+	comment := NewLabComment(uuid.New(), uuid.New(), CommentTypeGeneral, "Lovely recipe", nil)
+	reply := NewReply(comment, uuid.New(), CommentTypeGeneral, "Agreed")
+	if !reply.IsReply() || *reply.ParentCommentID != comment.ID || reply.WeaveID != comment.WeaveID {
+		t.Fatalf("Expected the reply to join the comment's thread, got %+v", reply)
+	}
+
+	revision := comment.Edit("Lovely recipe, the sauce is great")
+	if revision.CommentID != comment.ID || revision.Content != "Lovely recipe" {
+		t.Errorf("Expected the revision to keep the previous content, got %+v", revision)
+	}
+	if comment.Content != "Lovely recipe, the sauce is great" || comment.EditedAt == nil {
+		t.Errorf("Expected the comment to hold the new content and be marked edited, got %+v", comment)
+	}
+}
@@ -0,0 +1,273 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"weave-module/diff"
+)
+
+// ContributionType describes what kind of change a contribution proposes
+type ContributionType string
+
+const (
+	ContributionTypeSuggestion   ContributionType = "suggestion"
+	ContributionTypeContentEdit  ContributionType = "content_edit"
+	ContributionTypeStructural   ContributionType = "structural"
+	ContributionTypeFork         ContributionType = "fork"
+	ContributionTypeMergeRequest ContributionType = "merge_request"
+)
+
+// ContributionStatus describes where a contribution is in its review
+type ContributionStatus string
+
+const (
+	ContributionStatusPending   ContributionStatus = "pending"
+	ContributionStatusReviewing ContributionStatus = "reviewing"
+	ContributionStatusAccepted  ContributionStatus = "accepted"
+	ContributionStatusRejected  ContributionStatus = "rejected"
+	ContributionStatusMerged    ContributionStatus = "merged"
+)
+
+// VoteType is a user's vote on a contribution
+type VoteType string
+
+const (
+	VoteUp   VoteType = "up"
+	VoteDown VoteType = "down"
+)
+
+func (v VoteType) IsValid() bool {
+	return v == VoteUp || v == VoteDown
+}
+
+// Value is what the vote adds to the contribution's score
+func (v VoteType) Value() int {
+	switch v {
+	case VoteUp:
+		return 1
+	case VoteDown:
+		return -1
+	}
+	return 0
+}
+
+// ReviewDecision is the outcome of a review
+type ReviewDecision string
+
+const (
+	ReviewDecisionApprove        ReviewDecision = "approve"
+	ReviewDecisionRequestChanges ReviewDecision = "request_changes"
+	ReviewDecisionReject         ReviewDecision = "reject"
+)
+
+func (d ReviewDecision) IsValid() bool {
+	switch d {
+	case ReviewDecisionApprove, ReviewDecisionRequestChanges, ReviewDecisionReject:
+		return true
+	}
+	return false
+}
+
+// ContributionReview is one review left on a contribution
+type ContributionReview struct {
+	ID             uuid.UUID
+	ContributionID uuid.UUID
+	ReviewerID     uuid.UUID
+	Decision       ReviewDecision
+	Comment        *string
+	CreatedAt      time.Time
+}
+
+func NewContributionReview(contributionID, reviewerID uuid.UUID, decision ReviewDecision, comment *string) *ContributionReview {
+	return &ContributionReview{
+		ID:             uuid.New(),
+		ContributionID: contributionID,
+		ReviewerID:     reviewerID,
+		Decision:       decision,
+		Comment:        comment,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// LatestReviews keeps the most recent review of each reviewer, in the order given
+func LatestReviews(reviews []*ContributionReview) []*ContributionReview {
+	latest := make(map[uuid.UUID]int, len(reviews))
+	var result []*ContributionReview
+	for _, review := range reviews {
+		if i, ok := latest[review.ReviewerID]; ok {
+			if review.CreatedAt.After(result[i].CreatedAt) {
+				result[i] = review
+			}
+			continue
+		}
+		latest[review.ReviewerID] = len(result)
+		result = append(result, review)
+	}
+	return result
+}
+
+// Contribution domain entity - a proposed change to someone else's weave
+type Contribution struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	WeaveID         uuid.UUID
+	Type            ContributionType
+	Title           string
+	Description     *string
+	OriginalContent *WeaveContent // Weave content the proposal was based on
+	ProposedContent *WeaveContent
+	ContentDiff     *diff.Diff
+	ProposedAt      time.Time  // When the proposed content last changed; reviews left before it are outdated
+	SourceWeaveID   *uuid.UUID // Fork whose content a merge request proposes
+	Status          ContributionStatus
+	ReviewerID      *uuid.UUID
+	ReviewedAt      *time.Time
+	ReviewComment   *string
+	MergedBy        *uuid.UUID // Owner or maintainer who merged it
+	MergedAt        *time.Time
+	VoteScore       int
+	Priority        int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewMergeRequest proposes a fork's current content to its parent weave.
+// base is the parent content the fork last caught up with.
+func NewMergeRequest(fork *Weave, title string, description *string, base WeaveContent, contentDiff *diff.Diff) *Contribution {
+	now := time.Now()
+	proposed := fork.Content
+	sourceID := fork.ID
+
+	return &Contribution{
+		ID:              uuid.New(),
+		UserID:          fork.UserID,
+		WeaveID:         *fork.ParentWeaveID,
+		Type:            ContributionTypeMergeRequest,
+		Title:           title,
+		Description:     description,
+		OriginalContent: &base,
+		ProposedContent: &proposed,
+		ContentDiff:     contentDiff,
+		ProposedAt:      now,
+		SourceWeaveID:   &sourceID,
+		Status:          ContributionStatusPending,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// NewSuggestion proposes the weave's current content with a single value
+// replaced. path is the JSON Pointer of the replaced value.
+func NewSuggestion(weave *Weave, userID uuid.UUID, path string, title string, description *string, proposed WeaveContent, contentDiff *diff.Diff) *Contribution {
+	now := time.Now()
+	original := weave.Content
+	if title == "" {
+		title = "Suggested change to " + path
+	}
+
+	return &Contribution{
+		ID:              uuid.New(),
+		UserID:          userID,
+		WeaveID:         weave.ID,
+		Type:            ContributionTypeSuggestion,
+		Title:           title,
+		Description:     description,
+		OriginalContent: &original,
+		ProposedContent: &proposed,
+		ContentDiff:     contentDiff,
+		ProposedAt:      now,
+		Status:          ContributionStatusPending,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// Contribution business methods
+func (c *Contribution) IsOpen() bool {
+	return c.Status == ContributionStatusPending ||
+		c.Status == ContributionStatusReviewing ||
+		c.Status == ContributionStatusAccepted
+}
+
+func (c *Contribution) IsMerged() bool {
+	return c.Status == ContributionStatusMerged
+}
+
+func (c *Contribution) IsMergeRequest() bool {
+	return c.Type == ContributionTypeMergeRequest && c.SourceWeaveID != nil
+}
+
+func (c *Contribution) IsSuggestion() bool {
+	return c.Type == ContributionTypeSuggestion
+}
+
+func (c *Contribution) HasProposal() bool {
+	return c.ProposedContent != nil
+}
+
+func (c *Contribution) CanBeResolvedBy(userID uuid.UUID) bool {
+	return c.UserID == userID
+}
+
+// AssignReviewer puts a reviewer in charge of the contribution and starts its review
+func (c *Contribution) AssignReviewer(reviewerID uuid.UUID) {
+	c.ReviewerID = &reviewerID
+	if c.Status == ContributionStatusPending {
+		c.Status = ContributionStatusReviewing
+	}
+	c.UpdatedAt = time.Now()
+}
+
+// ApplyReview records the review on the contribution and moves it to the status
+// the decision calls for. An approval only accepts the contribution once no
+// other reviewer still asks for changes.
+func (c *Contribution) ApplyReview(review *ContributionReview, changesRequested bool) {
+	c.ReviewedAt = &review.CreatedAt
+	c.ReviewComment = review.Comment
+	if c.ReviewerID == nil {
+		c.ReviewerID = &review.ReviewerID
+	}
+
+	switch review.Decision {
+	case ReviewDecisionReject:
+		c.Status = ContributionStatusRejected
+	case ReviewDecisionRequestChanges:
+		c.Status = ContributionStatusReviewing
+	case ReviewDecisionApprove:
+		if changesRequested {
+			c.Status = ContributionStatusReviewing
+		} else {
+			c.Status = ContributionStatusAccepted
+		}
+	}
+	c.UpdatedAt = time.Now()
+}
+
+// Rebase moves the contribution onto new base content with a resolved proposal
+// and sends it back for review. Reviews of the earlier proposal no longer count.
+func (c *Contribution) Rebase(base, resolved WeaveContent, contentDiff *diff.Diff) {
+	now := time.Now()
+	c.OriginalContent = &base
+	c.ProposedContent = &resolved
+	c.ContentDiff = contentDiff
+	c.ProposedAt = now
+	c.Status = ContributionStatusReviewing
+	c.UpdatedAt = now
+}
+
+// IsCurrentReview reports whether the review was left on the proposal as it is now
+func (c *Contribution) IsCurrentReview(review *ContributionReview) bool {
+	return !review.CreatedAt.Before(c.ProposedAt)
+}
+
+// CurrentReviews drops the reviews left before the proposal last changed
+func (c *Contribution) CurrentReviews(reviews []*ContributionReview) []*ContributionReview {
+	var current []*ContributionReview
+	for _, review := range reviews {
+		if c.IsCurrentReview(review) {
+			current = append(current, review)
+		}
+	}
+	return current
+}
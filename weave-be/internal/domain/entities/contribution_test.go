@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewSuggestion(t *testing.T) {
+	weave := newPublishableWeave(uuid.New())
+	suggesterID := uuid.New()
+	proposed := WeaveContent{
+		Type: "recipe",
+		Data: map[string]interface{}{"servings": 4},
+	}
+
+	suggestion := NewSuggestion(weave, suggesterID, "/data/servings", "", nil, proposed, nil)
+
+	if !suggestion.IsSuggestion() || suggestion.IsMergeRequest() {
+		t.Errorf("Expected a suggestion, got type %s", suggestion.Type)
+	}
+	if suggestion.UserID != suggesterID || suggestion.WeaveID != weave.ID {
+		t.Error("Expected the suggestion to be made by the suggester on the weave")
+	}
+	if suggestion.Title != "Suggested change to /data/servings" {
+		t.Errorf("Expected a title naming the path, got %q", suggestion.Title)
+	}
+	if suggestion.Status != ContributionStatusPending {
+		t.Errorf("Expected a pending suggestion, got %s", suggestion.Status)
+	}
+
+	// The original content must not follow later edits of the weave
+	weave.UpdateContent(WeaveContent{Type: "recipe", Data: map[string]interface{}{"servings": 6}})
+	if suggestion.OriginalContent.Data["servings"] != 2 {
+		t.Errorf("Expected the original content to be the weave content when suggested, got %v", suggestion.OriginalContent.Data["servings"])
+	}
+
+	titled := NewSuggestion(weave, suggesterID, "/data/servings", "Serves four", nil, proposed, nil)
+	if titled.Title != "Serves four" {
+		t.Errorf("Expected the given title to be kept, got %q", titled.Title)
+	}
+}
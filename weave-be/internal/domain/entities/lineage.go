@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LineageNode is a weave's position in a fork family tree
+type LineageNode struct {
+	WeaveID       uuid.UUID
+	ParentWeaveID *uuid.UUID
+	UserID        uuid.UUID
+	Username      string
+	Title         string
+	Version       int
+	LikeCount     int
+	ForkCount     int
+	Status        WeaveStatus
+	Depth         int // Distance from the weave the lineage was requested for
+	CreatedAt     time.Time
+}
+
+// IsVisibleTo reports whether the node may be shown to the viewer
+func (n *LineageNode) IsVisibleTo(viewerID *uuid.UUID) bool {
+	return n.Status.IsPublic() || (viewerID != nil && n.UserID == *viewerID)
+}
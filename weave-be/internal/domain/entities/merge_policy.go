@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MergePolicy sets what a contribution needs before it can be merged into a
+// weave, such as "owner approval and a net score of at least 3". Only reviews
+// from users who can still review the weave count towards it.
+type MergePolicy struct {
+	WeaveID                 uuid.UUID
+	RequireOwnerApproval    bool
+	RequiredApprovals       int  // Distinct reviewers whose latest review approves
+	MinVoteScore            *int // Net vote score needed, nil for none
+	BlockOnChangesRequested bool // Any reviewer's latest review asking for changes blocks the merge
+	UpdatedBy               *uuid.UUID
+	UpdatedAt               *time.Time
+}
+
+// DefaultMergePolicy is the policy of a weave that never set one: owners and
+// maintainers may merge whenever they choose
+func DefaultMergePolicy(weaveID uuid.UUID) *MergePolicy {
+	return &MergePolicy{WeaveID: weaveID}
+}
+
+// IsDefault reports whether the policy was never customized
+func (p *MergePolicy) IsDefault() bool {
+	return p.UpdatedBy == nil
+}
+
+// Unmet lists the requirements the contribution does not meet yet, given all
+// the reviews left on it. Reviews of an earlier proposal do not count. An empty
+// result means it may be merged.
+func (p *MergePolicy) Unmet(weave *Weave, contribution *Contribution, reviews []*ContributionReview) []string {
+	var unmet []string
+
+	ownerApproved, approvals, changesRequested := false, 0, false
+	for _, review := range LatestReviews(contribution.CurrentReviews(reviews)) {
+		if !weave.HasPermission(review.ReviewerID, PermissionReview) {
+			continue
+		}
+		switch review.Decision {
+		case ReviewDecisionApprove:
+			approvals++
+			if review.ReviewerID == weave.UserID {
+				ownerApproved = true
+			}
+		case ReviewDecisionRequestChanges:
+			changesRequested = true
+		}
+	}
+
+	if p.RequireOwnerApproval && !ownerApproved {
+		unmet = append(unmet, "The weave owner has not approved")
+	}
+	if approvals < p.RequiredApprovals {
+		unmet = append(unmet, fmt.Sprintf("%d of %d required approvals", approvals, p.RequiredApprovals))
+	}
+	if p.MinVoteScore != nil && contribution.VoteScore < *p.MinVoteScore {
+		unmet = append(unmet, fmt.Sprintf("Vote score is %d, at least %d is required", contribution.VoteScore, *p.MinVoteScore))
+	}
+	if p.BlockOnChangesRequested && changesRequested {
+		unmet = append(unmet, "A reviewer has requested changes")
+	}
+	return unmet
+}
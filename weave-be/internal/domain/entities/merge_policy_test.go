@@ -0,0 +1,163 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func reviewAt(contribution *Contribution, reviewerID uuid.UUID, decision ReviewDecision, minutes int) *ContributionReview {
+	review := NewContributionReview(contribution.ID, reviewerID, decision, nil)
+	review.CreatedAt = time.Date(2026, 1, 1, 12, minutes, 0, 0, time.UTC)
+	return review
+}
+
+func TestMergePolicy_Unmet(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+	reviewerID := addCollaborator(weave, CollaboratorRoleReviewer, true)
+	editorID := addCollaborator(weave, CollaboratorRoleEditor, true)
+	contribution := &Contribution{ID: uuid.New(), UserID: uuid.New(), WeaveID: weave.ID, VoteScore: 3}
+
+	minScore := 3
+	policy := &MergePolicy{
+		WeaveID:                 weave.ID,
+		RequireOwnerApproval:    true,
+		RequiredApprovals:       2,
+		MinVoteScore:            &minScore,
+		BlockOnChangesRequested: true,
+	}
+
+	tests := []struct {
+		name    string
+		reviews []*ContributionReview
+		want    []string
+	}{
+		{
+			name: "owner and reviewer approve",
+			reviews: []*ContributionReview{
+				reviewAt(contribution, ownerID, ReviewDecisionApprove, 0),
+				reviewAt(contribution, reviewerID, ReviewDecisionApprove, 1),
+			},
+		},
+		{
+			name: "reviews without permission do not count",
+			reviews: []*ContributionReview{
+				reviewAt(contribution, ownerID, ReviewDecisionApprove, 0),
+				reviewAt(contribution, editorID, ReviewDecisionApprove, 1),
+			},
+			want: []string{"1 of 2 required approvals"},
+		},
+		{
+			name: "only the latest review of a reviewer counts",
+			reviews: []*ContributionReview{
+				reviewAt(contribution, ownerID, ReviewDecisionApprove, 0),
+				reviewAt(contribution, reviewerID, ReviewDecisionApprove, 1),
+				reviewAt(contribution, reviewerID, ReviewDecisionRequestChanges, 2),
+			},
+			want: []string{"1 of 2 required approvals", "requested changes"},
+		},
+		{
+			name:    "no reviews",
+			reviews: nil,
+			want:    []string{"owner has not approved", "0 of 2 required approvals"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unmet := policy.Unmet(weave, contribution, tt.reviews)
+			if len(unmet) != len(tt.want) {
+				t.Fatalf("Expected %d unmet requirements, got %v", len(tt.want), unmet)
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(unmet[i], want) {
+					t.Errorf("Expected requirement %d to mention %q, got %q", i, want, unmet[i])
+				}
+			}
+		})
+	}
+
+	contribution.VoteScore = 2
+	approved := []*ContributionReview{
+		reviewAt(contribution, ownerID, ReviewDecisionApprove, 0),
+		reviewAt(contribution, reviewerID, ReviewDecisionApprove, 1),
+	}
+	if unmet := policy.Unmet(weave, contribution, approved); len(unmet) != 1 || !strings.Contains(unmet[0], "Vote score is 2") {
+		t.Errorf("Expected the vote threshold to block the merge, got %v", unmet)
+	}
+
+	if unmet := DefaultMergePolicy(weave.ID).Unmet(weave, contribution, nil); len(unmet) != 0 {
+		t.Errorf("Expected the default policy to require nothing, got %v", unmet)
+	}
+}
+
+func TestMergePolicy_UnmetAfterRebase(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+	reviewerID := addCollaborator(weave, CollaboratorRoleReviewer, true)
+	contribution := &Contribution{ID: uuid.New(), UserID: uuid.New(), WeaveID: weave.ID}
+	policy := &MergePolicy{WeaveID: weave.ID, RequireOwnerApproval: true, RequiredApprovals: 2}
+
+	reviews := []*ContributionReview{
+		reviewAt(contribution, ownerID, ReviewDecisionApprove, 0),
+		reviewAt(contribution, reviewerID, ReviewDecisionApprove, 1),
+	}
+	if unmet := policy.Unmet(weave, contribution, reviews); len(unmet) != 0 {
+		t.Fatalf("Expected the approvals to meet the policy, got %v", unmet)
+	}
+
+	// New proposed content arrives after both approvals
+	contribution.Rebase(weave.Content, weave.Content, nil)
+	contribution.ProposedAt = time.Date(2026, 1, 1, 12, 5, 0, 0, time.UTC)
+	if unmet := policy.Unmet(weave, contribution, reviews); len(unmet) != 2 {
+		t.Errorf("Expected approvals of the earlier proposal to stop counting, got %v", unmet)
+	}
+
+	reviews = append(reviews, reviewAt(contribution, reviewerID, ReviewDecisionApprove, 6))
+	if unmet := policy.Unmet(weave, contribution, reviews); len(unmet) != 2 || !strings.Contains(unmet[1], "1 of 2") {
+		t.Errorf("Expected only the approval of the new proposal to count, got %v", unmet)
+	}
+}
+
+func TestContribution_ApplyReview(t *testing.T) {
+	reviewerID := uuid.New()
+	tests := []struct {
+		name             string
+		decision         ReviewDecision
+		changesRequested bool
+		want             ContributionStatus
+	}{
+		{"approval accepts", ReviewDecisionApprove, false, ContributionStatusAccepted},
+		{"approval waits on other change requests", ReviewDecisionApprove, true, ContributionStatusReviewing},
+		{"change request sends back to review", ReviewDecisionRequestChanges, false, ContributionStatusReviewing},
+		{"rejection closes", ReviewDecisionReject, false, ContributionStatusRejected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contribution := &Contribution{ID: uuid.New(), UserID: uuid.New(), Status: ContributionStatusPending}
+			comment := "Looks good"
+			review := NewContributionReview(contribution.ID, reviewerID, tt.decision, &comment)
+
+			contribution.ApplyReview(review, tt.changesRequested)
+			if contribution.Status != tt.want {
+				t.Errorf("Expected status %s, got %s", tt.want, contribution.Status)
+			}
+			if contribution.ReviewerID == nil || *contribution.ReviewerID != reviewerID || contribution.ReviewComment != &comment {
+				t.Errorf("Expected the review to be recorded on the contribution, got %+v", contribution)
+			}
+		})
+	}
+}
+
+func TestVoteType_Value(t *testing.T) {
+	if VoteUp.Value()-VoteDown.Value() != 2 {
+		t.Errorf("Expected switching a down vote to an up vote to move the score by 2")
+	}
+	if VoteType("sideways").IsValid() || VoteType("sideways").Value() != 0 {
+		t.Errorf("Expected unknown votes to be invalid and worth nothing")
+	}
+}
@@ -0,0 +1,146 @@
+package entities
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+const (
+	// MaxWeaveTags caps how many tags one weave can carry
+	MaxWeaveTags = 10
+	// MaxTagLength matches the size of the tag name column
+	MaxTagLength = 50
+)
+
+// Tag is a normalized label shared by weaves
+type Tag struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	WeaveCount  int // Published weaves carrying the tag, filled in when listing tags
+	CreatedAt   time.Time
+}
+
+// RelatedTag is a tag found on the same weaves as another one
+type RelatedTag struct {
+	Tag          *Tag
+	SharedWeaves int // Published weaves carrying both tags
+}
+
+// tagAliases maps common spellings, plurals and Korean names onto one canonical tag.
+// Aliases left behind by merging tags are stored in the database instead.
+var tagAliases = map[string]string{
+	"레시피":            "recipe",
+	"recipes":        "recipe",
+	"요리":             "cooking",
+	"cook":           "cooking",
+	"한식":             "korean-food",
+	"한국-음식":          "korean-food",
+	"korean-cuisine": "korean-food",
+	"디저트":            "dessert",
+	"desserts":       "dessert",
+	"베이킹":            "baking",
+	"bake":           "baking",
+	"비건":             "vegan",
+	"채식":             "vegetarian",
+	"veggie":         "vegetarian",
+	"다이어트":           "diet",
+	"여행":             "travel",
+	"trip":           "travel",
+	"trips":          "travel",
+	"캠핑":             "camping",
+	"등산":             "hiking",
+	"hike":           "hiking",
+	"운동":             "workout",
+	"workouts":       "workout",
+	"exercise":       "workout",
+	"헬스":             "fitness",
+	"gym":            "fitness",
+	"요가":             "yoga",
+	"러닝":             "running",
+	"달리기":            "running",
+	"run":            "running",
+}
+
+// NormalizeTag turns user input into the canonical tag name: lowercased,
+// slugged and with known aliases resolved. Hangul is kept as is.
+func NormalizeTag(raw string) string {
+	name := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(raw), "#"))
+	name = slugTag(strings.Join(strings.Fields(name), " "))
+	if canonical, ok := tagAliases[name]; ok {
+		return canonical
+	}
+	return name
+}
+
+// slugTag slugs everything but Hangul, which utils.ToSlug would strip
+func slugTag(name string) string {
+	var slug, run strings.Builder
+	flush := func() {
+		slug.WriteString(utils.ToSlug(run.String()))
+		run.Reset()
+	}
+	for _, r := range name {
+		if unicode.Is(unicode.Hangul, r) {
+			flush()
+			slug.WriteRune(r)
+			continue
+		}
+		run.WriteRune(r)
+	}
+	flush()
+
+	result := slug.String()
+	for strings.Contains(result, "--") {
+		result = strings.ReplaceAll(result, "--", "-")
+	}
+	return strings.Trim(result, "-")
+}
+
+// NormalizeTags normalizes the tags given for a weave, dropping duplicates
+// while keeping the order they were given in
+func NormalizeTags(raw []string) ([]string, error) {
+	tags := make([]string, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for _, tag := range raw {
+		name := NormalizeTag(tag)
+		if name == "" {
+			return nil, errors.BadRequest(fmt.Sprintf("'%s' is not a valid tag", tag))
+		}
+		if len([]rune(name)) > MaxTagLength {
+			return nil, errors.BadRequest(fmt.Sprintf("Tags cannot exceed %d characters", MaxTagLength))
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, name)
+	}
+	if len(tags) > MaxWeaveTags {
+		return nil, errors.BadRequest(fmt.Sprintf("A weave can have at most %d tags", MaxWeaveTags))
+	}
+	return tags, nil
+}
+
+// TagsAliasedBy returns the canonical tags whose aliases start with the prefix,
+// so autocompletion finds "recipe" while typing "레시"
+func TagsAliasedBy(prefix string) []string {
+	if prefix == "" {
+		return nil
+	}
+	var tags []string
+	for alias, canonical := range tagAliases {
+		if strings.HasPrefix(alias, prefix) && !strings.HasPrefix(canonical, prefix) && !utils.Contains(tags, canonical) {
+			tags = append(tags, canonical)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
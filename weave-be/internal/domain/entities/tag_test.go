@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"weave-module/errors"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		raw      string
+		expected string
+	}{
+		{"Recipe", "recipe"},
+		{"  #Weeknight   Dinner ", "weeknight-dinner"},
+		{"slow_cooker", "slow-cooker"},
+		{"Vegan!!", "vegan"},
+		{"레시피", "recipe"},
+		{"Recipes", "recipe"},
+		{"한국 음식", "korean-food"},
+		{"김치 Stew", "김치-stew"},
+		{"--", ""},
+	}
+
+	for _, test := range tests {
+		if got := NormalizeTag(test.raw); got != test.expected {
+			t.Errorf("NormalizeTag(%q) = %q, expected %q", test.raw, got, test.expected)
+		}
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{"Travel", "여행", "trip", "Camping"})
+	if err != nil {
+		t.Fatalf("Expected tags to normalize, got %v", err)
+	}
+	if strings.Join(tags, ",") != "travel,camping" {
+		t.Errorf("Expected aliases to collapse into one tag, got %v", tags)
+	}
+
+	if _, err := NormalizeTags([]string{"recipe", "!!!"}); !errors.IsBadRequest(err) {
+		t.Errorf("Expected a tag without letters to be rejected, got %v", err)
+	}
+	if _, err := NormalizeTags([]string{strings.Repeat("a", MaxTagLength+1)}); !errors.IsBadRequest(err) {
+		t.Errorf("Expected an overlong tag to be rejected, got %v", err)
+	}
+
+	tooMany := make([]string, MaxWeaveTags+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag-%d", i)
+	}
+	if _, err := NormalizeTags(tooMany); !errors.IsBadRequest(err) {
+		t.Errorf("Expected more than %d tags to be rejected, got %v", MaxWeaveTags, err)
+	}
+}
+
+func TestTagsAliasedBy(t *testing.T) {
+	if tags := TagsAliasedBy("레시"); strings.Join(tags, ",") != "recipe" {
+		t.Errorf("Expected a Korean prefix to suggest its English tag, got %v", tags)
+	}
+	if tags := TagsAliasedBy("rec"); len(tags) != 0 {
+		t.Errorf("Expected tags matching the prefix themselves to be left out, got %v", tags)
+	}
+	if tags := TagsAliasedBy(""); tags != nil {
+		t.Errorf("Expected no suggestions without a prefix, got %v", tags)
+	}
+}
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"weave-module/errors"
+)
+
+// ChannelTemplate pins a template weave as one of a channel's official templates
+type ChannelTemplate struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	WeaveID   uuid.UUID
+	PinnedBy  uuid.UUID
+	Position  int
+	Weave     *Weave // Loaded when listing a channel's templates
+	CreatedAt time.Time
+}
+
+func NewChannelTemplate(channelID, weaveID, pinnedBy uuid.UUID, position int) *ChannelTemplate {
+	return &ChannelTemplate{
+		ID:        uuid.New(),
+		ChannelID: channelID,
+		WeaveID:   weaveID,
+		PinnedBy:  pinnedBy,
+		Position:  position,
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsUsableTemplate reports whether new weaves may be started from this weave
+func (w *Weave) IsUsableTemplate() bool {
+	return w.IsTemplate && w.IsPublished()
+}
+
+// SetTemplate offers the weave as a template or withdraws it.
+// Only published weaves can become templates; withdrawing is always allowed.
+func (w *Weave) SetTemplate(isTemplate bool, userID uuid.UUID) error {
+	if !w.HasPermission(userID, PermissionPublish) {
+		return errors.Forbidden("You do not have permission to change whether this weave is a template")
+	}
+	if w.IsTemplate == isTemplate {
+		if isTemplate {
+			return errors.BadRequest("Weave is already a template")
+		}
+		return errors.BadRequest("Weave is not a template")
+	}
+	if isTemplate && !w.IsPublished() {
+		return errors.Conflict("Only published weaves can be templates")
+	}
+
+	w.IsTemplate = isTemplate
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// StartFromTemplate records the template the weave's content was copied from.
+// A description or cover image the author did not set is taken from the template.
+func (w *Weave) StartFromTemplate(template *Weave) {
+	templateID := template.ID
+	templateVersion := template.Version
+	w.TemplateID = &templateID
+	w.TemplateVersion = &templateVersion
+
+	if w.Description == nil {
+		w.Description = template.Description
+	}
+	if w.CoverImage == nil {
+		w.CoverImage = template.CoverImage
+	}
+}
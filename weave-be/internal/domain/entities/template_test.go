@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"weave-module/errors"
+)
+
+func TestWeave_SetTemplate(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+	maintainerID := addCollaborator(weave, CollaboratorRoleMaintainer, true)
+	editorID := addCollaborator(weave, CollaboratorRoleEditor, true)
+
+	if err := weave.SetTemplate(true, ownerID); err == nil || !errors.IsConflict(err) {
+		t.Fatalf("Expected drafts to be rejected as templates, got %v", err)
+	}
+
+	_ = weave.TransitionTo(WeaveStatusPublished, ownerID)
+	if err := weave.SetTemplate(true, editorID); err == nil || !errors.IsForbidden(err) {
+		t.Fatalf("Expected editors not to manage templates, got %v", err)
+	}
+	if err := weave.SetTemplate(true, maintainerID); err != nil {
+		t.Fatalf("Expected a maintainer to mark the weave as a template, got %v", err)
+	}
+	if !weave.IsUsableTemplate() {
+		t.Fatal("Expected a published template to be usable")
+	}
+	if err := weave.SetTemplate(true, ownerID); err == nil || !errors.IsBadRequest(err) {
+		t.Fatalf("Expected marking twice to be rejected, got %v", err)
+	}
+
+	_ = weave.TransitionTo(WeaveStatusArchived, ownerID)
+	if weave.IsUsableTemplate() {
+		t.Error("Expected an archived template not to be usable")
+	}
+	if err := weave.SetTemplate(false, ownerID); err != nil {
+		t.Fatalf("Expected an archived template to be withdrawn, got %v", err)
+	}
+}
+
+func TestWeave_StartFromTemplate(t *testing.T) {
+	template := newPublishableWeave(uuid.New())
+	template.Version = 4
+	description := "Rich broth"
+	template.Description = &description
+
+	cover := "mine.png"
+	weave := NewWeave(uuid.New(), template.ChannelID, "My ramen", template.Content)
+	weave.CoverImage = &cover
+	weave.StartFromTemplate(template)
+
+	if weave.TemplateID == nil || *weave.TemplateID != template.ID {
+		t.Fatalf("Expected the template to be recorded, got %v", weave.TemplateID)
+	}
+	if weave.TemplateVersion == nil || *weave.TemplateVersion != 4 {
+		t.Errorf("Expected template version 4, got %v", weave.TemplateVersion)
+	}
+	if weave.Description == nil || *weave.Description != description {
+		t.Errorf("Expected the template description as default, got %v", weave.Description)
+	}
+	if *weave.CoverImage != cover {
+		t.Errorf("Expected the author's cover image to be kept, got %s", *weave.CoverImage)
+	}
+	if weave.Version != 1 {
+		t.Errorf("Expected the new weave to start its own history, got version %d", weave.Version)
+	}
+}
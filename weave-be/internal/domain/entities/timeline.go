@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WeaveTimelineEvent is one entry of a weave's history of notable events
+type WeaveTimelineEvent struct {
+	ID          uuid.UUID
+	WeaveID     uuid.UUID
+	UserID      uuid.UUID
+	EventType   string // created, published, forked, status_changed, ...
+	Title       string
+	Description *string
+	Metadata    map[string]interface{}
+	CreatedAt   time.Time
+}
@@ -5,32 +5,47 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"weave-module/diff"
 )
 
 // Weave domain entity - represents the core content unit
 type Weave struct {
-	ID             uuid.UUID
-	UserID         uuid.UUID
-	ChannelID      uuid.UUID
-	Title          string
-	CoverImage     *string
-	Content        WeaveContent
-	Version        int
-	ParentWeaveID  *uuid.UUID
-	IsPublished    bool
-	IsFeatured     bool
-	ViewCount      int
-	LikeCount      int
-	ForkCount      int
-	CommentCount   int
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	ChannelID       uuid.UUID
+	Title           string
+	Description     *string
+	CoverImage      *string
+	Content         WeaveContent
+	Version         int
+	ParentWeaveID   *uuid.UUID
+	OriginalWeaveID *uuid.UUID
+	UpstreamVersion *int // Parent version last incorporated into this fork
+	SyncedVersion   *int // This fork's version when it last caught up with its parent
+	Status          WeaveStatus
+	PublishedAt     *time.Time   // First time the weave was published
+	PublishAt       *time.Time   // Scheduled publication, only while draft or in review
+	TrashedAt       *time.Time   // When the weave was deleted into the trash
+	PreviousStatus  *WeaveStatus // Status the weave returns to when restored from the trash
+	IsFeatured      bool
+	IsTemplate      bool       // Offered to others as a starting point
+	TemplateID      *uuid.UUID // Template this weave was started from
+	TemplateVersion *int       // Version of that template the content was copied from
+	ViewCount       int
+	LikeCount       int
+	ForkCount       int
+	CommentCount    int
+	Collaborators   []*WeaveCollaborator // Active collaborators, loaded with single weaves only
+	Tags            []string             // Tag names, loaded with single weaves only
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 // WeaveContent represents the structured content of a weave
 type WeaveContent struct {
-	Type string                 `json:"type"` // recipe, travel-plan, workout, etc.
-	Data map[string]interface{} `json:"data"`
+	Type          string                 `json:"type"`                     // recipe, travel-plan, workout, etc.
+	SchemaVersion int                    `json:"schema_version,omitempty"` // Schema of Data; unset means v1
+	Data          map[string]interface{} `json:"data"`
 }
 
 // Weave business methods
@@ -38,26 +53,45 @@ func (w *Weave) IsValidForPublication() bool {
 	return w.Title != "" && w.Content.Type != "" && len(w.Content.Data) > 0
 }
 
+// RoleOf returns the role the user holds on the weave, or "" without one.
+// The creator is always an owner.
+func (w *Weave) RoleOf(userID uuid.UUID) CollaboratorRole {
+	if w.UserID == userID {
+		return CollaboratorRoleOwner
+	}
+	for _, collaborator := range w.Collaborators {
+		if collaborator.UserID == userID && collaborator.IsActive() {
+			return collaborator.Role
+		}
+	}
+	return ""
+}
+
+// HasPermission reports whether the user's role on the weave grants the permission
+func (w *Weave) HasPermission(userID uuid.UUID, permission WeavePermission) bool {
+	return w.RoleOf(userID).Can(permission)
+}
+
 func (w *Weave) CanBeEditedBy(userID uuid.UUID) bool {
-	return w.UserID == userID
+	return w.HasPermission(userID, PermissionEdit)
 }
 
-func (w *Weave) CanBeFeatured() bool {
-	return w.IsPublished && w.LikeCount >= 10 // Example criteria
+func (w *Weave) IsPublished() bool {
+	return w.Status == WeaveStatusPublished
 }
 
-func (w *Weave) IsForked() bool {
-	return w.ParentWeaveID != nil
+// IsVisibleTo reports whether the weave may be shown to the viewer.
+// Everyone holding a role sees unpublished weaves, reviewers included.
+func (w *Weave) IsVisibleTo(viewerID *uuid.UUID) bool {
+	return w.Status.IsPublic() || (viewerID != nil && w.RoleOf(*viewerID) != "")
 }
 
-func (w *Weave) Publish() {
-	w.IsPublished = true
-	w.UpdatedAt = time.Now()
+func (w *Weave) CanBeFeatured() bool {
+	return w.IsPublished() && w.LikeCount >= 10 // Example criteria
 }
 
-func (w *Weave) Unpublish() {
-	w.IsPublished = false
-	w.UpdatedAt = time.Now()
+func (w *Weave) IsForked() bool {
+	return w.ParentWeaveID != nil
 }
 
 func (w *Weave) IncrementView() {
@@ -78,8 +112,42 @@ func (w *Weave) IncrementFork() {
 	w.ForkCount++
 }
 
+// UpstreamBase locates the version snapshot holding the parent content this fork
+// last caught up with. Forks created before sync tracking fall back to their own
+// first version, which is a copy of the parent at fork time.
+func (w *Weave) UpstreamBase() (uuid.UUID, int) {
+	if w.UpstreamVersion == nil || w.ParentWeaveID == nil {
+		return w.ID, 1
+	}
+	return *w.ParentWeaveID, *w.UpstreamVersion
+}
+
+// VersionsAhead counts fork versions created since the fork last caught up with its parent
+func (w *Weave) VersionsAhead() int {
+	if w.SyncedVersion == nil {
+		return w.Version - 1
+	}
+	return w.Version - *w.SyncedVersion
+}
+
+// VersionsBehind counts parent versions the fork has not incorporated yet.
+// Forks created before sync tracking count every parent version.
+func (w *Weave) VersionsBehind(parent *Weave) int {
+	if w.UpstreamVersion == nil {
+		return parent.Version
+	}
+	return parent.Version - *w.UpstreamVersion
+}
+
 func (w *Weave) UpdateContent(content WeaveContent) {
 	w.Content = content
+	w.UpdatedAt = time.Now()
+}
+
+// NextVersion moves the weave to the version its next save is stored as. Every
+// save gets a new version, not only content changes, so concurrent edits of any
+// field are detected.
+func (w *Weave) NextVersion() {
 	w.Version++
 	w.UpdatedAt = time.Now()
 }
@@ -94,37 +162,40 @@ func (w *Weave) ToJSON() (string, error) {
 
 func NewWeave(userID, channelID uuid.UUID, title string, content WeaveContent) *Weave {
 	return &Weave{
-		ID:          uuid.New(),
-		UserID:      userID,
-		ChannelID:   channelID,
-		Title:       title,
-		Content:     content,
-		Version:     1,
-		IsPublished: false,
-		IsFeatured:  false,
-		ViewCount:   0,
-		LikeCount:   0,
-		ForkCount:   0,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:         uuid.New(),
+		UserID:     userID,
+		ChannelID:  channelID,
+		Title:      title,
+		Content:    content,
+		Version:    1,
+		Status:     WeaveStatusDraft,
+		IsFeatured: false,
+		ViewCount:  0,
+		LikeCount:  0,
+		ForkCount:  0,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
 	}
 }
 
 // WeaveVersion represents a historical version of a weave
 type WeaveVersion struct {
-	ID        uuid.UUID
-	WeaveID   uuid.UUID
-	Version   int
-	Title     string
-	Content   WeaveContent
-	ChangeLog *string
-	CreatedAt time.Time
-}
-
-func NewWeaveVersion(weaveID uuid.UUID, version int, title string, content WeaveContent, changeLog *string) *WeaveVersion {
+	ID          uuid.UUID
+	WeaveID     uuid.UUID
+	UserID      uuid.UUID
+	Version     int
+	Title       string
+	Content     WeaveContent
+	ChangeLog   *string
+	ContentDiff *diff.Diff // Changes relative to the previous version, nil for the first one
+	CreatedAt   time.Time
+}
+
+func NewWeaveVersion(weaveID, userID uuid.UUID, version int, title string, content WeaveContent, changeLog *string) *WeaveVersion {
 	return &WeaveVersion{
 		ID:        uuid.New(),
 		WeaveID:   weaveID,
+		UserID:    userID,
 		Version:   version,
 		Title:     title,
 		Content:   content,
@@ -134,26 +205,38 @@ func NewWeaveVersion(weaveID uuid.UUID, version int, title string, content Weave
 }
 
 func ForkWeave(originalWeave *Weave, newUserID uuid.UUID) *Weave {
+	// Forks of forks keep pointing at the root of the lineage
+	originalID := originalWeave.ID
+	if originalWeave.OriginalWeaveID != nil {
+		originalID = *originalWeave.OriginalWeaveID
+	}
+	upstreamVersion := originalWeave.Version
+	syncedVersion := 1
+
 	forkedWeave := &Weave{
-		ID:            uuid.New(),
-		UserID:        newUserID,
-		ChannelID:     originalWeave.ChannelID,
-		Title:         originalWeave.Title + " (Forked)",
-		CoverImage:    originalWeave.CoverImage,
-		Content:       originalWeave.Content,
-		Version:       1, // Reset version for forked weave
-		ParentWeaveID: &originalWeave.ID,
-		IsPublished:   false, // Forked weaves start as drafts
-		IsFeatured:    false,
-		ViewCount:     0,
-		LikeCount:     0,
-		ForkCount:     0,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+		ID:              uuid.New(),
+		UserID:          newUserID,
+		ChannelID:       originalWeave.ChannelID,
+		Title:           originalWeave.Title + " (Forked)",
+		Description:     originalWeave.Description,
+		CoverImage:      originalWeave.CoverImage,
+		Content:         originalWeave.Content,
+		Version:         1, // Reset version for forked weave
+		ParentWeaveID:   &originalWeave.ID,
+		OriginalWeaveID: &originalID,
+		UpstreamVersion: &upstreamVersion,
+		SyncedVersion:   &syncedVersion,
+		Status:          WeaveStatusDraft, // Forked weaves start as drafts
+		IsFeatured:      false,
+		ViewCount:       0,
+		LikeCount:       0,
+		ForkCount:       0,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
 	// Increment fork count on original
 	originalWeave.IncrementFork()
 
 	return forkedWeave
-}
\ No newline at end of file
+}
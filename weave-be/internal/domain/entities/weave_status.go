@@ -0,0 +1,169 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-module/errors"
+)
+
+// WeaveStatus is the lifecycle state of a weave
+type WeaveStatus string
+
+const (
+	WeaveStatusDraft     WeaveStatus = "draft"
+	WeaveStatusInReview  WeaveStatus = "in_review"
+	WeaveStatusPublished WeaveStatus = "published"
+	WeaveStatusArchived  WeaveStatus = "archived"
+	WeaveStatusDeleted   WeaveStatus = "deleted"
+)
+
+// weaveTransitions lists the statuses each status may move to.
+// Deleted weaves are final here; restoring them goes through the trash.
+var weaveTransitions = map[WeaveStatus][]WeaveStatus{
+	WeaveStatusDraft:     {WeaveStatusInReview, WeaveStatusPublished, WeaveStatusDeleted},
+	WeaveStatusInReview:  {WeaveStatusDraft, WeaveStatusPublished, WeaveStatusDeleted},
+	WeaveStatusPublished: {WeaveStatusDraft, WeaveStatusArchived, WeaveStatusDeleted},
+	WeaveStatusArchived:  {WeaveStatusPublished, WeaveStatusDraft, WeaveStatusDeleted},
+}
+
+func (s WeaveStatus) IsValid() bool {
+	switch s {
+	case WeaveStatusDraft, WeaveStatusInReview, WeaveStatusPublished, WeaveStatusArchived, WeaveStatusDeleted:
+		return true
+	}
+	return false
+}
+
+// IsPublic reports whether weaves in this status can be read by anyone.
+// Archived weaves stay readable but drop out of listings.
+func (s WeaveStatus) IsPublic() bool {
+	return s == WeaveStatusPublished || s == WeaveStatusArchived
+}
+
+// IsSchedulable reports whether weaves in this status may be scheduled for publication
+func (s WeaveStatus) IsSchedulable() bool {
+	return s == WeaveStatusDraft || s == WeaveStatusInReview
+}
+
+// CanTransitionTo reports whether the state machine allows moving to next
+func (s WeaveStatus) CanTransitionTo(next WeaveStatus) bool {
+	for _, allowed := range weaveTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CanChangeStatus reports whether the user may move the weave to next.
+// Deleting is reserved for owners.
+func (w *Weave) CanChangeStatus(userID uuid.UUID, next WeaveStatus) bool {
+	if next == WeaveStatusDeleted {
+		return w.HasPermission(userID, PermissionDelete)
+	}
+	return w.HasPermission(userID, PermissionPublish)
+}
+
+// TransitionTo moves the weave to next on behalf of userID, stamping PublishedAt
+// the first time it is published. Leaving draft or review drops any publication schedule,
+// and deleting remembers the current status so the weave can be restored from the trash.
+func (w *Weave) TransitionTo(next WeaveStatus, userID uuid.UUID) error {
+	if !next.IsValid() {
+		return errors.BadRequest(fmt.Sprintf("Unknown weave status '%s'", next))
+	}
+	if !w.CanChangeStatus(userID, next) {
+		return errors.Forbidden("You do not have permission to change the status of this weave")
+	}
+	if w.Status == next {
+		return errors.BadRequest(fmt.Sprintf("Weave is already %s", next))
+	}
+	if !w.Status.CanTransitionTo(next) {
+		return errors.Conflict(fmt.Sprintf("Cannot change weave status from %s to %s", w.Status, next))
+	}
+	if next == WeaveStatusPublished && !w.IsValidForPublication() {
+		return errors.BadRequest("Weave needs a title and content before it can be published")
+	}
+
+	now := time.Now()
+	if next == WeaveStatusPublished && w.PublishedAt == nil {
+		w.PublishedAt = &now
+	}
+	if !next.IsSchedulable() {
+		w.PublishAt = nil
+	}
+	if next == WeaveStatusDeleted {
+		previous := w.Status
+		w.PreviousStatus = &previous
+		w.TrashedAt = &now
+	}
+	w.Status = next
+	w.UpdatedAt = now
+	return nil
+}
+
+// IsTrashed reports whether the weave is deleted but still restorable
+func (w *Weave) IsTrashed() bool {
+	return w.Status == WeaveStatusDeleted
+}
+
+// Restore takes the weave out of the trash and back to the status it was deleted from
+func (w *Weave) Restore(userID uuid.UUID) error {
+	if !w.HasPermission(userID, PermissionDelete) {
+		return errors.Forbidden("You do not have permission to restore this weave")
+	}
+	if !w.IsTrashed() {
+		return errors.Conflict("Weave is not in the trash")
+	}
+
+	restored := WeaveStatusDraft
+	if w.PreviousStatus != nil && *w.PreviousStatus != WeaveStatusDeleted {
+		restored = *w.PreviousStatus
+	}
+
+	w.Status = restored
+	w.PreviousStatus = nil
+	w.TrashedAt = nil
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsScheduled reports whether the weave is waiting for a scheduled publication
+func (w *Weave) IsScheduled() bool {
+	return w.PublishAt != nil
+}
+
+// SchedulePublication sets or moves the time the weave will be published automatically
+func (w *Weave) SchedulePublication(publishAt time.Time, userID uuid.UUID) error {
+	if !w.CanChangeStatus(userID, WeaveStatusPublished) {
+		return errors.Forbidden("You do not have permission to publish this weave")
+	}
+	if !w.Status.IsSchedulable() {
+		return errors.Conflict(fmt.Sprintf("Cannot schedule publication of a %s weave", w.Status))
+	}
+	if !publishAt.After(time.Now()) {
+		return errors.BadRequest("Publication time must be in the future")
+	}
+	if !w.IsValidForPublication() {
+		return errors.BadRequest("Weave needs a title and content before it can be published")
+	}
+
+	w.PublishAt = &publishAt
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// CancelScheduledPublication keeps the weave in its current status indefinitely
+func (w *Weave) CancelScheduledPublication(userID uuid.UUID) error {
+	if !w.CanChangeStatus(userID, WeaveStatusPublished) {
+		return errors.Forbidden("You do not have permission to publish this weave")
+	}
+	if !w.IsScheduled() {
+		return errors.BadRequest("Weave has no scheduled publication")
+	}
+
+	w.PublishAt = nil
+	w.UpdatedAt = time.Now()
+	return nil
+}
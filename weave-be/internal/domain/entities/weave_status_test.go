@@ -0,0 +1,225 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-module/errors"
+)
+
+func newPublishableWeave(ownerID uuid.UUID) *Weave {
+	return NewWeave(ownerID, uuid.New(), "Weekend ramen", WeaveContent{
+		Type: "recipe",
+		Data: map[string]interface{}{"servings": 2},
+	})
+}
+
+func TestWeave_TransitionTo(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+
+	steps := []WeaveStatus{WeaveStatusInReview, WeaveStatusPublished, WeaveStatusArchived, WeaveStatusPublished, WeaveStatusDraft}
+	for _, next := range steps {
+		if err := weave.TransitionTo(next, ownerID); err != nil {
+			t.Fatalf("Expected transition to %s to succeed, got %v", next, err)
+		}
+		if weave.Status != next {
+			t.Fatalf("Expected status %s, got %s", next, weave.Status)
+		}
+	}
+
+	if weave.PublishedAt == nil {
+		t.Error("Expected PublishedAt to be set once the weave was published")
+	}
+}
+
+func TestWeave_TransitionTo_KeepsFirstPublishedAt(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+
+	if err := weave.TransitionTo(WeaveStatusPublished, ownerID); err != nil {
+		t.Fatalf("Expected publish to succeed, got %v", err)
+	}
+	firstPublishedAt := *weave.PublishedAt
+
+	_ = weave.TransitionTo(WeaveStatusDraft, ownerID)
+	if err := weave.TransitionTo(WeaveStatusPublished, ownerID); err != nil {
+		t.Fatalf("Expected republish to succeed, got %v", err)
+	}
+
+	if !weave.PublishedAt.Equal(firstPublishedAt) {
+		t.Error("Expected PublishedAt to keep the first publication time")
+	}
+}
+
+func TestWeave_TransitionTo_Invalid(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name  string
+		from  WeaveStatus
+		to    WeaveStatus
+		check func(error) bool
+	}{
+		{"draft cannot be archived", WeaveStatusDraft, WeaveStatusArchived, errors.IsConflict},
+		{"deleted is final", WeaveStatusDeleted, WeaveStatusPublished, errors.IsConflict},
+		{"same status", WeaveStatusPublished, WeaveStatusPublished, errors.IsBadRequest},
+		{"unknown status", WeaveStatusDraft, WeaveStatus("hidden"), errors.IsBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weave := newPublishableWeave(ownerID)
+			weave.Status = tt.from
+
+			err := weave.TransitionTo(tt.to, ownerID)
+			if err == nil || !tt.check(err) {
+				t.Fatalf("Expected a rejected transition, got %v", err)
+			}
+			if weave.Status != tt.from {
+				t.Errorf("Expected status to stay %s, got %s", tt.from, weave.Status)
+			}
+		})
+	}
+}
+
+func TestWeave_TransitionTo_RequiresOwner(t *testing.T) {
+	weave := newPublishableWeave(uuid.New())
+
+	if err := weave.TransitionTo(WeaveStatusPublished, uuid.New()); err == nil {
+		t.Fatal("Expected a non-owner transition to be rejected")
+	}
+	if weave.Status != WeaveStatusDraft {
+		t.Errorf("Expected status to stay draft, got %s", weave.Status)
+	}
+}
+
+func TestWeave_TransitionTo_RequiresContentToPublish(t *testing.T) {
+	ownerID := uuid.New()
+	weave := NewWeave(ownerID, uuid.New(), "Empty", WeaveContent{Type: "recipe"})
+
+	err := weave.TransitionTo(WeaveStatusPublished, ownerID)
+	if err == nil || !errors.IsBadRequest(err) {
+		t.Fatalf("Expected publishing without content to fail, got %v", err)
+	}
+}
+
+func TestWeave_SchedulePublication(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+
+	publishAt := time.Now().Add(time.Hour)
+	if err := weave.SchedulePublication(publishAt, ownerID); err != nil {
+		t.Fatalf("Expected scheduling to succeed, got %v", err)
+	}
+	if !weave.IsScheduled() || !weave.PublishAt.Equal(publishAt) {
+		t.Fatalf("Expected publication scheduled at %v, got %v", publishAt, weave.PublishAt)
+	}
+
+	if err := weave.TransitionTo(WeaveStatusInReview, ownerID); err != nil {
+		t.Fatalf("Expected review to succeed, got %v", err)
+	}
+	if !weave.IsScheduled() {
+		t.Error("Expected the schedule to survive moving into review")
+	}
+
+	if err := weave.TransitionTo(WeaveStatusPublished, ownerID); err != nil {
+		t.Fatalf("Expected publish to succeed, got %v", err)
+	}
+	if weave.IsScheduled() {
+		t.Error("Expected publishing to drop the schedule")
+	}
+}
+
+func TestWeave_SchedulePublication_Invalid(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name      string
+		status    WeaveStatus
+		publishAt time.Time
+		userID    uuid.UUID
+		check     func(error) bool
+	}{
+		{"in the past", WeaveStatusDraft, time.Now().Add(-time.Minute), ownerID, errors.IsBadRequest},
+		{"already published", WeaveStatusPublished, time.Now().Add(time.Hour), ownerID, errors.IsConflict},
+		{"not the owner", WeaveStatusDraft, time.Now().Add(time.Hour), uuid.New(), errors.IsForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weave := newPublishableWeave(ownerID)
+			weave.Status = tt.status
+
+			err := weave.SchedulePublication(tt.publishAt, tt.userID)
+			if err == nil || !tt.check(err) {
+				t.Fatalf("Expected a rejected schedule, got %v", err)
+			}
+			if weave.IsScheduled() {
+				t.Error("Expected no schedule to be set")
+			}
+		})
+	}
+}
+
+func TestWeave_CancelScheduledPublication(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+
+	if err := weave.CancelScheduledPublication(ownerID); err == nil || !errors.IsBadRequest(err) {
+		t.Fatalf("Expected cancelling without a schedule to fail, got %v", err)
+	}
+
+	_ = weave.SchedulePublication(time.Now().Add(time.Hour), ownerID)
+	if err := weave.CancelScheduledPublication(ownerID); err != nil {
+		t.Fatalf("Expected cancel to succeed, got %v", err)
+	}
+	if weave.IsScheduled() || weave.Status != WeaveStatusDraft {
+		t.Errorf("Expected an unscheduled draft, got %s scheduled=%v", weave.Status, weave.IsScheduled())
+	}
+}
+
+func TestWeave_Restore(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+	_ = weave.TransitionTo(WeaveStatusPublished, ownerID)
+
+	if err := weave.TransitionTo(WeaveStatusDeleted, ownerID); err != nil {
+		t.Fatalf("Expected delete to succeed, got %v", err)
+	}
+	if !weave.IsTrashed() || weave.TrashedAt == nil {
+		t.Fatal("Expected the weave to be in the trash")
+	}
+	if weave.PreviousStatus == nil || *weave.PreviousStatus != WeaveStatusPublished {
+		t.Fatalf("Expected previous status published, got %v", weave.PreviousStatus)
+	}
+
+	if err := weave.Restore(ownerID); err != nil {
+		t.Fatalf("Expected restore to succeed, got %v", err)
+	}
+	if weave.Status != WeaveStatusPublished {
+		t.Errorf("Expected the weave to be published again, got %s", weave.Status)
+	}
+	if weave.TrashedAt != nil || weave.PreviousStatus != nil {
+		t.Error("Expected the trash bookkeeping to be cleared")
+	}
+}
+
+func TestWeave_Restore_Invalid(t *testing.T) {
+	ownerID := uuid.New()
+	weave := newPublishableWeave(ownerID)
+	maintainerID := addCollaborator(weave, CollaboratorRoleMaintainer, true)
+
+	if err := weave.Restore(ownerID); err == nil || !errors.IsConflict(err) {
+		t.Fatalf("Expected restoring a live weave to conflict, got %v", err)
+	}
+
+	_ = weave.TransitionTo(WeaveStatusDeleted, ownerID)
+	if err := weave.Restore(maintainerID); err == nil || !errors.IsForbidden(err) {
+		t.Fatalf("Expected only owners to restore, got %v", err)
+	}
+	if weave.Status != WeaveStatusDeleted {
+		t.Errorf("Expected the weave to stay deleted, got %s", weave.Status)
+	}
+}
@@ -0,0 +1,76 @@
+package export
+
+import (
+	"sort"
+	"time"
+
+	"weave-be/internal/domain/entities"
+	"weave-module/bundle"
+)
+
+// NewBundle archives a weave with its version history and timeline.
+// Versions and events may be given in any order; the bundle lists them oldest first.
+func NewBundle(weave *entities.Weave, authorUsername string, versions []*entities.WeaveVersion, timeline []*entities.WeaveTimelineEvent) *bundle.Bundle {
+	b := &bundle.Bundle{
+		Format:     bundle.Format,
+		Version:    bundle.CurrentVersion,
+		ExportedAt: time.Now(),
+		Source: bundle.Source{
+			WeaveID:        weave.ID,
+			ChannelID:      weave.ChannelID,
+			AuthorID:       weave.UserID,
+			AuthorUsername: authorUsername,
+		},
+		Weave: bundle.Weave{
+			Title:       weave.Title,
+			Description: weave.Description,
+			CoverImage:  weave.CoverImage,
+			Content:     bundleContent(weave.Content),
+			Version:     weave.Version,
+			Status:      string(weave.Status),
+			PublishedAt: weave.PublishedAt,
+			CreatedAt:   weave.CreatedAt,
+			UpdatedAt:   weave.UpdatedAt,
+		},
+		Versions: make([]bundle.Version, 0, len(versions)),
+		Timeline: make([]bundle.Event, 0, len(timeline)),
+	}
+
+	for _, version := range versions {
+		b.Versions = append(b.Versions, bundle.Version{
+			Version:   version.Version,
+			Title:     version.Title,
+			Content:   bundleContent(version.Content),
+			ChangeLog: version.ChangeLog,
+			AuthorID:  version.UserID,
+			CreatedAt: version.CreatedAt,
+		})
+	}
+	sort.Slice(b.Versions, func(i, j int) bool {
+		return b.Versions[i].Version < b.Versions[j].Version
+	})
+
+	for _, event := range timeline {
+		b.Timeline = append(b.Timeline, bundle.Event{
+			Type:        event.EventType,
+			Title:       event.Title,
+			Description: event.Description,
+			Metadata:    event.Metadata,
+			UserID:      event.UserID,
+			CreatedAt:   event.CreatedAt,
+		})
+	}
+	sort.SliceStable(b.Timeline, func(i, j int) bool {
+		return b.Timeline[i].CreatedAt.Before(b.Timeline[j].CreatedAt)
+	})
+
+	return b
+}
+
+func bundleContent(content entities.WeaveContent) bundle.Content {
+	return bundle.Content{
+		Type:          content.Type,
+		SchemaVersion: content.SchemaVersion,
+		Data:          content.Data,
+	}
+}
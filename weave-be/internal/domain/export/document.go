@@ -0,0 +1,235 @@
+// Package export renders weaves into formats readable outside the app:
+// Markdown and HTML documents for people, and JSON bundles for archiving
+// and re-importing. Markdown documents can be read back into content as well.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"weave-be/internal/domain/entities"
+)
+
+// Format is an export format accepted by the export endpoint
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+	FormatJSON     Format = "json"
+)
+
+func (f Format) IsValid() bool {
+	switch f {
+	case FormatMarkdown, FormatHTML, FormatJSON:
+		return true
+	}
+	return false
+}
+
+// Document is weave content laid out independently of the output format
+type Document struct {
+	Title       string
+	Description string
+	Meta        []Field
+	Sections    []Section
+}
+
+// Section is a titled block of a document. Any of its parts may be empty.
+type Section struct {
+	Heading    string
+	Fields     []Field
+	Paragraphs []string
+	Items      []string
+	Ordered    bool // Items are numbered steps rather than bullets
+}
+
+// Field is a labelled value, e.g. "Servings: 4"
+type Field struct {
+	Label string
+	Value string
+}
+
+// ContentRenderer lays out the data of one content type as document sections
+type ContentRenderer func(data map[string]interface{}) []Section
+
+// renderers holds the layout of each built-in content type. Types without one,
+// freeform included, fall back to renderGeneric.
+var renderers = map[string]ContentRenderer{
+	"recipe":      renderRecipe,
+	"travel-plan": renderTravelPlan,
+	"workout":     renderWorkout,
+}
+
+// NewDocument lays out a weave's title and content with the renderer of its content type
+func NewDocument(title string, content entities.WeaveContent) *Document {
+	render, ok := renderers[content.Type]
+	if !ok {
+		render = renderGeneric
+	}
+	return &Document{
+		Title:    title,
+		Sections: nonEmpty(render(content.Data)),
+	}
+}
+
+func (s *Section) isEmpty() bool {
+	return len(s.Fields) == 0 && len(s.Paragraphs) == 0 && len(s.Items) == 0
+}
+
+func nonEmpty(sections []Section) []Section {
+	kept := sections[:0]
+	for _, section := range sections {
+		if !section.isEmpty() {
+			kept = append(kept, section)
+		}
+	}
+	return kept
+}
+
+// renderGeneric lays out arbitrary JSON: top-level scalars become details,
+// lists and objects get a section of their own
+func renderGeneric(data map[string]interface{}) []Section {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	details := Section{Heading: "Details"}
+	var sections []Section
+	for _, key := range keys {
+		switch value := data[key].(type) {
+		case []interface{}:
+			items := make([]string, 0, len(value))
+			for _, item := range value {
+				items = append(items, formatValue(item))
+			}
+			sections = append(sections, Section{Heading: humanize(key), Items: items})
+		case map[string]interface{}:
+			sections = append(sections, Section{Heading: humanize(key), Fields: objectFields(value)})
+		default:
+			details.Fields = append(details.Fields, Field{Label: humanize(key), Value: formatValue(value)})
+		}
+	}
+	return append([]Section{details}, sections...)
+}
+
+// objectFields lists the entries of an object as fields ordered by key
+func objectFields(object map[string]interface{}) []Field {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]Field, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, Field{Label: humanize(key), Value: formatValue(object[key])})
+	}
+	return fields
+}
+
+// humanize turns a JSON key such as "prep_time" into a label such as "Prep time"
+func humanize(key string) string {
+	label := strings.NewReplacer("_", " ", "-", " ").Replace(key)
+	first, size := utf8.DecodeRuneInString(label)
+	if size == 0 {
+		return label
+	}
+	return string(unicode.ToUpper(first)) + label[size:]
+}
+
+// formatValue writes a JSON value as plain text. Whole numbers drop their
+// decimals and nested structures fall back to compact JSON.
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, formatValue(item))
+		}
+		return strings.Join(parts, ", ")
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
+
+// field returns the labelled value at key, or false when it is missing or empty
+func field(data map[string]interface{}, key, label, unit string) (Field, bool) {
+	value := formatValue(data[key])
+	if value == "" {
+		return Field{}, false
+	}
+	if unit != "" {
+		value += " " + unit
+	}
+	return Field{Label: label, Value: value}, true
+}
+
+// fields collects the present fields of specs, each given as key, label, unit
+func fields(data map[string]interface{}, specs ...[3]string) []Field {
+	var collected []Field
+	for _, spec := range specs {
+		if f, ok := field(data, spec[0], spec[1], spec[2]); ok {
+			collected = append(collected, f)
+		}
+	}
+	return collected
+}
+
+func objects(value interface{}) []map[string]interface{} {
+	list, _ := value.([]interface{})
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		if object, ok := item.(map[string]interface{}); ok {
+			result = append(result, object)
+		}
+	}
+	return result
+}
+
+func stringList(value interface{}) []string {
+	list, _ := value.([]interface{})
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if text := formatValue(item); text != "" {
+			result = append(result, text)
+		}
+	}
+	return result
+}
+
+// number reads a JSON number, reporting false for anything else
+func number(object map[string]interface{}, key string) (float64, bool) {
+	value, ok := object[key].(float64)
+	return value, ok
+}
+
+// joinNonEmpty joins the non-empty parts with sep
+func joinNonEmpty(sep string, parts ...string) string {
+	kept := parts[:0]
+	for _, part := range parts {
+		if part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, sep)
+}
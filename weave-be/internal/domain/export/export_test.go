@@ -0,0 +1,189 @@
+package export
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+func recipeContent() entities.WeaveContent {
+	return entities.WeaveContent{
+		Type: "recipe",
+		Data: map[string]interface{}{
+			"servings":  2.0,
+			"prep_time": 10.0,
+			"ingredients": []interface{}{
+				map[string]interface{}{"name": "kimchi", "amount": "200", "unit": "g"},
+				map[string]interface{}{"name": "tofu"},
+			},
+			"instructions": []interface{}{
+				map[string]interface{}{"step": 2.0, "description": "Add tofu", "duration": 5.0},
+				map[string]interface{}{"step": 1.0, "description": "Fry kimchi"},
+			},
+		},
+	}
+}
+
+func TestRenderMarkdown_Recipe(t *testing.T) {
+	doc := NewDocument("Kimchi stew", recipeContent())
+	doc.Description = "Weeknight dinner"
+
+	markdown := string(RenderMarkdown(doc))
+
+	for _, want := range []string{
+		"# Kimchi stew\n",
+		"\nWeeknight dinner\n",
+		"- **Prep time:** 10 min\n",
+		"- **Servings:** 2\n",
+		"## Ingredients\n\n- 200 g kimchi\n- tofu\n",
+		"## Steps\n\n1. Fry kimchi\n2. Add tofu (5 min)\n",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("Expected markdown to contain %q, got:\n%s", want, markdown)
+		}
+	}
+}
+
+func TestRenderHTML_EscapesContent(t *testing.T) {
+	doc := NewDocument("<script>alert(1)</script>", recipeContent())
+
+	page := string(RenderHTML(doc))
+
+	if strings.Contains(page, "<script>") {
+		t.Errorf("Expected title to be escaped, got:\n%s", page)
+	}
+	if !strings.Contains(page, "<ol>\n<li>Fry kimchi</li>") {
+		t.Errorf("Expected steps as an ordered list, got:\n%s", page)
+	}
+}
+
+func TestNewDocument_GenericFallback(t *testing.T) {
+	doc := NewDocument("Notes", entities.WeaveContent{
+		Type: "freeform",
+		Data: map[string]interface{}{
+			"summary": "Short",
+			"links":   []interface{}{"a", "b"},
+			"empty":   []interface{}{},
+		},
+	})
+
+	if len(doc.Sections) != 2 {
+		t.Fatalf("Expected details and links sections, got %+v", doc.Sections)
+	}
+	if doc.Sections[0].Fields[0] != (Field{Label: "Summary", Value: "Short"}) {
+		t.Errorf("Expected scalar fields under details, got %+v", doc.Sections[0])
+	}
+	if doc.Sections[1].Heading != "Links" || len(doc.Sections[1].Items) != 2 {
+		t.Errorf("Expected a links section with two items, got %+v", doc.Sections[1])
+	}
+}
+
+func TestNewBundle_OrdersHistoryOldestFirst(t *testing.T) {
+	weave := entities.NewWeave(uuid.New(), uuid.New(), "Kimchi stew", recipeContent())
+	weave.Version = 2
+	now := time.Now()
+	versions := []*entities.WeaveVersion{
+		entities.NewWeaveVersion(weave.ID, weave.UserID, 2, weave.Title, weave.Content, nil),
+		entities.NewWeaveVersion(weave.ID, weave.UserID, 1, weave.Title, weave.Content, nil),
+	}
+	timeline := []*entities.WeaveTimelineEvent{
+		{EventType: "published", CreatedAt: now},
+		{EventType: "created", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	b := NewBundle(weave, "cook", versions, timeline)
+
+	if err := b.Validate(); err != nil {
+		t.Fatalf("Expected a valid bundle, got %v", err)
+	}
+	if b.Versions[0].Version != 1 || b.Timeline[0].Type != "created" {
+		t.Errorf("Expected history oldest first, got versions %+v and timeline %+v", b.Versions, b.Timeline)
+	}
+	if b.Source.WeaveID != weave.ID || b.Source.AuthorUsername != "cook" {
+		t.Errorf("Expected source to record the weave and author, got %+v", b.Source)
+	}
+}
+
+func TestParseMarkdown_RoundTripsRenderedContent(t *testing.T) {
+	tests := []entities.WeaveContent{
+		{Type: "recipe", Data: map[string]interface{}{
+			"servings":             2.0,
+			"cook_time":            20.0,
+			"difficulty":           "easy",
+			"dietary_restrictions": []interface{}{"vegetarian"},
+			"ingredients": []interface{}{
+				map[string]interface{}{"name": "kimchi", "amount": "200", "unit": "g"},
+				map[string]interface{}{"name": "eggs", "amount": "2"},
+				map[string]interface{}{"name": "tofu"},
+			},
+			"instructions": []interface{}{
+				map[string]interface{}{"step": 1.0, "description": "Fry kimchi", "duration": 5.0},
+				map[string]interface{}{"step": 2.0, "description": "Add tofu"},
+			},
+		}},
+		{Type: "travel-plan", Data: map[string]interface{}{
+			"destination": "Seoul",
+			"duration":    2.0,
+			"itinerary": []interface{}{
+				map[string]interface{}{"day": 1.0, "activities": []interface{}{
+					map[string]interface{}{"time": "09:00", "activity": "Market tour", "location": "Gwangjang", "duration": 90.0, "cost": 20.0, "notes": "bring cash"},
+				}},
+				map[string]interface{}{"day": 2.0, "activities": []interface{}{
+					map[string]interface{}{"activity": "Hike"},
+				}},
+			},
+			"budget": map[string]interface{}{"food": 100.0},
+			"tips":   []interface{}{"Get a T-money card"},
+		}},
+		{Type: "workout", Data: map[string]interface{}{
+			"type":       "strength",
+			"difficulty": "beginner",
+			"equipment":  []interface{}{"barbell", "bench"},
+			"exercises": []interface{}{
+				map[string]interface{}{"name": "Squat", "sets": 3.0, "reps": 10.0, "weight": 60.0, "rest": 90.0, "instructions": "Keep your back straight"},
+				map[string]interface{}{"name": "Plank", "duration": 45.0},
+			},
+			"warmup": []interface{}{"Jumping jacks"},
+		}},
+		{Type: "freeform", Data: map[string]interface{}{
+			"summary": "Short",
+			"rating":  4.0,
+			"links":   []interface{}{"a", "b"},
+		}},
+	}
+
+	for _, content := range tests {
+		t.Run(content.Type, func(t *testing.T) {
+			doc, err := ParseMarkdown(string(RenderMarkdown(NewDocument("Title", content))))
+			if err != nil {
+				t.Fatalf("Expected rendered markdown to parse, got %v", err)
+			}
+
+			parsed := ParseContent(content.Type, doc)
+			if !reflect.DeepEqual(parsed.Data, content.Data) {
+				t.Errorf("Expected data to survive the round trip\nwant %#v\ngot  %#v", content.Data, parsed.Data)
+			}
+		})
+	}
+}
+
+func TestParseMarkdown_ReadsTitleAndDescription(t *testing.T) {
+	doc, err := ParseMarkdown("# Kimchi stew\r\n\r\nA weeknight\r\ndinner\r\n\r\n- **Author:** cook\r\n")
+	if err != nil {
+		t.Fatalf("Expected markdown to parse, got %v", err)
+	}
+	if doc.Title != "Kimchi stew" || doc.Description != "A weeknight dinner" {
+		t.Errorf("Expected title and description, got %q and %q", doc.Title, doc.Description)
+	}
+	if len(doc.Meta) != 1 || doc.Meta[0].Value != "cook" {
+		t.Errorf("Expected author meta, got %+v", doc.Meta)
+	}
+
+	if _, err := ParseMarkdown("no heading"); err == nil {
+		t.Error("Expected markdown without a title to be rejected")
+	}
+}
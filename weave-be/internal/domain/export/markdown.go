@@ -0,0 +1,99 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseMarkdown reads a Markdown document laid out the way RenderMarkdown writes
+// it: a "#" title, an optional description, optional "- **Label:** value" meta
+// lines and "##" sections. Hand-written files only need the same general shape.
+func ParseMarkdown(markdown string) (*Document, error) {
+	doc := &Document{}
+	var section *Section
+	var paragraph []string
+
+	flush := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		text := strings.Join(paragraph, " ")
+		paragraph = nil
+		switch {
+		case section != nil:
+			section.Paragraphs = append(section.Paragraphs, text)
+		case doc.Description == "":
+			doc.Description = text
+		default:
+			doc.Description += "\n\n" + text
+		}
+	}
+
+	for _, raw := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		line := strings.TrimSpace(raw)
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "## "):
+			flush()
+			doc.Sections = append(doc.Sections, Section{Heading: strings.TrimSpace(line[3:])})
+			section = &doc.Sections[len(doc.Sections)-1]
+		case strings.HasPrefix(line, "# "):
+			flush()
+			if doc.Title == "" {
+				doc.Title = strings.TrimSpace(line[2:])
+			}
+		default:
+			if label, value, ok := parseFieldLine(line); ok {
+				flush()
+				if section == nil {
+					doc.Meta = append(doc.Meta, Field{Label: label, Value: value})
+				} else {
+					section.Fields = append(section.Fields, Field{Label: label, Value: value})
+				}
+			} else if item, ordered, ok := parseListItem(line); ok && section != nil {
+				flush()
+				section.Items = append(section.Items, item)
+				section.Ordered = section.Ordered || ordered
+			} else {
+				paragraph = append(paragraph, line)
+			}
+		}
+	}
+	flush()
+
+	if doc.Title == "" {
+		return nil, fmt.Errorf("markdown needs a \"# Title\" heading")
+	}
+	return doc, nil
+}
+
+// parseFieldLine reads "- **Label:** value"
+func parseFieldLine(line string) (string, string, bool) {
+	if !strings.HasPrefix(line, "- **") {
+		return "", "", false
+	}
+	rest := line[len("- **"):]
+	end := strings.Index(rest, ":**")
+	if end <= 0 {
+		return "", "", false
+	}
+	return rest[:end], strings.TrimSpace(rest[end+len(":**"):]), true
+}
+
+// parseListItem reads "- item", "* item" and "1. item"
+func parseListItem(line string) (string, bool, bool) {
+	if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") {
+		return strings.TrimSpace(line[2:]), false, true
+	}
+	dot := strings.Index(line, ". ")
+	if dot <= 0 {
+		return "", false, false
+	}
+	for _, r := range line[:dot] {
+		if r < '0' || r > '9' {
+			return "", false, false
+		}
+	}
+	return strings.TrimSpace(line[dot+2:]), true, true
+}
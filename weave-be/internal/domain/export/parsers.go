@@ -0,0 +1,364 @@
+package export
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"weave-be/internal/domain/entities"
+)
+
+// ContentParser reads the sections of a document back into the data of one
+// content type. It is the inverse of the type's ContentRenderer; the result
+// still has to pass the content type's schema.
+type ContentParser func(doc *Document) map[string]interface{}
+
+// parsers holds the inverse of each renderer. Types without one fall back to parseGeneric.
+var parsers = map[string]ContentParser{
+	"recipe":      parseRecipe,
+	"travel-plan": parseTravelPlan,
+	"workout":     parseWorkout,
+}
+
+// ParseContent turns a parsed Markdown document into content of the given type
+func ParseContent(contentType string, doc *Document) entities.WeaveContent {
+	parse, ok := parsers[contentType]
+	if !ok {
+		parse = parseGeneric
+	}
+	return entities.WeaveContent{
+		Type: contentType,
+		Data: parse(doc),
+	}
+}
+
+// section returns the section with the given heading, ignoring case
+func (d *Document) section(heading string) *Section {
+	for i := range d.Sections {
+		if strings.EqualFold(d.Sections[i].Heading, heading) {
+			return &d.Sections[i]
+		}
+	}
+	return &Section{Heading: heading}
+}
+
+// value returns the value of the field with the given label, ignoring case
+func (s *Section) value(label string) string {
+	for _, f := range s.Fields {
+		if strings.EqualFold(f.Label, label) {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// setText stores a non-empty text value
+func setText(data map[string]interface{}, key, value string) {
+	if value != "" {
+		data[key] = value
+	}
+}
+
+// setNumber stores the leading number of values such as "15 min"
+func setNumber(data map[string]interface{}, key, value string) {
+	if n, ok := leadingNumber(value); ok {
+		data[key] = n
+	}
+}
+
+// setList stores a comma separated value as a list of strings
+func setList(data map[string]interface{}, key, value string) {
+	if value == "" {
+		return
+	}
+	var list []interface{}
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+	data[key] = list
+}
+
+var numberPattern = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+func leadingNumber(value string) (float64, bool) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 || !numberPattern.MatchString(fields[0]) {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(fields[0], 64)
+	return n, err == nil
+}
+
+func itemList(items []string) []interface{} {
+	list := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		list = append(list, item)
+	}
+	return list
+}
+
+// trailingNote splits "text (note)" into its text and note
+func trailingNote(item string) (string, string) {
+	if !strings.HasSuffix(item, ")") {
+		return item, ""
+	}
+	open := strings.LastIndex(item, " (")
+	if open < 0 {
+		return item, ""
+	}
+	return item[:open], item[open+2 : len(item)-1]
+}
+
+var minutesPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?) min$`)
+
+// recipeUnits are the units recognised between an ingredient's amount and name
+var recipeUnits = map[string]bool{
+	"g": true, "kg": true, "mg": true, "ml": true, "l": true,
+	"tsp": true, "tbsp": true, "cup": true, "cups": true, "oz": true, "lb": true,
+	"pinch": true, "clove": true, "cloves": true, "slice": true, "slices": true,
+	"큰술": true, "작은술": true, "컵": true, "개": true, "쪽": true, "줌": true,
+}
+
+func parseRecipe(doc *Document) map[string]interface{} {
+	data := map[string]interface{}{}
+	details := doc.section("Details")
+	setNumber(data, "prep_time", details.value("Prep time"))
+	setNumber(data, "cook_time", details.value("Cook time"))
+	setNumber(data, "servings", details.value("Servings"))
+	setText(data, "difficulty", strings.ToLower(details.value("Difficulty")))
+	setText(data, "cuisine", details.value("Cuisine"))
+	setList(data, "dietary_restrictions", details.value("Dietary"))
+
+	var ingredients []interface{}
+	for _, item := range doc.section("Ingredients").Items {
+		ingredients = append(ingredients, parseIngredient(item))
+	}
+	data["ingredients"] = ingredients
+
+	var instructions []interface{}
+	for i, item := range doc.section("Steps").Items {
+		step := map[string]interface{}{"step": float64(i + 1), "description": item}
+		if text, note := trailingNote(item); note != "" {
+			if match := minutesPattern.FindStringSubmatch(note); match != nil {
+				step["description"] = text
+				step["duration"], _ = strconv.ParseFloat(match[1], 64)
+			}
+		}
+		instructions = append(instructions, step)
+	}
+	data["instructions"] = instructions
+
+	return data
+}
+
+// parseIngredient reads "200 g kimchi" into amount, unit and name
+func parseIngredient(item string) map[string]interface{} {
+	ingredient := map[string]interface{}{"name": item}
+	words := strings.Fields(item)
+	if len(words) < 2 || !startsWithDigit(words[0]) {
+		return ingredient
+	}
+
+	ingredient["amount"] = words[0]
+	rest := words[1:]
+	if len(rest) > 1 && recipeUnits[strings.ToLower(rest[0])] {
+		ingredient["unit"] = rest[0]
+		rest = rest[1:]
+	}
+	ingredient["name"] = strings.Join(rest, " ")
+	return ingredient
+}
+
+func startsWithDigit(word string) bool {
+	return word != "" && word[0] >= '0' && word[0] <= '9'
+}
+
+var clockPattern = regexp.MustCompile(`^\d{1,2}:\d{2}$`)
+
+func parseTravelPlan(doc *Document) map[string]interface{} {
+	data := map[string]interface{}{}
+	details := doc.section("Details")
+	setText(data, "destination", details.value("Destination"))
+	setNumber(data, "duration", details.value("Duration"))
+
+	var itinerary []interface{}
+	for _, section := range doc.Sections {
+		number, ok := strings.CutPrefix(section.Heading, "Day ")
+		if !ok {
+			continue
+		}
+		day, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if err != nil {
+			continue
+		}
+		activities := []interface{}{}
+		for _, item := range section.Items {
+			activities = append(activities, parseActivity(item))
+		}
+		itinerary = append(itinerary, map[string]interface{}{"day": day, "activities": activities})
+	}
+	data["itinerary"] = itinerary
+
+	if budget := doc.section("Budget"); len(budget.Fields) > 0 {
+		amounts := map[string]interface{}{}
+		for _, f := range budget.Fields {
+			setNumber(amounts, strings.ToLower(f.Label), f.Value)
+		}
+		data["budget"] = amounts
+	}
+	if tips := doc.section("Tips").Items; len(tips) > 0 {
+		data["tips"] = itemList(tips)
+	}
+
+	return data
+}
+
+// parseActivity reads "09:00 Market tour @ Gwangjang (90 min; cost 20; bring cash)"
+func parseActivity(item string) map[string]interface{} {
+	activity := map[string]interface{}{}
+	text, note := trailingNote(item)
+
+	var notes []string
+	if note != "" {
+		for _, part := range strings.Split(note, "; ") {
+			if match := minutesPattern.FindStringSubmatch(part); match != nil {
+				activity["duration"], _ = strconv.ParseFloat(match[1], 64)
+			} else if cost, ok := strings.CutPrefix(part, "cost "); ok {
+				setNumber(activity, "cost", cost)
+			} else {
+				notes = append(notes, part)
+			}
+		}
+	}
+	setText(activity, "notes", strings.Join(notes, "; "))
+
+	if name, location, ok := strings.Cut(text, " @ "); ok {
+		text = name
+		setText(activity, "location", location)
+	}
+	if clock, rest, ok := strings.Cut(text, " "); ok && clockPattern.MatchString(clock) {
+		activity["time"] = clock
+		text = rest
+	}
+	activity["activity"] = text
+	return activity
+}
+
+func parseWorkout(doc *Document) map[string]interface{} {
+	data := map[string]interface{}{}
+	details := doc.section("Details")
+	setText(data, "type", strings.ToLower(details.value("Type")))
+	setText(data, "difficulty", strings.ToLower(details.value("Difficulty")))
+	setNumber(data, "duration", details.value("Duration"))
+	setList(data, "equipment", details.value("Equipment"))
+
+	if warmup := doc.section("Warm-up").Items; len(warmup) > 0 {
+		data["warmup"] = itemList(warmup)
+	}
+	var exercises []interface{}
+	for _, item := range doc.section("Exercises").Items {
+		exercises = append(exercises, parseExercise(item))
+	}
+	data["exercises"] = exercises
+	if cooldown := doc.section("Cool-down").Items; len(cooldown) > 0 {
+		data["cooldown"] = itemList(cooldown)
+	}
+
+	return data
+}
+
+var setsRepsPattern = regexp.MustCompile(`^(\d+) × (\d+)$`)
+
+// parseExercise reads "Squat — 3 × 10, 60 kg, rest 90 s. Keep your back straight"
+func parseExercise(item string) map[string]interface{} {
+	exercise := map[string]interface{}{}
+	name, load, hasLoad := strings.Cut(item, " — ")
+	if !hasLoad {
+		load = ""
+	}
+
+	// Instructions follow the first full stop of whatever comes last
+	tail := &name
+	if hasLoad {
+		tail = &load
+	}
+	if text, instructions, ok := strings.Cut(*tail, ". "); ok {
+		*tail = text
+		setText(exercise, "instructions", instructions)
+	}
+	exercise["name"] = name
+
+	for _, part := range strings.Split(load, ", ") {
+		switch {
+		case part == "":
+		case setsRepsPattern.MatchString(part):
+			match := setsRepsPattern.FindStringSubmatch(part)
+			setNumber(exercise, "sets", match[1])
+			setNumber(exercise, "reps", match[2])
+		case strings.HasSuffix(part, " sets"):
+			setNumber(exercise, "sets", part)
+		case strings.HasSuffix(part, " reps"):
+			setNumber(exercise, "reps", part)
+		case strings.HasSuffix(part, " kg"):
+			setNumber(exercise, "weight", part)
+		case strings.HasPrefix(part, "rest "):
+			setNumber(exercise, "rest", strings.TrimPrefix(part, "rest "))
+		case strings.HasSuffix(part, " s"):
+			setNumber(exercise, "duration", part)
+		}
+	}
+	return exercise
+}
+
+// parseGeneric reads the layout of renderGeneric: details become top-level
+// values, sections with items become lists and sections with fields objects
+func parseGeneric(doc *Document) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, section := range doc.Sections {
+		if strings.EqualFold(section.Heading, "Details") {
+			for _, f := range section.Fields {
+				data[keyOf(f.Label)] = scalarOf(f.Value)
+			}
+			continue
+		}
+
+		key := keyOf(section.Heading)
+		switch {
+		case len(section.Items) > 0:
+			list := make([]interface{}, 0, len(section.Items))
+			for _, item := range section.Items {
+				list = append(list, scalarOf(item))
+			}
+			data[key] = list
+		case len(section.Fields) > 0:
+			object := map[string]interface{}{}
+			for _, f := range section.Fields {
+				object[keyOf(f.Label)] = scalarOf(f.Value)
+			}
+			data[key] = object
+		case len(section.Paragraphs) > 0:
+			data[key] = strings.Join(section.Paragraphs, "\n\n")
+		}
+	}
+	return data
+}
+
+// keyOf turns a label such as "Prep time" back into a key such as "prep_time"
+func keyOf(label string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(label)), " ", "_")
+}
+
+// scalarOf reads numbers and booleans, keeping everything else as text
+func scalarOf(value string) interface{} {
+	if numberPattern.MatchString(value) {
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	}
+	if value == "true" || value == "false" {
+		return value == "true"
+	}
+	return value
+}
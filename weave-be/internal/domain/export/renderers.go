@@ -0,0 +1,160 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// renderRecipe lays out a recipe as details, an ingredient list and numbered steps
+func renderRecipe(data map[string]interface{}) []Section {
+	details := Section{
+		Heading: "Details",
+		Fields: fields(data,
+			[3]string{"prep_time", "Prep time", "min"},
+			[3]string{"cook_time", "Cook time", "min"},
+			[3]string{"servings", "Servings", ""},
+			[3]string{"difficulty", "Difficulty", ""},
+			[3]string{"cuisine", "Cuisine", ""},
+			[3]string{"dietary_restrictions", "Dietary", ""},
+		),
+	}
+
+	ingredients := Section{Heading: "Ingredients"}
+	for _, ingredient := range objects(data["ingredients"]) {
+		ingredients.Items = append(ingredients.Items, joinNonEmpty(" ",
+			formatValue(ingredient["amount"]),
+			formatValue(ingredient["unit"]),
+			formatValue(ingredient["name"]),
+		))
+	}
+
+	// Steps are numbered by their own step field, not by their position in the list
+	instructions := objects(data["instructions"])
+	sort.SliceStable(instructions, func(i, j int) bool {
+		a, _ := number(instructions[i], "step")
+		b, _ := number(instructions[j], "step")
+		return a < b
+	})
+	steps := Section{Heading: "Steps", Ordered: true}
+	for _, instruction := range instructions {
+		step := formatValue(instruction["description"])
+		if minutes, ok := number(instruction, "duration"); ok && minutes > 0 {
+			step += fmt.Sprintf(" (%s min)", formatValue(minutes))
+		}
+		steps.Items = append(steps.Items, step)
+	}
+
+	return []Section{details, ingredients, steps}
+}
+
+// renderTravelPlan lays out a travel plan as one section per day, followed by budget and tips
+func renderTravelPlan(data map[string]interface{}) []Section {
+	sections := []Section{{
+		Heading: "Details",
+		Fields: fields(data,
+			[3]string{"destination", "Destination", ""},
+			[3]string{"duration", "Duration", "days"},
+		),
+	}}
+
+	days := objects(data["itinerary"])
+	sort.SliceStable(days, func(i, j int) bool {
+		a, _ := number(days[i], "day")
+		b, _ := number(days[j], "day")
+		return a < b
+	})
+	for _, day := range days {
+		section := Section{Heading: "Day " + formatValue(day["day"])}
+		for _, activity := range objects(day["activities"]) {
+			item := joinNonEmpty(" ", formatValue(activity["time"]), formatValue(activity["activity"]))
+			if location := formatValue(activity["location"]); location != "" {
+				item += " @ " + location
+			}
+			var extras []string
+			if minutes, ok := number(activity, "duration"); ok && minutes > 0 {
+				extras = append(extras, formatValue(minutes)+" min")
+			}
+			if cost, ok := number(activity, "cost"); ok && cost > 0 {
+				extras = append(extras, "cost "+formatValue(cost))
+			}
+			if notes := formatValue(activity["notes"]); notes != "" {
+				extras = append(extras, notes)
+			}
+			if len(extras) > 0 {
+				item += " (" + strings.Join(extras, "; ") + ")"
+			}
+			section.Items = append(section.Items, item)
+		}
+		sections = append(sections, section)
+	}
+
+	if budget, ok := data["budget"].(map[string]interface{}); ok {
+		sections = append(sections, Section{
+			Heading: "Budget",
+			Fields: fields(budget,
+				[3]string{"accommodation", "Accommodation", ""},
+				[3]string{"transport", "Transport", ""},
+				[3]string{"food", "Food", ""},
+				[3]string{"activities", "Activities", ""},
+				[3]string{"misc", "Misc", ""},
+			),
+		})
+	}
+	sections = append(sections, Section{Heading: "Tips", Items: stringList(data["tips"])})
+
+	return sections
+}
+
+// renderWorkout lays out a workout as warm-up, numbered exercises and cool-down
+func renderWorkout(data map[string]interface{}) []Section {
+	details := Section{
+		Heading: "Details",
+		Fields: fields(data,
+			[3]string{"type", "Type", ""},
+			[3]string{"difficulty", "Difficulty", ""},
+			[3]string{"duration", "Duration", "min"},
+			[3]string{"equipment", "Equipment", ""},
+		),
+	}
+
+	exercises := Section{Heading: "Exercises", Ordered: true}
+	for _, exercise := range objects(data["exercises"]) {
+		var load []string
+		sets, hasSets := number(exercise, "sets")
+		reps, hasReps := number(exercise, "reps")
+		switch {
+		case hasSets && hasReps:
+			load = append(load, fmt.Sprintf("%s × %s", formatValue(sets), formatValue(reps)))
+		case hasSets:
+			load = append(load, formatValue(sets)+" sets")
+		case hasReps:
+			load = append(load, formatValue(reps)+" reps")
+		}
+		if seconds, ok := number(exercise, "duration"); ok {
+			load = append(load, formatValue(seconds)+" s")
+		}
+		if weight, ok := number(exercise, "weight"); ok && weight > 0 {
+			load = append(load, formatValue(weight)+" kg")
+		}
+		if rest, ok := number(exercise, "rest"); ok && rest > 0 {
+			load = append(load, "rest "+formatValue(rest)+" s")
+		}
+
+		item := formatValue(exercise["name"])
+		if len(load) > 0 {
+			item += " — " + strings.Join(load, ", ")
+		}
+		if instructions := formatValue(exercise["instructions"]); instructions != "" {
+			item += ". " + instructions
+		}
+		exercises.Items = append(exercises.Items, item)
+	}
+
+	return []Section{
+		details,
+		{Heading: "Warm-up", Items: stringList(data["warmup"])},
+		exercises,
+		{Heading: "Cool-down", Items: stringList(data["cooldown"])},
+	}
+}
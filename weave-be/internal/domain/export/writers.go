@@ -0,0 +1,98 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+)
+
+// RenderMarkdown writes the document as CommonMark
+func RenderMarkdown(doc *Document) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "# %s\n", doc.Title)
+	if doc.Description != "" {
+		fmt.Fprintf(&buf, "\n%s\n", doc.Description)
+	}
+	if len(doc.Meta) > 0 {
+		buf.WriteString("\n")
+		writeMarkdownFields(&buf, doc.Meta)
+	}
+
+	for _, section := range doc.Sections {
+		fmt.Fprintf(&buf, "\n## %s\n", section.Heading)
+		if len(section.Fields) > 0 {
+			buf.WriteString("\n")
+			writeMarkdownFields(&buf, section.Fields)
+		}
+		for _, paragraph := range section.Paragraphs {
+			fmt.Fprintf(&buf, "\n%s\n", paragraph)
+		}
+		if len(section.Items) > 0 {
+			buf.WriteString("\n")
+			for i, item := range section.Items {
+				if section.Ordered {
+					fmt.Fprintf(&buf, "%d. %s\n", i+1, item)
+				} else {
+					fmt.Fprintf(&buf, "- %s\n", item)
+				}
+			}
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func writeMarkdownFields(buf *bytes.Buffer, fields []Field) {
+	for _, f := range fields {
+		fmt.Fprintf(buf, "- **%s:** %s\n", f.Label, f.Value)
+	}
+}
+
+// RenderHTML writes the document as a standalone HTML page
+func RenderHTML(doc *Document) []byte {
+	var buf bytes.Buffer
+	title := html.EscapeString(doc.Title)
+
+	buf.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&buf, "<title>%s</title>\n</head>\n<body>\n<article>\n", title)
+	fmt.Fprintf(&buf, "<h1>%s</h1>\n", title)
+	if doc.Description != "" {
+		fmt.Fprintf(&buf, "<p>%s</p>\n", html.EscapeString(doc.Description))
+	}
+	writeHTMLFields(&buf, doc.Meta)
+
+	for _, section := range doc.Sections {
+		fmt.Fprintf(&buf, "<section>\n<h2>%s</h2>\n", html.EscapeString(section.Heading))
+		writeHTMLFields(&buf, section.Fields)
+		for _, paragraph := range section.Paragraphs {
+			fmt.Fprintf(&buf, "<p>%s</p>\n", html.EscapeString(paragraph))
+		}
+		if len(section.Items) > 0 {
+			tag := "ul"
+			if section.Ordered {
+				tag = "ol"
+			}
+			fmt.Fprintf(&buf, "<%s>\n", tag)
+			for _, item := range section.Items {
+				fmt.Fprintf(&buf, "<li>%s</li>\n", html.EscapeString(item))
+			}
+			fmt.Fprintf(&buf, "</%s>\n", tag)
+		}
+		buf.WriteString("</section>\n")
+	}
+
+	buf.WriteString("</article>\n</body>\n</html>\n")
+	return buf.Bytes()
+}
+
+func writeHTMLFields(buf *bytes.Buffer, fields []Field) {
+	if len(fields) == 0 {
+		return
+	}
+	buf.WriteString("<dl>\n")
+	for _, f := range fields {
+		fmt.Fprintf(buf, "<dt>%s</dt><dd>%s</dd>\n", html.EscapeString(f.Label), html.EscapeString(f.Value))
+	}
+	buf.WriteString("</dl>\n")
+}
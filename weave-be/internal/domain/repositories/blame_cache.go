@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// WeaveBlameCache stores computed blame per weave version. Versions only
+// change when an account is deleted and its versions are credited to the
+// weave owners; the deletion job drops the affected entries itself.
+type WeaveBlameCache interface {
+	// Get returns nil without an error on a cache miss
+	Get(ctx context.Context, weaveID uuid.UUID, version int) (*entities.WeaveBlame, error)
+	Set(ctx context.Context, blame *entities.WeaveBlame) error
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// ChannelRepository interface for channel data access
+type ChannelRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Channel, error)
+}
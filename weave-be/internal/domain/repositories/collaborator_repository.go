@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// ErrCollaboratorExists is returned when inviting someone who already has a role on the weave
+var ErrCollaboratorExists = errors.New("user is already a collaborator")
+
+// CollaboratorRepository interface for weave collaborator data access
+type CollaboratorRepository interface {
+	// Create stores an invitation, failing with ErrCollaboratorExists for duplicates
+	Create(ctx context.Context, collaborator *entities.WeaveCollaborator) error
+
+	GetByWeaveAndUser(ctx context.Context, weaveID, userID uuid.UUID) (*entities.WeaveCollaborator, error)
+	// ListByWeave returns the collaborators of a weave with their usernames, active ones first
+	ListByWeave(ctx context.Context, weaveID uuid.UUID, includeInvited bool) ([]*entities.WeaveCollaborator, error)
+
+	Update(ctx context.Context, collaborator *entities.WeaveCollaborator) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
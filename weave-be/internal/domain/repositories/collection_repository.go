@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+var (
+	// ErrWeaveAlreadyInCollection is returned when adding a weave a collection already holds
+	ErrWeaveAlreadyInCollection = errors.New("weave is already in the collection")
+	// ErrCollectionOrderMismatch is returned when a new order does not list exactly the weaves of the collection
+	ErrCollectionOrderMismatch = errors.New("order does not match the weaves in the collection")
+)
+
+// CollectionRepository interface for weave collection data access
+type CollectionRepository interface {
+	Create(ctx context.Context, collection *entities.Collection) error
+	// GetByID loads a collection with its weave count
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Collection, error)
+	// GetByUser lists a user's collections, most recently updated first
+	GetByUser(ctx context.Context, userID uuid.UUID, includePrivate bool, limit, offset int) ([]*entities.Collection, error)
+	CountByUser(ctx context.Context, userID uuid.UUID, includePrivate bool) (int64, error)
+	Update(ctx context.Context, collection *entities.Collection) error
+	// Delete removes a collection; the weaves in it are not touched
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AddWeave inserts a weave at position, or at the end when position is nil. The first
+	// time a weave is added to a public collection it is recorded in the weave's timeline.
+	// It fails with ErrWeaveAlreadyInCollection for duplicates.
+	AddWeave(ctx context.Context, collection *entities.Collection, weaveID uuid.UUID, position *int) error
+	RemoveWeave(ctx context.Context, collectionID, weaveID uuid.UUID) error
+	// ReorderWeaves puts the collection's weaves in the given order, failing with
+	// ErrCollectionOrderMismatch unless weaveIDs lists each of them exactly once
+	ReorderWeaves(ctx context.Context, collectionID uuid.UUID, weaveIDs []uuid.UUID) error
+
+	// GetWeaves lists the weaves of a collection in order. Only published weaves are
+	// included unless includeUnpublished is set; deleted weaves never are.
+	GetWeaves(ctx context.Context, collectionID uuid.UUID, includeUnpublished bool, limit, offset int) ([]*entities.Weave, error)
+	CountWeaves(ctx context.Context, collectionID uuid.UUID, includeUnpublished bool) (int64, error)
+}
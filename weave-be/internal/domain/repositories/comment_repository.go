@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// CommentFilter narrows the lab comments listed for a weave; unset fields match everything
+type CommentFilter struct {
+	AnchorPath *string // Comments anchored at this path or below it
+	Type       *entities.CommentType
+	IsResolved *bool
+	IsOutdated *bool
+
+	ThreadsOnly bool // Only comments that start a thread, with their reply counts
+}
+
+// CommentRepository interface for lab comment data access
+type CommentRepository interface {
+	Create(ctx context.Context, comment *entities.LabComment) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.LabComment, error)
+
+	// GetByWeave lists a weave's comments matching the filter, oldest first
+	GetByWeave(ctx context.Context, weaveID uuid.UUID, filter CommentFilter, limit, offset int) ([]*entities.LabComment, error)
+	CountByWeave(ctx context.Context, weaveID uuid.UUID, filter CommentFilter) (int64, error)
+
+	// CountOpenThreads counts a weave's unresolved threads by type
+	CountOpenThreads(ctx context.Context, weaveID uuid.UUID) (map[entities.CommentType]int, error)
+
+	// Threads
+	// GetDepth returns how many levels of replies lie between the comment and its thread's first comment
+	GetDepth(ctx context.Context, id uuid.UUID) (int, error)
+	// GetReplies returns the replies below a comment down to maxDepth levels, oldest first
+	GetReplies(ctx context.Context, id uuid.UUID, maxDepth int) ([]*entities.LabComment, error)
+	UpdateResolution(ctx context.Context, comment *entities.LabComment) error
+
+	// Edit history
+	// Edit stores the comment's new content along with the revision holding the previous one
+	Edit(ctx context.Context, comment *entities.LabComment, revision *entities.CommentRevision) error
+	// GetRevisions returns the earlier contents of a comment, newest first
+	GetRevisions(ctx context.Context, commentID uuid.UUID) ([]*entities.CommentRevision, error)
+
+	// Likes
+	Like(ctx context.Context, commentID, userID uuid.UUID) error
+	Unlike(ctx context.Context, commentID, userID uuid.UUID) error
+	IsLiked(ctx context.Context, commentID, userID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+var (
+	// ErrWeaveVersionConflict is returned when a weave changed between reading and writing it
+	ErrWeaveVersionConflict = errors.New("weave version changed concurrently")
+	// ErrContributionNotOpen is returned when merging a contribution that was merged or rejected meanwhile
+	ErrContributionNotOpen = errors.New("contribution is no longer open")
+	// ErrVoteNotFound is returned when withdrawing a vote the user never cast
+	ErrVoteNotFound = errors.New("vote not found")
+)
+
+// ContributionRepository interface for contribution data access operations
+type ContributionRepository interface {
+	// Create operations
+	Create(ctx context.Context, contribution *entities.Contribution) error
+
+	// Read operations
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Contribution, error)
+	HasOpenFromSource(ctx context.Context, sourceWeaveID uuid.UUID) (bool, error)
+	// GetMergedVersions maps versions of a weave that came from merged contributions to their contribution
+	GetMergedVersions(ctx context.Context, weaveID uuid.UUID) (map[int]uuid.UUID, error)
+
+	// Update operations
+	Update(ctx context.Context, contribution *entities.Contribution) error
+
+	// AssignReviewer stores the contribution's reviewer and status
+	AssignReviewer(ctx context.Context, contribution *entities.Contribution) error
+
+	// Reviews
+	// AddReview stores the review along with the review fields and status it gave the contribution
+	AddReview(ctx context.Context, contribution *entities.Contribution, review *entities.ContributionReview) error
+	// GetReviews returns every review left on a contribution, oldest first
+	GetReviews(ctx context.Context, contributionID uuid.UUID) ([]*entities.ContributionReview, error)
+
+	// Votes
+	// Vote casts or changes the user's vote and returns the contribution's new score.
+	// The score is kept in step with the votes within the same transaction.
+	Vote(ctx context.Context, contributionID, userID uuid.UUID, vote entities.VoteType) (int, error)
+	// RemoveVote withdraws the user's vote and returns the new score, or ErrVoteNotFound
+	RemoveVote(ctx context.Context, contributionID, userID uuid.UUID) (int, error)
+
+	// Merge writes the merged content to the weave as a new version credited to the
+	// contributor, marks the contribution merged and records it in the weave timeline.
+	// A review given with the merge, such as the approval of an accepted suggestion,
+	// is stored in the same transaction; review may be nil.
+	// The merging user is recorded as merged_by. It fails with ErrContributionNotOpen
+	// if the contribution was merged or rejected meanwhile, and with
+	// ErrWeaveVersionConflict if the weave is no longer at expectedVersion.
+	Merge(ctx context.Context, contributionID, mergedBy uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error)
+	// Rebase stores a contribution rebased onto the weave at expectedVersion. The
+	// weave row is held while the contribution is written, so no version can land
+	// in between. It fails with ErrContributionNotOpen if the contribution was
+	// merged or rejected meanwhile, and with ErrWeaveVersionConflict if the weave
+	// is no longer at expectedVersion.
+	Rebase(ctx context.Context, contribution *entities.Contribution, expectedVersion int) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// MergePolicyRepository interface for weave merge policy data access
+type MergePolicyRepository interface {
+	// GetByWeave returns the policy a weave set, or gorm.ErrRecordNotFound if it never set one
+	GetByWeave(ctx context.Context, weaveID uuid.UUID) (*entities.MergePolicy, error)
+	// Save creates or replaces the policy of a weave
+	Save(ctx context.Context, policy *entities.MergePolicy) error
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// TagRepository interface for tags and the weaves carrying them.
+// Names passed in are expected to be normalized already.
+type TagRepository interface {
+	// SetWeaveTags replaces the tags of a weave, creating missing tags and following
+	// the aliases left by merges. It returns the names that were attached.
+	SetWeaveTags(ctx context.Context, weaveID uuid.UUID, names []string) ([]string, error)
+
+	// GetByName finds a tag by name, or by a name it absorbed in a merge
+	GetByName(ctx context.Context, name string) (*entities.Tag, error)
+	// Autocomplete returns the tags in use whose name starts with the prefix or is
+	// one of extra, the most used first
+	Autocomplete(ctx context.Context, prefix string, extra []string, limit int) ([]*entities.Tag, error)
+	// GetRelated returns the tags that most often share published weaves with the tag
+	GetRelated(ctx context.Context, tagID uuid.UUID, limit int) ([]*entities.RelatedTag, error)
+
+	// Merge moves every weave of source onto target, deletes source and keeps its
+	// name as an alias of target
+	Merge(ctx context.Context, source, target *entities.Tag) error
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/entities"
+)
+
+// ErrTemplateAlreadyPinned is returned when pinning a template a channel already pins
+var ErrTemplateAlreadyPinned = errors.New("template is already pinned in the channel")
+
+// TemplateRepository interface for the official templates pinned by channels
+type TemplateRepository interface {
+	// Pin stores a pin, failing with ErrTemplateAlreadyPinned for duplicates
+	Pin(ctx context.Context, pin *entities.ChannelTemplate) error
+	Unpin(ctx context.Context, channelID, weaveID uuid.UUID) error
+
+	// ListPinned returns a channel's pins with their weaves in position order.
+	// Pins of weaves that are no longer published templates are left out.
+	ListPinned(ctx context.Context, channelID uuid.UUID) ([]*entities.ChannelTemplate, error)
+	// CountPinned counts every pin of a channel, hidden ones included
+	CountPinned(ctx context.Context, channelID uuid.UUID) (int64, error)
+}
@@ -2,18 +2,24 @@ package repositories
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"github.com/google/uuid"
 	"weave-be/internal/domain/entities"
+	"weave-module/bundle"
 )
 
+// ErrWeaveStatusConflict is returned when a weave's status changed between reading and writing it
+var ErrWeaveStatusConflict = errors.New("weave status changed concurrently")
+
 // WeaveRepository interface for weave data access operations
 type WeaveRepository interface {
 	// Create operations
 	Create(ctx context.Context, weave *entities.Weave) error
 	Fork(ctx context.Context, originalID, newUserID uuid.UUID) (*entities.Weave, error)
-	
+	// Import creates a draft weave owned by userID from a validated bundle, restoring its history
+	Import(ctx context.Context, b *bundle.Bundle, userID, channelID uuid.UUID) (*entities.Weave, error)
+
 	// Read operations
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Weave, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error)
@@ -22,54 +28,83 @@ type WeaveRepository interface {
 	GetFeatured(ctx context.Context, limit, offset int) ([]*entities.Weave, error)
 	GetDrafts(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error)
 	GetForked(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]*entities.Weave, error)
-	
+
+	// Templates
+	// GetTemplates lists published templates, most used first, optionally within one channel
+	GetTemplates(ctx context.Context, channelID *uuid.UUID, limit, offset int) ([]*entities.Weave, error)
+	CountTemplates(ctx context.Context, channelID *uuid.UUID) (int64, error)
+
+	// Trash
+	// GetTrashedByID loads a deleted weave; other read operations never return deleted weaves
+	GetTrashedByID(ctx context.Context, id uuid.UUID) (*entities.Weave, error)
+	GetTrashed(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error)
+	CountTrashed(ctx context.Context, userID uuid.UUID) (int64, error)
+
 	// Update operations
-	Update(ctx context.Context, weave *entities.Weave) error
-	UpdateContent(ctx context.Context, weaveID uuid.UUID, content entities.WeaveContent) error
-	UpdatePublishStatus(ctx context.Context, weaveID uuid.UUID, isPublished bool) error
+	// Update saves the weave as its next version, edited by userID, and records that
+	// version in the history in the same transaction. It is a compare-and-swap on
+	// version: it fails with ErrWeaveVersionConflict if the stored weave is no longer
+	// at expectedVersion.
+	Update(ctx context.Context, weave *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error)
+	// UpdateStatus persists a status transition made by userID and records it in the timeline.
+	// It fails with ErrWeaveStatusConflict if the weave is no longer in the from status.
+	UpdateStatus(ctx context.Context, weave *entities.Weave, from entities.WeaveStatus, userID uuid.UUID) error
+	// UpdatePublishSchedule persists weave.PublishAt. It fails with ErrWeaveStatusConflict
+	// if the weave was published or left draft/review in the meantime.
+	UpdatePublishSchedule(ctx context.Context, weave *entities.Weave) error
 	UpdateFeaturedStatus(ctx context.Context, weaveID uuid.UUID, isFeatured bool) error
+	// UpdateTemplateStatus persists weave.IsTemplate as changed by userID and records it in the timeline.
+	// Marking fails with ErrWeaveStatusConflict if the weave is no longer published;
+	// withdrawing also unpins the weave from every channel.
+	UpdateTemplateStatus(ctx context.Context, weave *entities.Weave, userID uuid.UUID) error
 	IncrementViewCount(ctx context.Context, weaveID uuid.UUID) error
 	IncrementLikeCount(ctx context.Context, weaveID uuid.UUID) error
 	DecrementLikeCount(ctx context.Context, weaveID uuid.UUID) error
 	IncrementForkCount(ctx context.Context, weaveID uuid.UUID) error
-	
+
 	// Delete operations
 	Delete(ctx context.Context, id uuid.UUID) error
 	SoftDelete(ctx context.Context, id uuid.UUID) error
-	
+
 	// Search operations
 	Search(ctx context.Context, query string, channelID *uuid.UUID, limit, offset int) ([]*entities.Weave, error)
+	SearchCount(ctx context.Context, query string, channelID *uuid.UUID) (int64, error)
 	SearchByTags(ctx context.Context, tags []string, limit, offset int) ([]*entities.Weave, error)
-	
+	CountByTags(ctx context.Context, tags []string) (int64, error)
+
 	// Analytics
 	Count(ctx context.Context) (int64, error)
+	CountFeatured(ctx context.Context) (int64, error)
 	CountByChannel(ctx context.Context, channelID uuid.UUID) (int64, error)
 	CountByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountDrafts(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountForks(ctx context.Context, parentID uuid.UUID) (int64, error)
 	CountForkedByUser(ctx context.Context, userID uuid.UUID) (int64, error)
 	CountLikedByUser(ctx context.Context, userID uuid.UUID) (int64, error)
 	CountContributionsByUser(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetTrending(ctx context.Context, timeframe string, limit, offset int) ([]*entities.Weave, error)
 	GetPopular(ctx context.Context, limit, offset int) ([]*entities.Weave, error)
-	
+
 	// Like system
+	// Like is idempotent: liking a weave twice counts once
 	Like(ctx context.Context, weaveID, userID uuid.UUID) error
 	Unlike(ctx context.Context, weaveID, userID uuid.UUID) error
 	IsLiked(ctx context.Context, weaveID, userID uuid.UUID) (bool, error)
 	GetLikedBy(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error)
-	
+
+	// Lineage
+	GetAncestors(ctx context.Context, weaveID uuid.UUID, maxDepth int) ([]*entities.LineageNode, error)
+	GetDescendants(ctx context.Context, weaveID uuid.UUID, maxDepth, limit int) ([]*entities.LineageNode, error)
+
 	// Version control
-	CreateVersion(ctx context.Context, weaveID uuid.UUID, content entities.WeaveContent, changeLog *string) error
 	GetVersions(ctx context.Context, weaveID uuid.UUID) ([]*entities.WeaveVersion, error)
 	GetVersion(ctx context.Context, weaveID uuid.UUID, version int) (*entities.WeaveVersion, error)
-}
+	RevertToVersion(ctx context.Context, weaveID, userID uuid.UUID, version int) (*entities.WeaveVersion, error)
+
+	// Timeline
+	// GetTimeline returns every timeline event of a weave, oldest first
+	GetTimeline(ctx context.Context, weaveID uuid.UUID) ([]*entities.WeaveTimelineEvent, error)
 
-// WeaveVersion represents a historical version of a weave
-type WeaveVersion struct {
-	ID        uuid.UUID
-	WeaveID   uuid.UUID
-	Version   int
-	Title     string
-	Content   entities.WeaveContent
-	ChangeLog *string
-	CreatedAt time.Time
-}
\ No newline at end of file
+	// Upstream sync
+	SyncWithUpstream(ctx context.Context, forkID, userID uuid.UUID, content entities.WeaveContent, upstreamVersion, expectedVersion int) (*entities.WeaveVersion, error)
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// NotificationService delivers notifications to users.
+// Delivery happens asynchronously, so a returned error only means the notification was not queued.
+type NotificationService interface {
+	Notify(ctx context.Context, userID uuid.UUID, notificationType, title, message string, data map[string]interface{}) error
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TaskService hands long-running work to the background worker.
+// A returned error only means the task was not queued.
+type TaskService interface {
+	Enqueue(ctx context.Context, taskType string, userID uuid.UUID, data map[string]interface{}) error
+}
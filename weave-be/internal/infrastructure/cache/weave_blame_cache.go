@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/redis"
+)
+
+// Blame is cheap to rebuild, so entries only need to outlive bursts of views
+const weaveBlameTTL = 24 * time.Hour
+
+type weaveBlameCache struct{}
+
+// NewWeaveBlameCache creates a Redis backed blame cache
+func NewWeaveBlameCache() repositories.WeaveBlameCache {
+	return &weaveBlameCache{}
+}
+
+func (c *weaveBlameCache) Get(ctx context.Context, weaveID uuid.UUID, version int) (*entities.WeaveBlame, error) {
+	data, err := redis.Get(ctx, redis.WeaveBlameKey(weaveID.String(), version))
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var blame entities.WeaveBlame
+	if err := json.Unmarshal([]byte(data), &blame); err != nil {
+		return nil, err
+	}
+	return &blame, nil
+}
+
+func (c *weaveBlameCache) Set(ctx context.Context, blame *entities.WeaveBlame) error {
+	data, err := json.Marshal(blame)
+	if err != nil {
+		return err
+	}
+	return redis.Set(ctx, redis.WeaveBlameKey(blame.WeaveID.String(), blame.Version), string(data), weaveBlameTTL)
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/models"
+)
+
+type channelRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewChannelRepository() repositories.ChannelRepository {
+	return &channelRepositoryImpl{
+		db: database.GetDB(),
+	}
+}
+
+func (r *channelRepositoryImpl) modelToEntity(model *models.Channel) (*entities.Channel, error) {
+	channel := &entities.Channel{
+		ID:          model.ID,
+		Name:        model.Name,
+		Slug:        model.Slug,
+		Description: model.Description,
+		IsActive:    model.IsActive,
+		IsPublic:    model.IsPublic,
+	}
+	if model.AcceptedContentTypes != nil {
+		if err := json.Unmarshal([]byte(*model.AcceptedContentTypes), &channel.AcceptedContentTypes); err != nil {
+			return nil, err
+		}
+	}
+	return channel, nil
+}
+
+func (r *channelRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.Channel, error) {
+	var model models.Channel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return r.modelToEntity(&model)
+}
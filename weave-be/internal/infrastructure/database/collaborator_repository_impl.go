@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/models"
+)
+
+// collaboratorRepositoryImpl implements the CollaboratorRepository interface
+type collaboratorRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCollaboratorRepository creates a new collaborator repository implementation
+func NewCollaboratorRepository() repositories.CollaboratorRepository {
+	return &collaboratorRepositoryImpl{
+		db: database.GetDB(),
+	}
+}
+
+func collaboratorModelToEntity(model *models.WeaveCollaborator) *entities.WeaveCollaborator {
+	return &entities.WeaveCollaborator{
+		ID:         model.ID,
+		WeaveID:    model.WeaveID,
+		UserID:     model.UserID,
+		Username:   model.User.Username,
+		Role:       entities.CollaboratorRole(model.Role),
+		Status:     entities.CollaboratorStatus(model.Status),
+		InvitedBy:  model.InvitedBy,
+		AcceptedAt: model.AcceptedAt,
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+}
+
+func collaboratorModelsToEntities(collaboratorModels []models.WeaveCollaborator) []*entities.WeaveCollaborator {
+	collaborators := make([]*entities.WeaveCollaborator, len(collaboratorModels))
+	for i := range collaboratorModels {
+		collaborators[i] = collaboratorModelToEntity(&collaboratorModels[i])
+	}
+	return collaborators
+}
+
+func (r *collaboratorRepositoryImpl) Create(ctx context.Context, collaborator *entities.WeaveCollaborator) error {
+	model := &models.WeaveCollaborator{
+		ID:         collaborator.ID,
+		WeaveID:    collaborator.WeaveID,
+		UserID:     collaborator.UserID,
+		Role:       models.CollaboratorRole(collaborator.Role),
+		Status:     models.CollaboratorStatus(collaborator.Status),
+		InvitedBy:  collaborator.InvitedBy,
+		AcceptedAt: collaborator.AcceptedAt,
+		CreatedAt:  collaborator.CreatedAt,
+		UpdatedAt:  collaborator.UpdatedAt,
+	}
+
+	// The unique index on (weave_id, user_id) settles concurrent invitations
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrCollaboratorExists
+	}
+	return nil
+}
+
+func (r *collaboratorRepositoryImpl) GetByWeaveAndUser(ctx context.Context, weaveID, userID uuid.UUID) (*entities.WeaveCollaborator, error) {
+	var model models.WeaveCollaborator
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("weave_id = ? AND user_id = ?", weaveID, userID).
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return collaboratorModelToEntity(&model), nil
+}
+
+func (r *collaboratorRepositoryImpl) ListByWeave(ctx context.Context, weaveID uuid.UUID, includeInvited bool) ([]*entities.WeaveCollaborator, error) {
+	query := r.db.WithContext(ctx).Preload("User").Where("weave_id = ?", weaveID)
+	if !includeInvited {
+		query = query.Where("status = ?", models.CollaboratorStatusActive)
+	}
+
+	var collaboratorModels []models.WeaveCollaborator
+	if err := query.Order("status ASC, created_at ASC").Find(&collaboratorModels).Error; err != nil {
+		return nil, err
+	}
+	return collaboratorModelsToEntities(collaboratorModels), nil
+}
+
+func (r *collaboratorRepositoryImpl) Update(ctx context.Context, collaborator *entities.WeaveCollaborator) error {
+	result := r.db.WithContext(ctx).Model(&models.WeaveCollaborator{}).
+		Where("id = ?", collaborator.ID).
+		Updates(map[string]interface{}{
+			"role":        models.CollaboratorRole(collaborator.Role),
+			"status":      models.CollaboratorStatus(collaborator.Status),
+			"accepted_at": collaborator.AcceptedAt,
+			"updated_at":  collaborator.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *collaboratorRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.WeaveCollaborator{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
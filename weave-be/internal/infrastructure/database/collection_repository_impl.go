@@ -0,0 +1,359 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/models"
+)
+
+// collectionRepositoryImpl implements the CollectionRepository interface
+type collectionRepositoryImpl struct {
+	db     *gorm.DB
+	weaves *weaveRepositoryImpl // Loads the weaves of collections
+}
+
+// NewCollectionRepository creates a new collection repository implementation
+func NewCollectionRepository() repositories.CollectionRepository {
+	db := database.GetDB()
+	return &collectionRepositoryImpl{
+		db:     db,
+		weaves: &weaveRepositoryImpl{db: db},
+	}
+}
+
+// collectionRow is a collection with the number of weaves it holds
+type collectionRow struct {
+	models.WeaveCollection
+	WeaveCount int
+}
+
+func collectionRowToEntity(row *collectionRow) *entities.Collection {
+	return &entities.Collection{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Name:        row.Name,
+		Description: row.Description,
+		IsPublic:    row.IsPublic,
+		WeaveCount:  row.WeaveCount,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// withWeaveCount selects collections along with the number of weaves in each
+func (r *collectionRepositoryImpl) withWeaveCount(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&models.WeaveCollection{}).
+		Select("weave_collections.*, (SELECT COUNT(*) FROM collection_weaves WHERE collection_weaves.weave_collection_id = weave_collections.id) AS weave_count")
+}
+
+func (r *collectionRepositoryImpl) Create(ctx context.Context, collection *entities.Collection) error {
+	model := &models.WeaveCollection{
+		ID:          collection.ID,
+		UserID:      collection.UserID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		IsPublic:    collection.IsPublic,
+		CreatedAt:   collection.CreatedAt,
+		UpdatedAt:   collection.UpdatedAt,
+	}
+	return r.db.WithContext(ctx).Create(model).Error
+}
+
+func (r *collectionRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.Collection, error) {
+	var rows []collectionRow
+	err := r.withWeaveCount(ctx).
+		Where("weave_collections.id = ?", id).
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return collectionRowToEntity(&rows[0]), nil
+}
+
+func (r *collectionRepositoryImpl) GetByUser(ctx context.Context, userID uuid.UUID, includePrivate bool, limit, offset int) ([]*entities.Collection, error) {
+	var rows []collectionRow
+	err := r.owned(r.withWeaveCount(ctx), userID, includePrivate).
+		Order("weave_collections.updated_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]*entities.Collection, len(rows))
+	for i := range rows {
+		collections[i] = collectionRowToEntity(&rows[i])
+	}
+	return collections, nil
+}
+
+func (r *collectionRepositoryImpl) CountByUser(ctx context.Context, userID uuid.UUID, includePrivate bool) (int64, error) {
+	var count int64
+	err := r.owned(r.db.WithContext(ctx).Model(&models.WeaveCollection{}), userID, includePrivate).Count(&count).Error
+	return count, err
+}
+
+// owned restricts the query to a user's collections, public ones only unless includePrivate is set
+func (r *collectionRepositoryImpl) owned(query *gorm.DB, userID uuid.UUID, includePrivate bool) *gorm.DB {
+	query = query.Where("weave_collections.user_id = ?", userID)
+	if !includePrivate {
+		query = query.Where("weave_collections.is_public = ?", true)
+	}
+	return query
+}
+
+func (r *collectionRepositoryImpl) Update(ctx context.Context, collection *entities.Collection) error {
+	result := r.db.WithContext(ctx).Model(&models.WeaveCollection{}).
+		Where("id = ?", collection.ID).
+		Updates(map[string]interface{}{
+			"name":        collection.Name,
+			"description": collection.Description,
+			"is_public":   collection.IsPublic,
+			"updated_at":  collection.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *collectionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("weave_collection_id = ?", id).Delete(&models.CollectionWeave{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", id).Delete(&models.WeaveCollection{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (r *collectionRepositoryImpl) AddWeave(ctx context.Context, collection *entities.Collection, weaveID uuid.UUID, position *int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCollection(tx, collection.ID); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.CollectionWeave{}).Where("weave_collection_id = ?", collection.ID).Count(&count).Error; err != nil {
+			return err
+		}
+
+		// Positions stay contiguous, so inserting makes room by shifting the later weaves
+		at := int(count)
+		if position != nil && *position < at {
+			at = *position
+			err := tx.Model(&models.CollectionWeave{}).
+				Where("weave_collection_id = ? AND position >= ?", collection.ID, at).
+				UpdateColumn("position", gorm.Expr("position + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		entry := &models.CollectionWeave{
+			WeaveCollectionID: collection.ID,
+			WeaveID:           weaveID,
+			Position:          at,
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entry)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repositories.ErrWeaveAlreadyInCollection
+		}
+
+		if err := r.touch(tx, collection.ID); err != nil {
+			return err
+		}
+		return recordCollectionAdded(tx, collection, weaveID)
+	})
+}
+
+// lockCollection holds a collection's row until the transaction ends, so
+// concurrent changes to its weaves keep positions contiguous
+func lockCollection(tx *gorm.DB, collectionID uuid.UUID) error {
+	var collection models.WeaveCollection
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", collectionID).
+		First(&collection).Error
+}
+
+// recordCollectionAdded records a weave being added to a public collection in
+// the weave's timeline, once per collection. The weave's timeline is public,
+// so private collections leave no trace in it.
+func recordCollectionAdded(tx *gorm.DB, collection *entities.Collection, weaveID uuid.UUID) error {
+	if !collection.IsPublic {
+		return nil
+	}
+
+	var recorded int64
+	err := tx.Model(&models.WeaveTimeline{}).
+		Where("weave_id = ? AND event_type = ? AND metadata->>'collection_id' = ?",
+			weaveID, models.TimelineCollectionAdded, collection.ID.String()).
+		Count(&recorded).Error
+	if err != nil || recorded > 0 {
+		return err
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"collection_id":   collection.ID,
+		"collection_name": collection.Name,
+	})
+	if err != nil {
+		return err
+	}
+	serializedMetadata := string(metadata)
+
+	return tx.Create(&models.WeaveTimeline{
+		WeaveID:   weaveID,
+		UserID:    collection.UserID,
+		EventType: models.TimelineCollectionAdded,
+		Title:     fmt.Sprintf("Added to collection \"%s\"", collection.Name),
+		Metadata:  &serializedMetadata,
+	}).Error
+}
+
+func (r *collectionRepositoryImpl) RemoveWeave(ctx context.Context, collectionID, weaveID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCollection(tx, collectionID); err != nil {
+			return err
+		}
+
+		var entry models.CollectionWeave
+		err := tx.Where("weave_collection_id = ? AND weave_id = ?", collectionID, weaveID).First(&entry).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("weave_collection_id = ? AND weave_id = ?", collectionID, weaveID).Delete(&models.CollectionWeave{}).Error; err != nil {
+			return err
+		}
+		err = tx.Model(&models.CollectionWeave{}).
+			Where("weave_collection_id = ? AND position > ?", collectionID, entry.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error
+		if err != nil {
+			return err
+		}
+
+		return r.touch(tx, collectionID)
+	})
+}
+
+func (r *collectionRepositoryImpl) ReorderWeaves(ctx context.Context, collectionID uuid.UUID, weaveIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCollection(tx, collectionID); err != nil {
+			return err
+		}
+
+		var entries []struct {
+			WeaveID uuid.UUID
+			Status  models.WeaveStatus
+		}
+		err := tx.Model(&models.CollectionWeave{}).
+			Select("collection_weaves.weave_id, weaves.status").
+			Joins("JOIN weaves ON weaves.id = collection_weaves.weave_id").
+			Where("collection_weaves.weave_collection_id = ?", collectionID).
+			Order("collection_weaves.position ASC").
+			Scan(&entries).Error
+		if err != nil {
+			return err
+		}
+
+		// Weaves in the trash cannot be seen by the owner, so they keep their relative order at the end
+		listed := make(map[uuid.UUID]bool, len(weaveIDs))
+		for _, id := range weaveIDs {
+			listed[id] = true
+		}
+		order := append([]uuid.UUID(nil), weaveIDs...)
+		live := 0
+		for _, entry := range entries {
+			if entry.Status == models.WeaveStatusDeleted {
+				if !listed[entry.WeaveID] {
+					order = append(order, entry.WeaveID)
+				}
+				continue
+			}
+			if !listed[entry.WeaveID] {
+				return repositories.ErrCollectionOrderMismatch
+			}
+			live++
+		}
+		if live != len(weaveIDs) || len(listed) != len(weaveIDs) {
+			return repositories.ErrCollectionOrderMismatch
+		}
+
+		for position, weaveID := range order {
+			err := tx.Model(&models.CollectionWeave{}).
+				Where("weave_collection_id = ? AND weave_id = ?", collectionID, weaveID).
+				UpdateColumn("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return r.touch(tx, collectionID)
+	})
+}
+
+// touch marks the collection as updated when its weaves change
+func (r *collectionRepositoryImpl) touch(tx *gorm.DB, collectionID uuid.UUID) error {
+	return tx.Model(&models.WeaveCollection{}).
+		Where("id = ?", collectionID).
+		UpdateColumn("updated_at", time.Now()).Error
+}
+
+func (r *collectionRepositoryImpl) GetWeaves(ctx context.Context, collectionID uuid.UUID, includeUnpublished bool, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.collected(ctx, collectionID, includeUnpublished).
+		Order("collection_weaves.position ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.weaves.modelsToEntities(weaveModels), nil
+}
+
+func (r *collectionRepositoryImpl) CountWeaves(ctx context.Context, collectionID uuid.UUID, includeUnpublished bool) (int64, error) {
+	var count int64
+	err := r.collected(ctx, collectionID, includeUnpublished).Count(&count).Error
+	return count, err
+}
+
+// collected restricts the query to the weaves of a collection
+func (r *collectionRepositoryImpl) collected(ctx context.Context, collectionID uuid.UUID, includeUnpublished bool) *gorm.DB {
+	query := r.weaves.published(ctx)
+	if includeUnpublished {
+		query = r.weaves.visible(ctx)
+	}
+	return query.
+		Joins("JOIN collection_weaves ON collection_weaves.weave_id = weaves.id").
+		Where("collection_weaves.weave_collection_id = ?", collectionID)
+}
@@ -0,0 +1,334 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/diff"
+	"weave-module/models"
+)
+
+// likeEscaper escapes the LIKE wildcards in literal text matched with ESCAPE '\'
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// commentRepositoryImpl implements the CommentRepository interface
+type commentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCommentRepository creates a new comment repository implementation
+func NewCommentRepository() repositories.CommentRepository {
+	return &commentRepositoryImpl{
+		db: database.GetDB(),
+	}
+}
+
+func commentModelToEntity(model *models.LabComment) *entities.LabComment {
+	comment := &entities.LabComment{
+		ID:              model.ID,
+		WeaveID:         model.WeaveID,
+		UserID:          model.UserID,
+		ParentCommentID: model.ParentCommentID,
+		Type:            entities.CommentType(model.Type),
+		Content:         model.Content,
+		IsOutdated:      model.IsOutdated,
+		IsResolved:      model.IsResolved,
+		ResolvedBy:      model.ResolvedBy,
+		ResolvedAt:      model.ResolvedAt,
+		LikeCount:       model.LikeCount,
+		EditedAt:        model.EditedAt,
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+	if model.ContentPosition != nil {
+		var anchor diff.Anchor
+		if err := json.Unmarshal([]byte(*model.ContentPosition), &anchor); err == nil && anchor.Path != "" {
+			comment.Anchor = &anchor
+		}
+	}
+	return comment
+}
+
+func (r *commentRepositoryImpl) Create(ctx context.Context, comment *entities.LabComment) error {
+	model := &models.LabComment{
+		ID:              comment.ID,
+		UserID:          comment.UserID,
+		WeaveID:         comment.WeaveID,
+		ParentCommentID: comment.ParentCommentID,
+		Type:            models.CommentType(comment.Type),
+		Content:         comment.Content,
+		CreatedAt:       comment.CreatedAt,
+		UpdatedAt:       comment.UpdatedAt,
+	}
+	if comment.Anchor != nil {
+		position, err := json.Marshal(comment.Anchor)
+		if err != nil {
+			return err
+		}
+		serializedPosition := string(position)
+		model.ContentPosition = &serializedPosition
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		metadata, err := json.Marshal(map[string]interface{}{
+			"comment_id": model.ID,
+			"type":       model.Type,
+		})
+		if err != nil {
+			return err
+		}
+		serializedMetadata := string(metadata)
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:   model.WeaveID,
+			UserID:    model.UserID,
+			EventType: models.TimelineCommentAdded,
+			Title:     "Comment added",
+			Metadata:  &serializedMetadata,
+		}
+		return tx.Create(timeline).Error
+	})
+}
+
+func (r *commentRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.LabComment, error) {
+	var model models.LabComment
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return commentModelToEntity(&model), nil
+}
+
+// commentRow is a lab comment together with the number of its direct replies
+type commentRow struct {
+	models.LabComment
+	ReplyCount int
+}
+
+func (r *commentRepositoryImpl) GetByWeave(ctx context.Context, weaveID uuid.UUID, filter repositories.CommentFilter, limit, offset int) ([]*entities.LabComment, error) {
+	var rows []commentRow
+	err := r.filtered(ctx, weaveID, filter).
+		Select("lab_comments.*, (SELECT COUNT(*) FROM lab_comments replies WHERE replies.parent_comment_id = lab_comments.id) AS reply_count").
+		Order("created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*entities.LabComment, len(rows))
+	for i := range rows {
+		comments[i] = commentModelToEntity(&rows[i].LabComment)
+		comments[i].ReplyCount = rows[i].ReplyCount
+	}
+	return comments, nil
+}
+
+func (r *commentRepositoryImpl) CountByWeave(ctx context.Context, weaveID uuid.UUID, filter repositories.CommentFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, weaveID, filter).Count(&count).Error
+	return count, err
+}
+
+// filtered restricts the query to the comments of a weave that match the filter
+func (r *commentRepositoryImpl) filtered(ctx context.Context, weaveID uuid.UUID, filter repositories.CommentFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&models.LabComment{}).Where("weave_id = ?", weaveID)
+	if filter.AnchorPath != nil {
+		// A path matches itself and everything below it, but not siblings sharing a prefix
+		path := strings.TrimSuffix(*filter.AnchorPath, "/")
+		query = query.Where(`(content_position->>'path' = ? OR content_position->>'path' LIKE ? ESCAPE '\')`, path, likeEscaper.Replace(path)+"/%")
+	}
+	if filter.Type != nil {
+		query = query.Where("type = ?", *filter.Type)
+	}
+	if filter.IsResolved != nil {
+		query = query.Where("is_resolved = ?", *filter.IsResolved)
+	}
+	if filter.IsOutdated != nil {
+		query = query.Where("is_outdated = ?", *filter.IsOutdated)
+	}
+	if filter.ThreadsOnly {
+		query = query.Where("parent_comment_id IS NULL")
+	}
+	return query
+}
+
+func (r *commentRepositoryImpl) CountOpenThreads(ctx context.Context, weaveID uuid.UUID) (map[entities.CommentType]int, error) {
+	var rows []struct {
+		Type  models.CommentType
+		Count int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.LabComment{}).
+		Select("type, COUNT(*) AS count").
+		Where("weave_id = ? AND parent_comment_id IS NULL AND is_resolved = ?", weaveID, false).
+		Group("type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entities.CommentType]int, len(rows))
+	for _, row := range rows {
+		counts[entities.CommentType(row.Type)] = row.Count
+	}
+	return counts, nil
+}
+
+// Threads
+
+func (r *commentRepositoryImpl) GetDepth(ctx context.Context, id uuid.UUID) (int, error) {
+	var depth int
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE chain AS (
+			SELECT c.id, c.parent_comment_id, 0 AS depth
+			FROM lab_comments c
+			WHERE c.id = ?
+			UNION ALL
+			SELECT p.id, p.parent_comment_id, chain.depth + 1
+			FROM lab_comments p
+			JOIN chain ON p.id = chain.parent_comment_id
+			WHERE chain.depth <= ?
+		)
+		SELECT COALESCE(MAX(depth), 0) FROM chain
+	`, id, entities.MaxCommentDepth).Scan(&depth).Error
+	return depth, err
+}
+
+func (r *commentRepositoryImpl) GetReplies(ctx context.Context, id uuid.UUID, maxDepth int) ([]*entities.LabComment, error) {
+	var commentModels []models.LabComment
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE thread AS (
+			SELECT c.id, 1 AS depth
+			FROM lab_comments c
+			WHERE c.parent_comment_id = ?
+			UNION ALL
+			SELECT c.id, thread.depth + 1
+			FROM lab_comments c
+			JOIN thread ON c.parent_comment_id = thread.id
+			WHERE thread.depth < ?
+		)
+		SELECT lab_comments.*
+		FROM lab_comments
+		JOIN thread ON thread.id = lab_comments.id
+		ORDER BY lab_comments.created_at ASC
+	`, id, maxDepth).Scan(&commentModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*entities.LabComment, len(commentModels))
+	for i := range commentModels {
+		comments[i] = commentModelToEntity(&commentModels[i])
+	}
+	return comments, nil
+}
+
+func (r *commentRepositoryImpl) UpdateResolution(ctx context.Context, comment *entities.LabComment) error {
+	return r.db.WithContext(ctx).
+		Model(&models.LabComment{}).
+		Where("id = ?", comment.ID).
+		Updates(map[string]interface{}{
+			"is_resolved": comment.IsResolved,
+			"resolved_by": comment.ResolvedBy,
+			"resolved_at": comment.ResolvedAt,
+		}).Error
+}
+
+// Edit history
+
+func (r *commentRepositoryImpl) Edit(ctx context.Context, comment *entities.LabComment, revision *entities.CommentRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		model := &models.LabCommentRevision{
+			ID:        revision.ID,
+			CommentID: revision.CommentID,
+			Content:   revision.Content,
+			CreatedAt: revision.CreatedAt,
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.LabComment{}).
+			Where("id = ?", comment.ID).
+			Updates(map[string]interface{}{
+				"content":   comment.Content,
+				"edited_at": comment.EditedAt,
+			}).Error
+	})
+}
+
+func (r *commentRepositoryImpl) GetRevisions(ctx context.Context, commentID uuid.UUID) ([]*entities.CommentRevision, error) {
+	var revisionModels []models.LabCommentRevision
+	err := r.db.WithContext(ctx).
+		Where("comment_id = ?", commentID).
+		Order("created_at DESC").
+		Find(&revisionModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*entities.CommentRevision, len(revisionModels))
+	for i, model := range revisionModels {
+		revisions[i] = &entities.CommentRevision{
+			ID:        model.ID,
+			CommentID: model.CommentID,
+			Content:   model.Content,
+			CreatedAt: model.CreatedAt,
+		}
+	}
+	return revisions, nil
+}
+
+// Likes
+
+func (r *commentRepositoryImpl) Like(ctx context.Context, commentID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		like := &models.LabCommentLike{
+			CommentID: commentID,
+			UserID:    userID,
+		}
+		if err := tx.Create(like).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.LabComment{}).
+			Where("id = ?", commentID).
+			UpdateColumn("like_count", gorm.Expr("like_count + 1")).Error
+	})
+}
+
+func (r *commentRepositoryImpl) Unlike(ctx context.Context, commentID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("comment_id = ? AND user_id = ?", commentID, userID).Delete(&models.LabCommentLike{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.LabComment{}).
+			Where("id = ? AND like_count > 0", commentID).
+			UpdateColumn("like_count", gorm.Expr("like_count - 1")).Error
+	})
+}
+
+func (r *commentRepositoryImpl) IsLiked(ctx context.Context, commentID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.LabCommentLike{}).
+		Where("comment_id = ? AND user_id = ?", commentID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,562 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/diff"
+	"weave-module/models"
+)
+
+// openContributionStatuses are the statuses of contributions that may still be merged
+var openContributionStatuses = []models.ContributionStatus{
+	models.ContributionStatusPending,
+	models.ContributionStatusReviewing,
+	models.ContributionStatusAccepted,
+}
+
+// contributionRepositoryImpl implements the ContributionRepository interface
+type contributionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewContributionRepository creates a new contribution repository implementation
+func NewContributionRepository() repositories.ContributionRepository {
+	return &contributionRepositoryImpl{
+		db: database.GetDB(),
+	}
+}
+
+// Convert between database model and domain entity
+func (r *contributionRepositoryImpl) modelToEntity(model *models.Contribution) *entities.Contribution {
+	contribution := &entities.Contribution{
+		ID:            model.ID,
+		UserID:        model.UserID,
+		WeaveID:       model.WeaveID,
+		Type:          entities.ContributionType(model.Type),
+		Title:         model.Title,
+		Description:   model.Description,
+		Status:        entities.ContributionStatus(model.Status),
+		SourceWeaveID: model.SourceWeaveID,
+		ReviewerID:    model.ReviewerID,
+		ReviewedAt:    model.ReviewedAt,
+		ReviewComment: model.ReviewComment,
+		MergedBy:      model.MergedBy,
+		MergedAt:      model.MergedAt,
+		VoteScore:     model.VoteScore,
+		Priority:      model.Priority,
+		ProposedAt:    model.CreatedAt,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+	if model.ProposedAt != nil {
+		contribution.ProposedAt = *model.ProposedAt
+	}
+
+	if model.OriginalContent != nil {
+		content := unmarshalWeaveContent(*model.OriginalContent)
+		contribution.OriginalContent = &content
+	}
+	if model.ProposedContent != nil {
+		content := unmarshalWeaveContent(*model.ProposedContent)
+		contribution.ProposedContent = &content
+	}
+	if model.ContentDiff != nil {
+		if contentDiff, err := diff.Parse(*model.ContentDiff); err == nil {
+			contribution.ContentDiff = contentDiff
+		}
+	}
+
+	return contribution
+}
+
+// Create operations
+
+// Create stores a new contribution, counts it on the target weave and records it in the timeline
+func (r *contributionRepositoryImpl) Create(ctx context.Context, contribution *entities.Contribution) error {
+	model := &models.Contribution{
+		ID:            contribution.ID,
+		UserID:        contribution.UserID,
+		WeaveID:       contribution.WeaveID,
+		Type:          models.ContributionType(contribution.Type),
+		Title:         contribution.Title,
+		Description:   contribution.Description,
+		SourceWeaveID: contribution.SourceWeaveID,
+		ProposedAt:    &contribution.ProposedAt,
+		Status:        models.ContributionStatus(contribution.Status),
+		Priority:      contribution.Priority,
+		CreatedAt:     contribution.CreatedAt,
+		UpdatedAt:     contribution.UpdatedAt,
+	}
+
+	if contribution.OriginalContent != nil {
+		data, err := marshalWeaveContent(*contribution.OriginalContent)
+		if err != nil {
+			return err
+		}
+		model.OriginalContent = &data
+	}
+	if contribution.ProposedContent != nil {
+		data, err := marshalWeaveContent(*contribution.ProposedContent)
+		if err != nil {
+			return err
+		}
+		model.ProposedContent = &data
+	}
+	if contribution.ContentDiff != nil {
+		data, err := contribution.ContentDiff.JSON()
+		if err != nil {
+			return err
+		}
+		model.ContentDiff = &data
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&models.Weave{}).Where("id = ?", model.WeaveID).
+			UpdateColumn("contribution_count", gorm.Expr("contribution_count + ?", 1)).Error
+		if err != nil {
+			return err
+		}
+
+		metadata, err := json.Marshal(map[string]interface{}{
+			"contribution_id": model.ID,
+			"type":            model.Type,
+			"source_weave_id": model.SourceWeaveID,
+		})
+		if err != nil {
+			return err
+		}
+		serializedMetadata := string(metadata)
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:     model.WeaveID,
+			UserID:      model.UserID,
+			EventType:   models.TimelineContributionAdded,
+			Title:       contributionAddedTitle(contribution),
+			Description: &model.Title,
+			Metadata:    &serializedMetadata,
+		}
+		return tx.Create(timeline).Error
+	})
+}
+
+// Read operations
+func (r *contributionRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.Contribution, error) {
+	var model models.Contribution
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelToEntity(&model), nil
+}
+
+func (r *contributionRepositoryImpl) HasOpenFromSource(ctx context.Context, sourceWeaveID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Contribution{}).
+		Where("source_weave_id = ? AND status IN ?", sourceWeaveID, openContributionStatuses).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetMergedVersions maps weave versions created by merges to the contribution merged.
+// The link is only recorded in the timeline events written by Merge.
+func (r *contributionRepositoryImpl) GetMergedVersions(ctx context.Context, weaveID uuid.UUID) (map[int]uuid.UUID, error) {
+	var events []models.WeaveTimeline
+	err := r.db.WithContext(ctx).
+		Where("weave_id = ? AND event_type = ?", weaveID, models.TimelineContributionMerged).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[int]uuid.UUID, len(events))
+	for _, event := range events {
+		if event.Metadata == nil {
+			continue
+		}
+		var metadata struct {
+			ContributionID uuid.UUID `json:"contribution_id"`
+			Version        int       `json:"version"`
+		}
+		if err := json.Unmarshal([]byte(*event.Metadata), &metadata); err != nil || metadata.Version == 0 {
+			continue
+		}
+		merged[metadata.Version] = metadata.ContributionID
+	}
+	return merged, nil
+}
+
+// Update operations
+func (r *contributionRepositoryImpl) Update(ctx context.Context, contribution *entities.Contribution) error {
+	updates, err := contributionUpdates(contribution)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Model(&models.Contribution{}).Where("id = ?", contribution.ID).Updates(updates).Error
+}
+
+// contributionUpdates returns the columns Update writes
+func contributionUpdates(contribution *entities.Contribution) (map[string]interface{}, error) {
+	updates := map[string]interface{}{
+		"title":       contribution.Title,
+		"description": contribution.Description,
+		"proposed_at": contribution.ProposedAt,
+		"status":      models.ContributionStatus(contribution.Status),
+		"priority":    contribution.Priority,
+		"updated_at":  contribution.UpdatedAt,
+	}
+
+	contents := map[string]*entities.WeaveContent{
+		"original_content": contribution.OriginalContent,
+		"proposed_content": contribution.ProposedContent,
+	}
+	for column, content := range contents {
+		if content == nil {
+			updates[column] = nil
+			continue
+		}
+		data, err := marshalWeaveContent(*content)
+		if err != nil {
+			return nil, err
+		}
+		updates[column] = data
+	}
+
+	updates["content_diff"] = nil
+	if contribution.ContentDiff != nil {
+		data, err := contribution.ContentDiff.JSON()
+		if err != nil {
+			return nil, err
+		}
+		updates["content_diff"] = data
+	}
+	return updates, nil
+}
+
+func (r *contributionRepositoryImpl) AssignReviewer(ctx context.Context, contribution *entities.Contribution) error {
+	return r.db.WithContext(ctx).Model(&models.Contribution{}).Where("id = ?", contribution.ID).Updates(map[string]interface{}{
+		"reviewer_id": contribution.ReviewerID,
+		"status":      models.ContributionStatus(contribution.Status),
+		"updated_at":  contribution.UpdatedAt,
+	}).Error
+}
+
+// Review operations
+func (r *contributionRepositoryImpl) AddReview(ctx context.Context, contribution *entities.Contribution, review *entities.ContributionReview) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := createReview(tx, review); err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Contribution{}).Where("id = ?", contribution.ID).Updates(map[string]interface{}{
+			"status":         models.ContributionStatus(contribution.Status),
+			"reviewer_id":    contribution.ReviewerID,
+			"reviewed_at":    contribution.ReviewedAt,
+			"review_comment": contribution.ReviewComment,
+			"updated_at":     contribution.UpdatedAt,
+		}).Error
+	})
+}
+
+func createReview(tx *gorm.DB, review *entities.ContributionReview) error {
+	return tx.Create(&models.ContributionReview{
+		ID:             review.ID,
+		ContributionID: review.ContributionID,
+		ReviewerID:     review.ReviewerID,
+		Decision:       models.ReviewDecision(review.Decision),
+		Comment:        review.Comment,
+		CreatedAt:      review.CreatedAt,
+	}).Error
+}
+
+func (r *contributionRepositoryImpl) GetReviews(ctx context.Context, contributionID uuid.UUID) ([]*entities.ContributionReview, error) {
+	var reviewModels []models.ContributionReview
+	err := r.db.WithContext(ctx).
+		Where("contribution_id = ?", contributionID).
+		Order("created_at ASC").
+		Find(&reviewModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*entities.ContributionReview, len(reviewModels))
+	for i, model := range reviewModels {
+		reviews[i] = &entities.ContributionReview{
+			ID:             model.ID,
+			ContributionID: model.ContributionID,
+			ReviewerID:     model.ReviewerID,
+			Decision:       entities.ReviewDecision(model.Decision),
+			Comment:        model.Comment,
+			CreatedAt:      model.CreatedAt,
+		}
+	}
+	return reviews, nil
+}
+
+// Vote operations
+
+// Vote locks the contribution so concurrent votes apply their score changes one at a time
+func (r *contributionRepositoryImpl) Vote(ctx context.Context, contributionID, userID uuid.UUID, vote entities.VoteType) (int, error) {
+	var score int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contribution, err := lockContribution(tx, contributionID)
+		if err != nil {
+			return err
+		}
+		score = contribution.VoteScore
+
+		var existing models.ContributionVote
+		err = tx.Where("contribution_id = ? AND user_id = ?", contributionID, userID).First(&existing).Error
+		switch {
+		case err == nil:
+			previous := entities.VoteType(existing.VoteType)
+			if previous == vote {
+				return nil
+			}
+			if err := tx.Model(&existing).Update("vote_type", string(vote)).Error; err != nil {
+				return err
+			}
+			score += vote.Value() - previous.Value()
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			created := &models.ContributionVote{
+				ContributionID: contributionID,
+				UserID:         userID,
+				VoteType:       string(vote),
+			}
+			if err := tx.Create(created).Error; err != nil {
+				return err
+			}
+			score += vote.Value()
+		default:
+			return err
+		}
+
+		return tx.Model(&models.Contribution{}).Where("id = ?", contributionID).UpdateColumn("vote_score", score).Error
+	})
+	return score, err
+}
+
+func (r *contributionRepositoryImpl) RemoveVote(ctx context.Context, contributionID, userID uuid.UUID) (int, error) {
+	var score int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contribution, err := lockContribution(tx, contributionID)
+		if err != nil {
+			return err
+		}
+
+		var existing models.ContributionVote
+		err = tx.Where("contribution_id = ? AND user_id = ?", contributionID, userID).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repositories.ErrVoteNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(&existing).Error; err != nil {
+			return err
+		}
+
+		score = contribution.VoteScore - entities.VoteType(existing.VoteType).Value()
+		return tx.Model(&models.Contribution{}).Where("id = ?", contributionID).UpdateColumn("vote_score", score).Error
+	})
+	return score, err
+}
+
+// lockContribution reads a contribution and holds its row until the transaction ends
+func lockContribution(tx *gorm.DB, contributionID uuid.UUID) (*models.Contribution, error) {
+	var contribution models.Contribution
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "vote_score").
+		Where("id = ?", contributionID).
+		First(&contribution).Error
+	if err != nil {
+		return nil, err
+	}
+	return &contribution, nil
+}
+
+// Merge operations
+func (r *contributionRepositoryImpl) Merge(ctx context.Context, contributionID, mergedBy uuid.UUID, content entities.WeaveContent, expectedVersion int, review *entities.ContributionReview) (*entities.WeaveVersion, error) {
+	data, err := marshalWeaveContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var created models.WeaveVersion
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Holding the contribution row serializes concurrent merges, rejections and rebases
+		var contribution models.Contribution
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", contributionID).
+			First(&contribution).Error
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(openContributionStatuses, contribution.Status) {
+			return repositories.ErrContributionNotOpen
+		}
+
+		var weave models.Weave
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status <> ?", contribution.WeaveID, models.WeaveStatusDeleted).
+			First(&weave).Error
+		if err != nil {
+			return err
+		}
+		if weave.Version != expectedVersion {
+			return repositories.ErrWeaveVersionConflict
+		}
+
+		contentDiff, err := diff.Compare(unmarshalWeaveContent(weave.Content), content)
+		if err != nil {
+			return err
+		}
+		serializedDiff, err := contentDiff.JSON()
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		err = tx.Model(&models.Weave{}).Where("id = ?", weave.ID).Updates(map[string]interface{}{
+			"content":    data,
+			"version":    weave.Version + 1,
+			"updated_at": now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// The new version is credited to the contributor, not the merging owner
+		changeLog := "Merged contribution: " + contribution.Title
+		if contribution.SourceWeaveID != nil {
+			changeLog = "Merged fork: " + contribution.Title
+		} else if contribution.Type == models.ContributionTypeSuggestion {
+			changeLog = "Accepted suggestion: " + contribution.Title
+		}
+		created = models.WeaveVersion{
+			WeaveID:     weave.ID,
+			UserID:      contribution.UserID,
+			Version:     weave.Version + 1,
+			Title:       weave.Title,
+			Description: weave.Description,
+			Content:     data,
+			ChangeLog:   &changeLog,
+			ContentDiff: &serializedDiff,
+		}
+		if err := tx.Create(&created).Error; err != nil {
+			return err
+		}
+		if err := database.ReanchorComments(tx, weave.ID, weave.Content, data, created.Version); err != nil {
+			return err
+		}
+
+		if review != nil {
+			if err := createReview(tx, review); err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(&models.Contribution{}).Where("id = ?", contribution.ID).Updates(map[string]interface{}{
+			"status":     models.ContributionStatusMerged,
+			"merged_by":  mergedBy,
+			"merged_at":  now,
+			"updated_at": now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		metadata, err := json.Marshal(map[string]interface{}{
+			"contribution_id": contribution.ID,
+			"version":         created.Version,
+			"merged_by":       mergedBy,
+			"source_weave_id": contribution.SourceWeaveID,
+		})
+		if err != nil {
+			return err
+		}
+		serializedMetadata := string(metadata)
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:     weave.ID,
+			UserID:      contribution.UserID,
+			EventType:   models.TimelineContributionMerged,
+			Title:       contributionMergedTitle(&contribution),
+			Description: &contribution.Title,
+			Metadata:    &serializedMetadata,
+		}
+		return tx.Create(timeline).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versionModelToEntity(&created), nil
+}
+func (r *contributionRepositoryImpl) Rebase(ctx context.Context, contribution *entities.Contribution, expectedVersion int) error {
+	updates, err := contributionUpdates(contribution)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Same lock order as Merge: the contribution row, then the weave row
+		var current models.Contribution
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", contribution.ID).
+			First(&current).Error
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(openContributionStatuses, current.Status) {
+			return repositories.ErrContributionNotOpen
+		}
+
+		var weave models.Weave
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status <> ?", current.WeaveID, models.WeaveStatusDeleted).
+			First(&weave).Error
+		if err != nil {
+			return err
+		}
+		if weave.Version != expectedVersion {
+			return repositories.ErrWeaveVersionConflict
+		}
+
+		return tx.Model(&models.Contribution{}).Where("id = ?", contribution.ID).Updates(updates).Error
+	})
+}
+
+func contributionAddedTitle(contribution *entities.Contribution) string {
+	if contribution.IsMergeRequest() {
+		return "Merge request opened"
+	}
+	if contribution.IsSuggestion() {
+		return "Suggestion added"
+	}
+	return "Contribution added"
+}
+
+// Merge requests are credited as forks merged back, so the timeline names the source
+func contributionMergedTitle(contribution *models.Contribution) string {
+	if contribution.SourceWeaveID != nil {
+		return "Fork merged"
+	}
+	if contribution.Type == models.ContributionTypeSuggestion {
+		return "Suggestion accepted"
+	}
+	return "Contribution merged"
+}
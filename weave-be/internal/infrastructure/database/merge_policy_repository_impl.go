@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/models"
+)
+
+// mergePolicyRepositoryImpl implements the MergePolicyRepository interface
+type mergePolicyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewMergePolicyRepository creates a new merge policy repository implementation
+func NewMergePolicyRepository() repositories.MergePolicyRepository {
+	return &mergePolicyRepositoryImpl{
+		db: database.GetDB(),
+	}
+}
+
+func (r *mergePolicyRepositoryImpl) GetByWeave(ctx context.Context, weaveID uuid.UUID) (*entities.MergePolicy, error) {
+	var model models.WeaveMergePolicy
+	if err := r.db.WithContext(ctx).Where("weave_id = ?", weaveID).First(&model).Error; err != nil {
+		return nil, err
+	}
+
+	return &entities.MergePolicy{
+		WeaveID:                 model.WeaveID,
+		RequireOwnerApproval:    model.RequireOwnerApproval,
+		RequiredApprovals:       model.RequiredApprovals,
+		MinVoteScore:            model.MinVoteScore,
+		BlockOnChangesRequested: model.BlockOnChangesRequested,
+		UpdatedBy:               &model.UpdatedBy,
+		UpdatedAt:               &model.UpdatedAt,
+	}, nil
+}
+
+func (r *mergePolicyRepositoryImpl) Save(ctx context.Context, policy *entities.MergePolicy) error {
+	model := &models.WeaveMergePolicy{
+		WeaveID:                 policy.WeaveID,
+		RequireOwnerApproval:    policy.RequireOwnerApproval,
+		RequiredApprovals:       policy.RequiredApprovals,
+		MinVoteScore:            policy.MinVoteScore,
+		BlockOnChangesRequested: policy.BlockOnChangesRequested,
+		UpdatedBy:               *policy.UpdatedBy,
+		UpdatedAt:               *policy.UpdatedAt,
+	}
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "weave_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"require_owner_approval", "required_approvals", "min_vote_score",
+			"block_on_changes_requested", "updated_by", "updated_at",
+		}),
+	}).Create(model).Error
+}
@@ -0,0 +1,248 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/models"
+)
+
+// publishedTagUses counts the published weaves carrying the tag of the outer query
+const publishedTagUses = `SELECT COUNT(*) FROM weave_tag_relations
+	JOIN weaves ON weaves.id = weave_tag_relations.weave_id
+	WHERE weave_tag_relations.weave_tag_id = weave_tags.id AND weaves.status = ?`
+
+// tagRepositoryImpl implements the TagRepository interface
+type tagRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTagRepository creates a new tag repository implementation
+func NewTagRepository() repositories.TagRepository {
+	return &tagRepositoryImpl{
+		db: database.GetDB(),
+	}
+}
+
+// tagRow is a tag with the number of published weaves carrying it
+type tagRow struct {
+	models.WeaveTag
+	WeaveCount   int
+	SharedWeaves int
+}
+
+func tagRowToEntity(row *tagRow) *entities.Tag {
+	return &entities.Tag{
+		ID:          row.ID,
+		Name:        row.Name,
+		Description: row.Description,
+		WeaveCount:  row.WeaveCount,
+		CreatedAt:   row.CreatedAt,
+	}
+}
+
+// withWeaveCount selects tags along with the number of published weaves carrying each
+func (r *tagRepositoryImpl) withWeaveCount(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&models.WeaveTag{}).
+		Select("weave_tags.*, ("+publishedTagUses+") AS weave_count", models.WeaveStatusPublished)
+}
+
+func (r *tagRepositoryImpl) SetWeaveTags(ctx context.Context, weaveID uuid.UUID, names []string) ([]string, error) {
+	var attached []string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		attached, err = replaceWeaveTags(tx, weaveID, names)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return attached, nil
+}
+
+// replaceWeaveTags swaps the tags of a weave for the named ones inside tx,
+// creating the tags that do not exist yet
+func replaceWeaveTags(tx *gorm.DB, weaveID uuid.UUID, names []string) ([]string, error) {
+	names, err := resolveTagAliases(tx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Exec("DELETE FROM weave_tag_relations WHERE weave_id = ?", weaveID).Error; err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return names, nil
+	}
+
+	// The unique index on name settles concurrent creation of the same tag
+	created := make([]models.WeaveTag, len(names))
+	for i, name := range names {
+		created[i] = models.WeaveTag{Name: name}
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&created).Error; err != nil {
+		return nil, err
+	}
+
+	var tags []models.WeaveTag
+	if err := tx.Where("name IN ?", names).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		err := tx.Exec("INSERT INTO weave_tag_relations (weave_id, weave_tag_id) VALUES (?, ?) ON CONFLICT DO NOTHING", weaveID, tag.ID).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+// resolveTagAliases replaces names retired by merges with the tags they were merged into
+func resolveTagAliases(tx *gorm.DB, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return names, nil
+	}
+
+	var aliases []struct {
+		Alias string
+		Name  string
+	}
+	err := tx.Model(&models.WeaveTagAlias{}).
+		Select("weave_tag_aliases.alias, weave_tags.name").
+		Joins("JOIN weave_tags ON weave_tags.id = weave_tag_aliases.tag_id").
+		Where("weave_tag_aliases.alias IN ?", names).
+		Scan(&aliases).Error
+	if err != nil {
+		return nil, err
+	}
+
+	canonical := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		canonical[alias.Alias] = alias.Name
+	}
+
+	resolved := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if target, ok := canonical[name]; ok {
+			name = target
+		}
+		if !seen[name] {
+			seen[name] = true
+			resolved = append(resolved, name)
+		}
+	}
+	return resolved, nil
+}
+
+func (r *tagRepositoryImpl) GetByName(ctx context.Context, name string) (*entities.Tag, error) {
+	aliased := r.db.Model(&models.WeaveTagAlias{}).Select("tag_id").Where("alias = ?", name)
+
+	var rows []tagRow
+	err := r.withWeaveCount(ctx).
+		Where("weave_tags.name = ? OR weave_tags.id IN (?)", name, aliased).
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return tagRowToEntity(&rows[0]), nil
+}
+
+func (r *tagRepositoryImpl) Autocomplete(ctx context.Context, prefix string, extra []string, limit int) ([]*entities.Tag, error) {
+	// Normalized names hold no LIKE wildcards, so the prefix needs no escaping
+	var rows []tagRow
+	err := r.withWeaveCount(ctx).
+		Where("weave_tags.name LIKE ? OR weave_tags.name IN ?", prefix+"%", extra).
+		Where("("+publishedTagUses+") > 0", models.WeaveStatusPublished).
+		Order("weave_count DESC, weave_tags.name ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entities.Tag, len(rows))
+	for i := range rows {
+		tags[i] = tagRowToEntity(&rows[i])
+	}
+	return tags, nil
+}
+
+func (r *tagRepositoryImpl) GetRelated(ctx context.Context, tagID uuid.UUID, limit int) ([]*entities.RelatedTag, error) {
+	var rows []tagRow
+	err := r.db.WithContext(ctx).
+		Table("weave_tag_relations AS own").
+		Select("weave_tags.*, COUNT(*) AS shared_weaves").
+		Joins("JOIN weaves ON weaves.id = own.weave_id AND weaves.status = ?", models.WeaveStatusPublished).
+		Joins("JOIN weave_tag_relations AS other ON other.weave_id = own.weave_id AND other.weave_tag_id <> own.weave_tag_id").
+		Joins("JOIN weave_tags ON weave_tags.id = other.weave_tag_id").
+		Where("own.weave_tag_id = ?", tagID).
+		Group("weave_tags.id").
+		Order("shared_weaves DESC, weave_tags.name ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	related := make([]*entities.RelatedTag, len(rows))
+	for i := range rows {
+		related[i] = &entities.RelatedTag{
+			Tag:          tagRowToEntity(&rows[i]),
+			SharedWeaves: rows[i].SharedWeaves,
+		}
+	}
+	return related, nil
+}
+
+func (r *tagRepositoryImpl) Merge(ctx context.Context, source, target *entities.Tag) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Weaves already carrying both tags keep a single relation
+		err := tx.Exec(`INSERT INTO weave_tag_relations (weave_id, weave_tag_id)
+			SELECT weave_id, ? FROM weave_tag_relations WHERE weave_tag_id = ?
+			ON CONFLICT DO NOTHING`, target.ID, source.ID).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM weave_tag_relations WHERE weave_tag_id = ?", source.ID).Error; err != nil {
+			return err
+		}
+
+		// Names that pointed at the source now point at the target, and so does the source's own
+		err = tx.Model(&models.WeaveTagAlias{}).
+			Where("tag_id = ?", source.ID).
+			Update("tag_id", target.ID).Error
+		if err != nil {
+			return err
+		}
+		alias := &models.WeaveTagAlias{
+			Alias: source.Name,
+			TagID: target.ID,
+		}
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "alias"}},
+			DoUpdates: clause.AssignmentColumns([]string{"tag_id"}),
+		}).Create(alias).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", source.ID).Delete(&models.WeaveTag{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/database"
+	"weave-module/models"
+)
+
+// templateRepositoryImpl implements the TemplateRepository interface
+type templateRepositoryImpl struct {
+	db     *gorm.DB
+	weaves *weaveRepositoryImpl // Converts the pinned weaves
+}
+
+// NewTemplateRepository creates a new template repository implementation
+func NewTemplateRepository() repositories.TemplateRepository {
+	db := database.GetDB()
+	return &templateRepositoryImpl{
+		db:     db,
+		weaves: &weaveRepositoryImpl{db: db},
+	}
+}
+
+func (r *templateRepositoryImpl) Pin(ctx context.Context, pin *entities.ChannelTemplate) error {
+	model := &models.ChannelTemplate{
+		ID:        pin.ID,
+		ChannelID: pin.ChannelID,
+		WeaveID:   pin.WeaveID,
+		PinnedBy:  pin.PinnedBy,
+		Position:  pin.Position,
+		CreatedAt: pin.CreatedAt,
+	}
+
+	// The unique index on (channel_id, weave_id) settles concurrent pins
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrTemplateAlreadyPinned
+	}
+	return nil
+}
+
+func (r *templateRepositoryImpl) Unpin(ctx context.Context, channelID, weaveID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("channel_id = ? AND weave_id = ?", channelID, weaveID).
+		Delete(&models.ChannelTemplate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *templateRepositoryImpl) ListPinned(ctx context.Context, channelID uuid.UUID) ([]*entities.ChannelTemplate, error) {
+	var pinModels []models.ChannelTemplate
+	err := r.db.WithContext(ctx).
+		Preload("Weave").
+		Joins("JOIN weaves ON weaves.id = channel_templates.weave_id").
+		Where("channel_templates.channel_id = ?", channelID).
+		Where("weaves.status = ? AND weaves.is_template = ?", models.WeaveStatusPublished, true).
+		Order("channel_templates.position ASC, channel_templates.created_at ASC").
+		Find(&pinModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pins := make([]*entities.ChannelTemplate, len(pinModels))
+	for i := range pinModels {
+		model := &pinModels[i]
+		pins[i] = &entities.ChannelTemplate{
+			ID:        model.ID,
+			ChannelID: model.ChannelID,
+			WeaveID:   model.WeaveID,
+			PinnedBy:  model.PinnedBy,
+			Position:  model.Position,
+			Weave:     r.weaves.modelToEntity(&model.Weave),
+			CreatedAt: model.CreatedAt,
+		}
+	}
+	return pins, nil
+}
+
+func (r *templateRepositoryImpl) CountPinned(ctx context.Context, channelID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.ChannelTemplate{}).
+		Where("channel_id = ?", channelID).
+		Count(&count).Error
+	return count, err
+}
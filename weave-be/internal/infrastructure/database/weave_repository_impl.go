@@ -0,0 +1,1258 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+	"weave-module/bundle"
+	"weave-module/contentschema"
+	"weave-module/database"
+	"weave-module/diff"
+	"weave-module/models"
+)
+
+// unpublishedStatuses are the statuses listed as a user's drafts
+var unpublishedStatuses = []models.WeaveStatus{models.WeaveStatusDraft, models.WeaveStatusInReview}
+
+// weaveRepositoryImpl implements the WeaveRepository interface
+type weaveRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewWeaveRepository creates a new weave repository implementation
+func NewWeaveRepository() repositories.WeaveRepository {
+	return &weaveRepositoryImpl{
+		db: database.GetDB(),
+	}
+}
+
+// Convert between domain entity and database model
+func (r *weaveRepositoryImpl) entityToModel(weave *entities.Weave) (*models.Weave, error) {
+	content, err := marshalWeaveContent(weave.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.WeaveStatus(weave.Status)
+	if status == "" {
+		status = models.WeaveStatusDraft
+	}
+
+	weaveType := models.WeaveTypeOriginal
+	if weave.IsForked() {
+		weaveType = models.WeaveTypeFork
+	}
+
+	return &models.Weave{
+		ID:              weave.ID,
+		UserID:          weave.UserID,
+		ChannelID:       weave.ChannelID,
+		Title:           weave.Title,
+		Description:     weave.Description,
+		CoverImage:      weave.CoverImage,
+		Content:         content,
+		Status:          status,
+		Type:            weaveType,
+		PublishedAt:     weave.PublishedAt,
+		PublishAt:       weave.PublishAt,
+		TrashedAt:       weave.TrashedAt,
+		PreviousStatus:  (*models.WeaveStatus)(weave.PreviousStatus),
+		Version:         weave.Version,
+		ParentWeaveID:   weave.ParentWeaveID,
+		OriginalWeaveID: weave.OriginalWeaveID,
+		UpstreamVersion: weave.UpstreamVersion,
+		SyncedVersion:   weave.SyncedVersion,
+		IsFeatured:      weave.IsFeatured,
+		IsTemplate:      weave.IsTemplate,
+		TemplateID:      weave.TemplateID,
+		TemplateVersion: weave.TemplateVersion,
+		ViewCount:       weave.ViewCount,
+		LikeCount:       weave.LikeCount,
+		ForkCount:       weave.ForkCount,
+		CreatedAt:       weave.CreatedAt,
+		UpdatedAt:       weave.UpdatedAt,
+	}, nil
+}
+
+func (r *weaveRepositoryImpl) modelToEntity(model *models.Weave) *entities.Weave {
+	return &entities.Weave{
+		ID:              model.ID,
+		UserID:          model.UserID,
+		ChannelID:       model.ChannelID,
+		Title:           model.Title,
+		Description:     model.Description,
+		CoverImage:      model.CoverImage,
+		Content:         unmarshalWeaveContent(model.Content),
+		Version:         model.Version,
+		ParentWeaveID:   model.ParentWeaveID,
+		OriginalWeaveID: model.OriginalWeaveID,
+		UpstreamVersion: model.UpstreamVersion,
+		SyncedVersion:   model.SyncedVersion,
+		Status:          entities.WeaveStatus(model.Status),
+		PublishedAt:     model.PublishedAt,
+		PublishAt:       model.PublishAt,
+		TrashedAt:       model.TrashedAt,
+		PreviousStatus:  (*entities.WeaveStatus)(model.PreviousStatus),
+		IsFeatured:      model.IsFeatured,
+		IsTemplate:      model.IsTemplate,
+		TemplateID:      model.TemplateID,
+		TemplateVersion: model.TemplateVersion,
+		ViewCount:       model.ViewCount,
+		LikeCount:       model.LikeCount,
+		ForkCount:       model.ForkCount,
+		Collaborators:   collaboratorModelsToEntities(model.Collaborators),
+		Tags:            tagNames(model.Tags),
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+}
+
+func tagNames(tags []models.WeaveTag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+func (r *weaveRepositoryImpl) modelsToEntities(models []*models.Weave) []*entities.Weave {
+	entities := make([]*entities.Weave, len(models))
+	for i, model := range models {
+		entities[i] = r.modelToEntity(model)
+	}
+	return entities
+}
+
+func versionModelToEntity(model *models.WeaveVersion) *entities.WeaveVersion {
+	version := &entities.WeaveVersion{
+		ID:        model.ID,
+		WeaveID:   model.WeaveID,
+		UserID:    model.UserID,
+		Version:   model.Version,
+		Title:     model.Title,
+		Content:   unmarshalWeaveContent(model.Content),
+		ChangeLog: model.ChangeLog,
+		CreatedAt: model.CreatedAt,
+	}
+	if model.ContentDiff != nil {
+		if contentDiff, err := diff.Parse(*model.ContentDiff); err == nil {
+			version.ContentDiff = contentDiff
+		}
+	}
+	return version
+}
+
+func timelineModelToEntity(model *models.WeaveTimeline) *entities.WeaveTimelineEvent {
+	event := &entities.WeaveTimelineEvent{
+		ID:          model.ID,
+		WeaveID:     model.WeaveID,
+		UserID:      model.UserID,
+		EventType:   string(model.EventType),
+		Title:       model.Title,
+		Description: model.Description,
+		CreatedAt:   model.CreatedAt,
+	}
+	if model.Metadata != nil {
+		// Metadata is informational; an unreadable blob is dropped rather than failing the read
+		_ = json.Unmarshal([]byte(*model.Metadata), &event.Metadata)
+	}
+	return event
+}
+
+func marshalWeaveContent(content entities.WeaveContent) (string, error) {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// unmarshalWeaveContent decodes stored content, upgrading content written against
+// an older schema of its type on the fly. Stored rows are left untouched; the
+// upgrade_weave_content worker task rewrites them in bulk.
+func unmarshalWeaveContent(data string) entities.WeaveContent {
+	var content entities.WeaveContent
+	if err := json.Unmarshal([]byte(data), &content); err != nil {
+		return entities.WeaveContent{}
+	}
+
+	migrator := contentschema.Default()
+	if content.SchemaVersion >= migrator.CurrentVersion(content.Type) {
+		return content
+	}
+	if content.Data == nil {
+		content.Data = map[string]interface{}{}
+	}
+	upgraded, version, err := migrator.Upgrade(content.Type, content.SchemaVersion, content.Data)
+	if err != nil {
+		// Serve the content as stored rather than failing the read
+		log.Printf("Failed to upgrade weave content: %v", err)
+		return content
+	}
+	content.Data = upgraded
+	content.SchemaVersion = version
+	return content
+}
+
+// visible excludes soft deleted weaves
+func (r *weaveRepositoryImpl) visible(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).Where("weaves.status <> ?", models.WeaveStatusDeleted)
+}
+
+// published restricts the query to weaves visible to everyone
+func (r *weaveRepositoryImpl) published(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).Where("weaves.status = ?", models.WeaveStatusPublished)
+}
+
+// Create operations
+func (r *weaveRepositoryImpl) Create(ctx context.Context, weave *entities.Weave) error {
+	model, err := r.entityToModel(weave)
+	if err != nil {
+		return err
+	}
+
+	// Every weave starts its history with version 1
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		version := &models.WeaveVersion{
+			WeaveID:     model.ID,
+			UserID:      model.UserID,
+			Version:     model.Version,
+			Title:       model.Title,
+			Description: model.Description,
+			Content:     model.Content,
+		}
+		if err := tx.Create(version).Error; err != nil {
+			return err
+		}
+
+		if len(weave.Tags) > 0 {
+			tags, err := replaceWeaveTags(tx, model.ID, weave.Tags)
+			if err != nil {
+				return err
+			}
+			weave.Tags = tags
+		}
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:   model.ID,
+			UserID:    model.UserID,
+			EventType: models.TimelineCreated,
+			Title:     "Weave created",
+		}
+		if model.TemplateID != nil {
+			metadata, err := json.Marshal(map[string]interface{}{
+				"template_id":      model.TemplateID,
+				"template_version": model.TemplateVersion,
+			})
+			if err != nil {
+				return err
+			}
+			serializedMetadata := string(metadata)
+			timeline.Title = "Weave created from template"
+			timeline.Metadata = &serializedMetadata
+		}
+		return tx.Create(timeline).Error
+	})
+}
+
+func (r *weaveRepositoryImpl) Fork(ctx context.Context, originalID, newUserID uuid.UUID) (*entities.Weave, error) {
+	var forked *entities.Weave
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var originalModel models.Weave
+		err := tx.Where("id = ? AND status <> ?", originalID, models.WeaveStatusDeleted).First(&originalModel).Error
+		if err != nil {
+			return err
+		}
+
+		original := r.modelToEntity(&originalModel)
+		forked = entities.ForkWeave(original, newUserID)
+
+		model, err := r.entityToModel(forked)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Weave{}).
+			Where("id = ?", originalID).
+			UpdateColumn("fork_count", gorm.Expr("fork_count + 1")).Error
+		if err != nil {
+			return err
+		}
+
+		version := &models.WeaveVersion{
+			WeaveID:     model.ID,
+			UserID:      newUserID,
+			Version:     model.Version,
+			Title:       model.Title,
+			Description: model.Description,
+			Content:     model.Content,
+		}
+		if err := tx.Create(version).Error; err != nil {
+			return err
+		}
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:   originalID,
+			UserID:    newUserID,
+			EventType: models.TimelineForked,
+			Title:     "Weave forked",
+		}
+		return tx.Create(timeline).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return forked, nil
+}
+
+func (r *weaveRepositoryImpl) Import(ctx context.Context, b *bundle.Bundle, userID, channelID uuid.UUID) (*entities.Weave, error) {
+	var model *models.Weave
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		model, err = database.ImportBundle(tx, b, userID, channelID, uuid.New())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.modelToEntity(model), nil
+}
+
+// Read operations
+func (r *weaveRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+	var model models.Weave
+	err := r.visible(ctx).
+		Preload("Collaborators", "status = ?", models.CollaboratorStatusActive).
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("weave_tags.name ASC")
+		}).
+		Where("id = ?", id).
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelToEntity(&model), nil
+}
+
+func (r *weaveRepositoryImpl) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.visible(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) GetByChannelID(ctx context.Context, channelID uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Where("channel_id = ?", channelID).
+		Order("published_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) GetPublished(ctx context.Context, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Order("published_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) GetFeatured(ctx context.Context, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Where("is_featured = ?", true).
+		Order("published_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) GetTemplates(ctx context.Context, channelID *uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.templates(ctx, channelID).
+		Order("(SELECT COUNT(*) FROM weaves AS uses WHERE uses.template_id = weaves.id) DESC, published_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) CountTemplates(ctx context.Context, channelID *uuid.UUID) (int64, error) {
+	var count int64
+	err := r.templates(ctx, channelID).Count(&count).Error
+	return count, err
+}
+
+// templates restricts the query to templates anyone can start from
+func (r *weaveRepositoryImpl) templates(ctx context.Context, channelID *uuid.UUID) *gorm.DB {
+	query := r.published(ctx).Where("weaves.is_template = ?", true)
+	if channelID != nil {
+		query = query.Where("weaves.channel_id = ?", *channelID)
+	}
+	return query
+}
+
+func (r *weaveRepositoryImpl) GetDrafts(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status IN ?", userID, unpublishedStatuses).
+		Order("updated_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+// trashed restricts the query to soft deleted weaves
+func (r *weaveRepositoryImpl) trashed(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).Where("weaves.status = ?", models.WeaveStatusDeleted)
+}
+
+func (r *weaveRepositoryImpl) GetTrashedByID(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+	var model models.Weave
+	err := r.trashed(ctx).
+		Preload("Collaborators", "status = ?", models.CollaboratorStatusActive).
+		Where("id = ?", id).
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelToEntity(&model), nil
+}
+
+func (r *weaveRepositoryImpl) GetTrashed(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.trashed(ctx).
+		Where("user_id = ?", userID).
+		Order("trashed_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) CountTrashed(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.trashed(ctx).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) GetForked(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Where("parent_weave_id = ?", parentID).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+// Update operations
+func (r *weaveRepositoryImpl) Update(ctx context.Context, weave *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+	content, err := marshalWeaveContent(weave.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	var created models.WeaveVersion
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.Weave
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status <> ?", weave.ID, models.WeaveStatusDeleted).
+			First(&current).Error
+		if err != nil {
+			return err
+		}
+		if current.Version != expectedVersion {
+			return repositories.ErrWeaveVersionConflict
+		}
+
+		contentDiff, err := diff.Compare(unmarshalWeaveContent(current.Content), weave.Content)
+		if err != nil {
+			return err
+		}
+		serializedDiff, err := contentDiff.JSON()
+		if err != nil {
+			return err
+		}
+
+		// Publication state and counters have dedicated update methods
+		err = tx.Model(&models.Weave{}).Where("id = ?", weave.ID).Updates(map[string]interface{}{
+			"title":       weave.Title,
+			"description": weave.Description,
+			"cover_image": weave.CoverImage,
+			"content":     content,
+			"version":     weave.Version,
+			"is_featured": weave.IsFeatured,
+			"updated_at":  weave.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// The version is credited to whoever made the edit, owner or collaborator
+		created = models.WeaveVersion{
+			WeaveID:     weave.ID,
+			UserID:      userID,
+			Version:     weave.Version,
+			Title:       weave.Title,
+			Description: weave.Description,
+			Content:     content,
+			ChangeLog:   changeLog,
+			ContentDiff: &serializedDiff,
+		}
+		if err := tx.Create(&created).Error; err != nil {
+			return err
+		}
+		if contentDiff.IsEmpty() {
+			return nil
+		}
+		// Open comments were anchored to the content being replaced
+		return database.ReanchorComments(tx, weave.ID, current.Content, content, created.Version)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versionModelToEntity(&created), nil
+}
+
+func (r *weaveRepositoryImpl) UpdateStatus(ctx context.Context, weave *entities.Weave, from entities.WeaveStatus, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Compare-and-set on the status so concurrent transitions cannot both apply
+		result := tx.Model(&models.Weave{}).
+			Where("id = ? AND status = ?", weave.ID, models.WeaveStatus(from)).
+			Updates(map[string]interface{}{
+				"status":          models.WeaveStatus(weave.Status),
+				"published_at":    weave.PublishedAt,
+				"publish_at":      weave.PublishAt,
+				"trashed_at":      weave.TrashedAt,
+				"previous_status": (*models.WeaveStatus)(weave.PreviousStatus),
+				"updated_at":      weave.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repositories.ErrWeaveStatusConflict
+		}
+
+		metadata, err := json.Marshal(map[string]interface{}{
+			"from": from,
+			"to":   weave.Status,
+		})
+		if err != nil {
+			return err
+		}
+		serializedMetadata := string(metadata)
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:   weave.ID,
+			UserID:    userID,
+			EventType: models.TimelineStatusChanged,
+			Title:     fmt.Sprintf("Status changed to %s", weave.Status),
+			Metadata:  &serializedMetadata,
+		}
+		return tx.Create(timeline).Error
+	})
+}
+
+func (r *weaveRepositoryImpl) UpdatePublishSchedule(ctx context.Context, weave *entities.Weave) error {
+	// Guarded by status so a schedule cannot be set on a weave the scheduler just published
+	result := r.db.WithContext(ctx).Model(&models.Weave{}).
+		Where("id = ? AND status IN ?", weave.ID, []models.WeaveStatus{models.WeaveStatusDraft, models.WeaveStatusInReview}).
+		Updates(map[string]interface{}{
+			"publish_at": weave.PublishAt,
+			"updated_at": weave.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrWeaveStatusConflict
+	}
+	return nil
+}
+
+func (r *weaveRepositoryImpl) UpdateFeaturedStatus(ctx context.Context, weaveID uuid.UUID, isFeatured bool) error {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).Where("id = ?", weaveID).Update("is_featured", isFeatured).Error
+}
+
+func (r *weaveRepositoryImpl) UpdateTemplateStatus(ctx context.Context, weave *entities.Weave, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.Weave{}).Where("id = ?", weave.ID)
+		if weave.IsTemplate {
+			// A weave unpublished in the meantime must not become a template
+			query = query.Where("status = ?", models.WeaveStatusPublished)
+		}
+		result := query.Updates(map[string]interface{}{
+			"is_template": weave.IsTemplate,
+			"updated_at":  weave.UpdatedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repositories.ErrWeaveStatusConflict
+		}
+
+		title := "Marked as template"
+		if !weave.IsTemplate {
+			if err := tx.Where("weave_id = ?", weave.ID).Delete(&models.ChannelTemplate{}).Error; err != nil {
+				return err
+			}
+			title = "No longer a template"
+		}
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:   weave.ID,
+			UserID:    userID,
+			EventType: models.TimelineUpdated,
+			Title:     title,
+		}
+		return tx.Create(timeline).Error
+	})
+}
+
+func (r *weaveRepositoryImpl) IncrementViewCount(ctx context.Context, weaveID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).
+		Where("id = ?", weaveID).
+		UpdateColumn("view_count", gorm.Expr("view_count + 1")).Error
+}
+
+func (r *weaveRepositoryImpl) IncrementLikeCount(ctx context.Context, weaveID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).
+		Where("id = ?", weaveID).
+		UpdateColumn("like_count", gorm.Expr("like_count + 1")).Error
+}
+
+func (r *weaveRepositoryImpl) DecrementLikeCount(ctx context.Context, weaveID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).
+		Where("id = ? AND like_count > 0", weaveID).
+		UpdateColumn("like_count", gorm.Expr("like_count - 1")).Error
+}
+
+func (r *weaveRepositoryImpl) IncrementForkCount(ctx context.Context, weaveID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).
+		Where("id = ?", weaveID).
+		UpdateColumn("fork_count", gorm.Expr("fork_count + 1")).Error
+}
+
+// Delete operations
+func (r *weaveRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return database.PurgeWeave(tx, id)
+	})
+}
+
+func (r *weaveRepositoryImpl) SoftDelete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Weave{}).
+		Where("id = ? AND status <> ?", id, models.WeaveStatusDeleted).
+		Updates(map[string]interface{}{
+			"status":          models.WeaveStatusDeleted,
+			"previous_status": gorm.Expr("status"),
+			"trashed_at":      time.Now(),
+			"publish_at":      nil,
+		}).Error
+}
+
+// Search operations
+func (r *weaveRepositoryImpl) Search(ctx context.Context, query string, channelID *uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.searchScope(ctx, query, channelID).
+		Order("like_count DESC, published_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) SearchCount(ctx context.Context, query string, channelID *uuid.UUID) (int64, error) {
+	var count int64
+	err := r.searchScope(ctx, query, channelID).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) searchScope(ctx context.Context, query string, channelID *uuid.UUID) *gorm.DB {
+	// The query is matched literally, so wildcards typed by the user are escaped
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+	scope := r.published(ctx).Where(`(title ILIKE ? ESCAPE '\' OR description ILIKE ? ESCAPE '\')`, pattern, pattern)
+	if channelID != nil {
+		scope = scope.Where("channel_id = ?", *channelID)
+	}
+	return scope
+}
+
+func (r *weaveRepositoryImpl) SearchByTags(ctx context.Context, tags []string, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Select("weaves.*").
+		Joins("JOIN weave_tag_relations ON weave_tag_relations.weave_id = weaves.id").
+		Joins("JOIN weave_tags ON weave_tags.id = weave_tag_relations.weave_tag_id").
+		Where("weave_tags.name IN ?", tags).
+		Group("weaves.id").
+		Order("COUNT(weave_tags.id) DESC, weaves.like_count DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+func (r *weaveRepositoryImpl) CountByTags(ctx context.Context, tags []string) (int64, error) {
+	tagged := r.db.WithContext(ctx).Table("weave_tag_relations").
+		Select("weave_tag_relations.weave_id").
+		Joins("JOIN weave_tags ON weave_tags.id = weave_tag_relations.weave_tag_id").
+		Where("weave_tags.name IN ?", tags)
+
+	var count int64
+	err := r.published(ctx).Where("weaves.id IN (?)", tagged).Count(&count).Error
+	return count, err
+}
+
+// Analytics
+func (r *weaveRepositoryImpl) Count(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.published(ctx).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountFeatured(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.published(ctx).Where("is_featured = ?", true).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountByChannel(ctx context.Context, channelID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.published(ctx).Where("channel_id = ?", channelID).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.visible(ctx).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountDrafts(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Weave{}).
+		Where("user_id = ? AND status IN ?", userID, unpublishedStatuses).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountForks(ctx context.Context, parentID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.published(ctx).Where("parent_weave_id = ?", parentID).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountForkedByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.visible(ctx).
+		Where("user_id = ? AND parent_weave_id IS NOT NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountLikedByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.WeaveLike{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) CountContributionsByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Contribution{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *weaveRepositoryImpl) GetTrending(ctx context.Context, timeframe string, limit, offset int) ([]*entities.Weave, error) {
+	since := time.Now().Add(-trendingWindow(timeframe))
+
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Select("weaves.*").
+		Joins("LEFT JOIN weave_likes ON weave_likes.weave_id = weaves.id AND weave_likes.created_at >= ?", since).
+		Group("weaves.id").
+		Order("COUNT(weave_likes.id) DESC, weaves.view_count DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+// trendingWindow converts a timeframe name into the lookback window for trending weaves
+func trendingWindow(timeframe string) time.Duration {
+	switch timeframe {
+	case "hour":
+		return time.Hour
+	case "day":
+		return 24 * time.Hour
+	case "month":
+		return 30 * 24 * time.Hour
+	default:
+		return 7 * 24 * time.Hour
+	}
+}
+
+func (r *weaveRepositoryImpl) GetPopular(ctx context.Context, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Order("like_count DESC, fork_count DESC, view_count DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+// Like system
+func (r *weaveRepositoryImpl) Like(ctx context.Context, weaveID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		like := &models.WeaveLike{
+			WeaveID: weaveID,
+			UserID:  userID,
+		}
+		// A concurrent like by the same user is a no-op rather than a second count
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(like)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.Weave{}).
+			Where("id = ?", weaveID).
+			UpdateColumn("like_count", gorm.Expr("like_count + 1")).Error
+	})
+}
+
+func (r *weaveRepositoryImpl) Unlike(ctx context.Context, weaveID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("weave_id = ? AND user_id = ?", weaveID, userID).Delete(&models.WeaveLike{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.Weave{}).
+			Where("id = ? AND like_count > 0", weaveID).
+			UpdateColumn("like_count", gorm.Expr("like_count - 1")).Error
+	})
+}
+
+func (r *weaveRepositoryImpl) IsLiked(ctx context.Context, weaveID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.WeaveLike{}).
+		Where("weave_id = ? AND user_id = ?", weaveID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *weaveRepositoryImpl) GetLikedBy(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Weave, error) {
+	var weaveModels []*models.Weave
+	err := r.published(ctx).
+		Joins("JOIN weave_likes ON weave_likes.weave_id = weaves.id").
+		Where("weave_likes.user_id = ?", userID).
+		Order("weave_likes.created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&weaveModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.modelsToEntities(weaveModels), nil
+}
+
+// Lineage
+
+// lineageRow is a weave joined with its author, as returned by the lineage queries
+type lineageRow struct {
+	ID            uuid.UUID
+	ParentWeaveID *uuid.UUID
+	UserID        uuid.UUID
+	Username      string
+	Title         string
+	Version       int
+	LikeCount     int
+	ForkCount     int
+	Status        models.WeaveStatus
+	CreatedAt     time.Time
+	Depth         int
+}
+
+func (row *lineageRow) toEntity() *entities.LineageNode {
+	return &entities.LineageNode{
+		WeaveID:       row.ID,
+		ParentWeaveID: row.ParentWeaveID,
+		UserID:        row.UserID,
+		Username:      row.Username,
+		Title:         row.Title,
+		Version:       row.Version,
+		LikeCount:     row.LikeCount,
+		ForkCount:     row.ForkCount,
+		Status:        entities.WeaveStatus(row.Status),
+		Depth:         row.Depth,
+		CreatedAt:     row.CreatedAt,
+	}
+}
+
+func lineageRowsToEntities(rows []lineageRow) []*entities.LineageNode {
+	nodes := make([]*entities.LineageNode, len(rows))
+	for i := range rows {
+		nodes[i] = rows[i].toEntity()
+	}
+	return nodes
+}
+
+// GetAncestors walks parent links upwards, returning the nearest ancestor first.
+// Deleted ancestors are walked through but not returned.
+func (r *weaveRepositoryImpl) GetAncestors(ctx context.Context, weaveID uuid.UUID, maxDepth int) ([]*entities.LineageNode, error) {
+	var rows []lineageRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE chain AS (
+			SELECT w.id, w.parent_weave_id, 0 AS depth
+			FROM weaves w
+			WHERE w.id = ?
+			UNION ALL
+			SELECT p.id, p.parent_weave_id, chain.depth + 1
+			FROM weaves p
+			JOIN chain ON p.id = chain.parent_weave_id
+			WHERE chain.depth < ?
+		)
+		SELECT w.id, w.parent_weave_id, w.user_id, u.username, w.title, w.version,
+			w.like_count, w.fork_count, w.status, w.created_at, chain.depth
+		FROM chain
+		JOIN weaves w ON w.id = chain.id
+		JOIN users u ON u.id = w.user_id
+		WHERE chain.depth > 0 AND w.status <> ?
+		ORDER BY chain.depth ASC
+	`, weaveID, maxDepth, models.WeaveStatusDeleted).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return lineageRowsToEntities(rows), nil
+}
+
+// GetDescendants walks the fork tree downwards breadth first. The weave itself is
+// returned first at depth 0; deleted forks and everything below them are skipped.
+func (r *weaveRepositoryImpl) GetDescendants(ctx context.Context, weaveID uuid.UUID, maxDepth, limit int) ([]*entities.LineageNode, error) {
+	var rows []lineageRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT w.id, 0 AS depth, ARRAY[w.id] AS path
+			FROM weaves w
+			WHERE w.id = ?
+			UNION ALL
+			SELECT c.id, tree.depth + 1, tree.path || c.id
+			FROM weaves c
+			JOIN tree ON c.parent_weave_id = tree.id
+			WHERE tree.depth < ? AND c.status <> ? AND NOT c.id = ANY(tree.path)
+		)
+		SELECT w.id, w.parent_weave_id, w.user_id, u.username, w.title, w.version,
+			w.like_count, w.fork_count, w.status, w.created_at, tree.depth
+		FROM tree
+		JOIN weaves w ON w.id = tree.id
+		JOIN users u ON u.id = w.user_id
+		ORDER BY tree.depth ASC, w.created_at ASC
+		LIMIT ?
+	`, weaveID, maxDepth, models.WeaveStatusDeleted, limit).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return lineageRowsToEntities(rows), nil
+}
+
+// Version control
+func (r *weaveRepositoryImpl) GetVersions(ctx context.Context, weaveID uuid.UUID) ([]*entities.WeaveVersion, error) {
+	var versionModels []*models.WeaveVersion
+	err := r.db.WithContext(ctx).
+		Where("weave_id = ?", weaveID).
+		Order("version DESC").
+		Find(&versionModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*entities.WeaveVersion, len(versionModels))
+	for i, model := range versionModels {
+		versions[i] = versionModelToEntity(model)
+	}
+	return versions, nil
+}
+
+func (r *weaveRepositoryImpl) GetVersion(ctx context.Context, weaveID uuid.UUID, version int) (*entities.WeaveVersion, error) {
+	var model models.WeaveVersion
+	err := r.db.WithContext(ctx).Where("weave_id = ? AND version = ?", weaveID, version).First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return versionModelToEntity(&model), nil
+}
+
+func (r *weaveRepositoryImpl) GetTimeline(ctx context.Context, weaveID uuid.UUID) ([]*entities.WeaveTimelineEvent, error) {
+	var timelineModels []*models.WeaveTimeline
+	err := r.db.WithContext(ctx).
+		Where("weave_id = ?", weaveID).
+		Order("created_at ASC").
+		Find(&timelineModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*entities.WeaveTimelineEvent, len(timelineModels))
+	for i, model := range timelineModels {
+		events[i] = timelineModelToEntity(model)
+	}
+	return events, nil
+}
+
+// RevertToVersion restores the title and content of an earlier version as a new
+// forward version; existing history is left untouched
+func (r *weaveRepositoryImpl) RevertToVersion(ctx context.Context, weaveID, userID uuid.UUID, version int) (*entities.WeaveVersion, error) {
+	var created models.WeaveVersion
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var weave models.Weave
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status <> ?", weaveID, models.WeaveStatusDeleted).
+			First(&weave).Error
+		if err != nil {
+			return err
+		}
+
+		var target models.WeaveVersion
+		if err := tx.Where("weave_id = ? AND version = ?", weaveID, version).First(&target).Error; err != nil {
+			return err
+		}
+
+		contentDiff, err := diff.Compare(unmarshalWeaveContent(weave.Content), unmarshalWeaveContent(target.Content))
+		if err != nil {
+			return err
+		}
+		serializedDiff, err := contentDiff.JSON()
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Weave{}).Where("id = ?", weave.ID).Updates(map[string]interface{}{
+			"title":      target.Title,
+			"content":    target.Content,
+			"version":    weave.Version + 1,
+			"updated_at": time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		changeLog := fmt.Sprintf("Reverted to v%d", target.Version)
+		created = models.WeaveVersion{
+			WeaveID:     weave.ID,
+			UserID:      userID,
+			Version:     weave.Version + 1,
+			Title:       target.Title,
+			Description: weave.Description,
+			Content:     target.Content,
+			ChangeLog:   &changeLog,
+			ContentDiff: &serializedDiff,
+		}
+		if err := tx.Create(&created).Error; err != nil {
+			return err
+		}
+		if err := database.ReanchorComments(tx, weave.ID, weave.Content, target.Content, created.Version); err != nil {
+			return err
+		}
+
+		metadata, err := json.Marshal(map[string]interface{}{
+			"reverted_to": target.Version,
+			"version":     created.Version,
+		})
+		if err != nil {
+			return err
+		}
+		serializedMetadata := string(metadata)
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:   weave.ID,
+			UserID:    userID,
+			EventType: models.TimelineUpdated,
+			Title:     changeLog,
+			Metadata:  &serializedMetadata,
+		}
+		return tx.Create(timeline).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versionModelToEntity(&created), nil
+}
+
+// Upstream sync operations
+
+// SyncWithUpstream stores merged upstream content on a fork and moves its sync point.
+// No version is created when the merge leaves the fork content unchanged.
+func (r *weaveRepositoryImpl) SyncWithUpstream(ctx context.Context, forkID, userID uuid.UUID, content entities.WeaveContent, upstreamVersion, expectedVersion int) (*entities.WeaveVersion, error) {
+	data, err := marshalWeaveContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *models.WeaveVersion
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var weave models.Weave
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status <> ?", forkID, models.WeaveStatusDeleted).
+			First(&weave).Error
+		if err != nil {
+			return err
+		}
+		if weave.Version != expectedVersion {
+			return repositories.ErrWeaveVersionConflict
+		}
+
+		contentDiff, err := diff.Compare(unmarshalWeaveContent(weave.Content), content)
+		if err != nil {
+			return err
+		}
+
+		syncedVersion := weave.Version
+		updates := map[string]interface{}{
+			"upstream_version": upstreamVersion,
+			"updated_at":       time.Now(),
+		}
+
+		if !contentDiff.IsEmpty() {
+			serializedDiff, err := contentDiff.JSON()
+			if err != nil {
+				return err
+			}
+
+			syncedVersion = weave.Version + 1
+			updates["content"] = data
+			updates["version"] = syncedVersion
+
+			changeLog := fmt.Sprintf("Synced with upstream v%d", upstreamVersion)
+			created = &models.WeaveVersion{
+				WeaveID:     weave.ID,
+				UserID:      userID,
+				Version:     syncedVersion,
+				Title:       weave.Title,
+				Description: weave.Description,
+				Content:     data,
+				ChangeLog:   &changeLog,
+				ContentDiff: &serializedDiff,
+			}
+			if err := tx.Create(created).Error; err != nil {
+				return err
+			}
+			if err := database.ReanchorComments(tx, weave.ID, weave.Content, data, syncedVersion); err != nil {
+				return err
+			}
+		}
+		updates["synced_version"] = syncedVersion
+
+		if err := tx.Model(&models.Weave{}).Where("id = ?", weave.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+
+		metadata, err := json.Marshal(map[string]interface{}{
+			"parent_weave_id":  weave.ParentWeaveID,
+			"upstream_version": upstreamVersion,
+			"version":          syncedVersion,
+		})
+		if err != nil {
+			return err
+		}
+		serializedMetadata := string(metadata)
+
+		timeline := &models.WeaveTimeline{
+			WeaveID:   weave.ID,
+			UserID:    userID,
+			EventType: models.TimelineUpdated,
+			Title:     fmt.Sprintf("Synced with upstream v%d", upstreamVersion),
+			Metadata:  &serializedMetadata,
+		}
+		return tx.Create(timeline).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if created == nil {
+		return nil, nil
+	}
+	return versionModelToEntity(created), nil
+}
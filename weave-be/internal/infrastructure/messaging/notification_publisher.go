@@ -0,0 +1,27 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/services"
+	"weave-module/queue"
+)
+
+// notificationPublisher implements the NotificationService interface on top of the notification queue
+type notificationPublisher struct{}
+
+// NewNotificationPublisher creates a notification service that hands notifications to the worker
+func NewNotificationPublisher() services.NotificationService {
+	return &notificationPublisher{}
+}
+
+func (p *notificationPublisher) Notify(ctx context.Context, userID uuid.UUID, notificationType, title, message string, data map[string]interface{}) error {
+	return queue.PublishNotification(queue.NotificationMessage{
+		UserID:  userID.String(),
+		Type:    notificationType,
+		Title:   title,
+		Message: message,
+		Data:    data,
+	})
+}
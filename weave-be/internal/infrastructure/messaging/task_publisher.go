@@ -0,0 +1,25 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"weave-be/internal/domain/services"
+	"weave-module/queue"
+)
+
+// taskPublisher implements the TaskService interface on top of the processing queue
+type taskPublisher struct{}
+
+// NewTaskPublisher creates a task service that hands tasks to the worker
+func NewTaskPublisher() services.TaskService {
+	return &taskPublisher{}
+}
+
+func (p *taskPublisher) Enqueue(ctx context.Context, taskType string, userID uuid.UUID, data map[string]interface{}) error {
+	return queue.PublishProcessing(queue.ProcessingMessage{
+		Type:   taskType,
+		UserID: userID.String(),
+		Data:   data,
+	})
+}
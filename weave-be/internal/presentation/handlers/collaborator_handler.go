@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/services"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+// CollaboratorHandler handles HTTP requests related to weave collaborators
+type CollaboratorHandler struct {
+	collaboratorService *services.CollaboratorApplicationService
+}
+
+// NewCollaboratorHandler creates a new collaborator handler
+func NewCollaboratorHandler(collaboratorService *services.CollaboratorApplicationService) *CollaboratorHandler {
+	return &CollaboratorHandler{
+		collaboratorService: collaboratorService,
+	}
+}
+
+// ListCollaborators handles listing the collaborators of a weave
+// GET /weaves/:id/collaborators
+func (h *CollaboratorHandler) ListCollaborators(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	collaborators, err := h.collaboratorService.ListCollaborators(c.Request.Context(), weaveID, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Collaborators retrieved successfully", collaborators)
+}
+
+// InviteCollaborator handles inviting a user to collaborate on a weave
+// POST /weaves/:id/collaborators
+func (h *CollaboratorHandler) InviteCollaborator(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.InviteCollaboratorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid invitation", err.Error()))
+		return
+	}
+
+	collaborator, err := h.collaboratorService.InviteCollaborator(c.Request.Context(), weaveID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Collaborator invited successfully", collaborator)
+}
+
+// AcceptCollaboration handles accepting a collaboration invitation
+// POST /weaves/:id/collaborators/accept
+func (h *CollaboratorHandler) AcceptCollaboration(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	collaborator, err := h.collaboratorService.AcceptCollaboration(c.Request.Context(), weaveID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Invitation accepted successfully", collaborator)
+}
+
+// RemoveCollaborator handles revoking a role, declining an invitation or leaving a weave
+// DELETE /weaves/:id/collaborators/:userId
+func (h *CollaboratorHandler) RemoveCollaborator(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	collaboratorID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid user ID"))
+		return
+	}
+
+	if err := h.collaboratorService.RemoveCollaborator(c.Request.Context(), weaveID, userID, collaboratorID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Collaborator removed successfully", nil)
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/services"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+// CollectionHandler handles HTTP requests related to weave collections
+type CollectionHandler struct {
+	collectionService *services.CollectionApplicationService
+}
+
+// NewCollectionHandler creates a new collection handler
+func NewCollectionHandler(collectionService *services.CollectionApplicationService) *CollectionHandler {
+	return &CollectionHandler{
+		collectionService: collectionService,
+	}
+}
+
+// GetCollection handles get collection by ID requests
+// GET /collections/:id
+func (h *CollectionHandler) GetCollection(c *gin.Context) {
+	collectionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid collection ID"))
+		return
+	}
+
+	collection, err := h.collectionService.GetCollection(c.Request.Context(), collectionID, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Collection retrieved successfully", collection)
+}
+
+// GetCollectionWeaves handles listing the weaves of a collection
+// GET /collections/:id/weaves
+func (h *CollectionHandler) GetCollectionWeaves(c *gin.Context) {
+	collectionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid collection ID"))
+		return
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	weaves, err := h.collectionService.GetCollectionWeaves(c.Request.Context(), collectionID, getOptionalUserIDFromContext(c), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(weaves.Total))
+	utils.PaginatedSuccessResponse(c, "Collection weaves retrieved successfully", weaves.Weaves, pagination)
+}
+
+// ListUserCollections handles listing a user's collections
+// GET /users/:id/collections
+func (h *CollectionHandler) ListUserCollections(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid user ID"))
+		return
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	collections, err := h.collectionService.ListUserCollections(c.Request.Context(), userID, getOptionalUserIDFromContext(c), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(collections.Total))
+	utils.PaginatedSuccessResponse(c, "Collections retrieved successfully", collections.Collections, pagination)
+}
+
+// CreateCollection handles collection creation
+// POST /collections
+func (h *CollectionHandler) CreateCollection(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	var req dto.CreateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid collection", err.Error()))
+		return
+	}
+
+	collection, err := h.collectionService.CreateCollection(c.Request.Context(), userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Collection created successfully", collection)
+}
+
+// UpdateCollection handles collection updates
+// PUT /collections/:id
+func (h *CollectionHandler) UpdateCollection(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	collectionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid collection ID"))
+		return
+	}
+
+	var req dto.UpdateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid collection", err.Error()))
+		return
+	}
+
+	collection, err := h.collectionService.UpdateCollection(c.Request.Context(), collectionID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Collection updated successfully", collection)
+}
+
+// DeleteCollection handles collection deletion
+// DELETE /collections/:id
+func (h *CollectionHandler) DeleteCollection(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	collectionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid collection ID"))
+		return
+	}
+
+	if err := h.collectionService.DeleteCollection(c.Request.Context(), collectionID, userID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Collection deleted successfully", nil)
+}
+
+// AddWeave handles adding a weave to a collection
+// POST /collections/:id/weaves
+func (h *CollectionHandler) AddWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	collectionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid collection ID"))
+		return
+	}
+
+	var req dto.AddCollectionWeaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid collection weave", err.Error()))
+		return
+	}
+
+	if err := h.collectionService.AddWeave(c.Request.Context(), collectionID, userID, req); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Weave added to collection successfully", nil)
+}
+
+// RemoveWeave handles taking a weave out of a collection
+// DELETE /collections/:id/weaves/:weaveId
+func (h *CollectionHandler) RemoveWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	collectionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid collection ID"))
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("weaveId"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	if err := h.collectionService.RemoveWeave(c.Request.Context(), collectionID, userID, weaveID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave removed from collection successfully", nil)
+}
+
+// ReorderWeaves handles changing the order of the weaves in a collection
+// PUT /collections/:id/weaves/order
+func (h *CollectionHandler) ReorderWeaves(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	collectionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid collection ID"))
+		return
+	}
+
+	var req dto.ReorderCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid collection order", err.Error()))
+		return
+	}
+
+	if err := h.collectionService.ReorderWeaves(c.Request.Context(), collectionID, userID, req); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Collection reordered successfully", nil)
+}
@@ -0,0 +1,332 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/services"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+// CommentHandler handles HTTP requests related to lab comments
+type CommentHandler struct {
+	commentService *services.CommentApplicationService
+}
+
+// NewCommentHandler creates a new comment handler
+func NewCommentHandler(commentService *services.CommentApplicationService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// CreateComment handles commenting on a weave
+// POST /collaborations/weaves/:id/comments
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid comment", err.Error()))
+		return
+	}
+
+	comment, err := h.commentService.CreateComment(c.Request.Context(), weaveID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Comment created successfully", comment)
+}
+
+// ListComments handles listing the comment threads of a weave
+// GET /collaborations/weaves/:id/comments?path=...&type=...&resolved=...&outdated=...
+func (h *CommentHandler) ListComments(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	filter, err := commentFilterFromQuery(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	comments, err := h.commentService.ListComments(c.Request.Context(), weaveID, userID, filter, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(comments.Total))
+	utils.PaginatedSuccessResponse(c, "Comments retrieved successfully", comments.Comments, pagination)
+}
+
+// GetCommentThread handles loading a comment with its replies
+// GET /collaborations/comments/:id/thread?depth=3
+func (h *CommentHandler) GetCommentThread(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid comment ID"))
+		return
+	}
+
+	depth, err := strconv.Atoi(c.DefaultQuery("depth", "0"))
+	if err != nil || depth < 0 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid depth"))
+		return
+	}
+
+	thread, err := h.commentService.GetCommentThread(c.Request.Context(), commentID, userID, depth)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Comment thread retrieved successfully", thread)
+}
+
+// EditComment handles editing a comment
+// PUT /collaborations/comments/:id
+func (h *CommentHandler) EditComment(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid comment ID"))
+		return
+	}
+
+	var req dto.EditCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid comment", err.Error()))
+		return
+	}
+
+	comment, err := h.commentService.EditComment(c.Request.Context(), commentID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Comment updated successfully", comment)
+}
+
+// GetCommentHistory handles listing the edit history of a comment
+// GET /collaborations/comments/:id/history
+func (h *CommentHandler) GetCommentHistory(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid comment ID"))
+		return
+	}
+
+	history, err := h.commentService.GetCommentHistory(c.Request.Context(), commentID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Comment history retrieved successfully", history)
+}
+
+// ResolveComment handles resolving a thread
+// POST /collaborations/comments/:id/resolve
+func (h *CommentHandler) ResolveComment(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid comment ID"))
+		return
+	}
+
+	comment, err := h.commentService.ResolveComment(c.Request.Context(), commentID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Thread resolved successfully", comment)
+}
+
+// ReopenComment handles reopening a resolved thread
+// POST /collaborations/comments/:id/reopen
+func (h *CommentHandler) ReopenComment(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid comment ID"))
+		return
+	}
+
+	comment, err := h.commentService.ReopenComment(c.Request.Context(), commentID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Thread reopened successfully", comment)
+}
+
+// LikeComment handles liking a comment
+// POST /collaborations/comments/:id/like
+func (h *CommentHandler) LikeComment(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid comment ID"))
+		return
+	}
+
+	if err := h.commentService.LikeComment(c.Request.Context(), commentID, userID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Comment liked successfully", nil)
+}
+
+// UnlikeComment handles removing a like from a comment
+// DELETE /collaborations/comments/:id/like
+func (h *CommentHandler) UnlikeComment(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid comment ID"))
+		return
+	}
+
+	if err := h.commentService.UnlikeComment(c.Request.Context(), commentID, userID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Comment unliked successfully", nil)
+}
+
+// GetIssueSummary handles the open issues summary of a weave for its owner
+// GET /collaborations/weaves/:id/issues
+func (h *CommentHandler) GetIssueSummary(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	summary, err := h.commentService.GetIssueSummary(c.Request.Context(), weaveID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Issue summary retrieved successfully", summary)
+}
+
+// commentFilterFromQuery reads the comment listing filters from the query string
+func commentFilterFromQuery(c *gin.Context) (dto.CommentFilterRequest, error) {
+	var filter dto.CommentFilterRequest
+	if path, ok := c.GetQuery("path"); ok {
+		filter.AnchorPath = &path
+	}
+	if commentType := c.Query("type"); commentType != "" {
+		filter.Type = &commentType
+	}
+
+	flags := []struct {
+		name   string
+		target **bool
+	}{
+		{"resolved", &filter.IsResolved},
+		{"outdated", &filter.IsOutdated},
+	}
+	for _, flag := range flags {
+		value := c.Query(flag.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, errors.BadRequest("Invalid " + flag.name + " filter")
+		}
+		*flag.target = &parsed
+	}
+
+	if err := filter.Validate(); err != nil {
+		return filter, errors.BadRequestWithDetails("Invalid comment filter", err.Error())
+	}
+	return filter, nil
+}
@@ -0,0 +1,414 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/services"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+// ContributionHandler handles HTTP requests related to contributions
+type ContributionHandler struct {
+	contributionService *services.ContributionApplicationService
+}
+
+// NewContributionHandler creates a new contribution handler
+func NewContributionHandler(contributionService *services.ContributionApplicationService) *ContributionHandler {
+	return &ContributionHandler{
+		contributionService: contributionService,
+	}
+}
+
+// CreateMergeRequest handles proposing a fork back to its parent weave
+// POST /collaborations/weaves/:id/merge-requests
+func (h *ContributionHandler) CreateMergeRequest(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.CreateMergeRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid merge request", err.Error()))
+		return
+	}
+
+	contribution, err := h.contributionService.CreateMergeRequest(c.Request.Context(), weaveID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Merge request created successfully", contribution)
+}
+
+// CreateSuggestion handles suggesting a change to a single value of a weave
+// POST /collaborations/weaves/:id/suggestions
+func (h *ContributionHandler) CreateSuggestion(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.CreateSuggestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid suggestion", err.Error()))
+		return
+	}
+
+	contribution, err := h.contributionService.CreateSuggestion(c.Request.Context(), weaveID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Suggestion created successfully", contribution)
+}
+
+// AcceptSuggestion handles approving and applying a suggestion in one call
+// POST /collaborations/contributions/:id/accept
+func (h *ContributionHandler) AcceptSuggestion(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	result, err := h.contributionService.AcceptSuggestion(c.Request.Context(), contributionID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	if !result.Merged {
+		utils.ConflictResponse(c, "Suggestion conflicts with changes made since", result)
+		return
+	}
+
+	utils.SuccessResponse(c, "Suggestion accepted successfully", result)
+}
+
+// MergeContribution handles contribution merge requests
+// POST /collaborations/contributions/:id/merge
+func (h *ContributionHandler) MergeContribution(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	result, err := h.contributionService.MergeContribution(c.Request.Context(), contributionID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	if !result.Merged {
+		utils.ConflictResponse(c, "Contribution has merge conflicts", result)
+		return
+	}
+
+	utils.SuccessResponse(c, "Contribution merged successfully", result)
+}
+
+// GetContributionConflicts handles conflict listing requests
+// GET /collaborations/contributions/:id/conflicts
+func (h *ContributionHandler) GetContributionConflicts(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	conflicts, err := h.contributionService.GetContributionConflicts(c.Request.Context(), contributionID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Contribution conflicts retrieved successfully", conflicts)
+}
+
+// ResolveContributionConflicts handles conflict resolution requests
+// PUT /collaborations/contributions/:id/conflicts
+func (h *ContributionHandler) ResolveContributionConflicts(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	var req dto.ResolveConflictsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid resolution", err.Error()))
+		return
+	}
+
+	contribution, err := h.contributionService.ResolveContributionConflicts(c.Request.Context(), contributionID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Contribution conflicts resolved successfully", contribution)
+}
+
+// ReviewContribution handles reviewing a contribution
+// POST /collaborations/contributions/:id/review
+func (h *ContributionHandler) ReviewContribution(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	var req dto.ReviewContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid review", err.Error()))
+		return
+	}
+
+	contribution, err := h.contributionService.ReviewContribution(c.Request.Context(), contributionID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Contribution reviewed successfully", contribution)
+}
+
+// GetContributionReviews handles listing the reviews of a contribution
+// GET /collaborations/contributions/:id/reviews
+func (h *ContributionHandler) GetContributionReviews(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	reviews, err := h.contributionService.GetContributionReviews(c.Request.Context(), contributionID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Contribution reviews retrieved successfully", reviews)
+}
+
+// AssignReviewer handles assigning a reviewer to a contribution
+// PUT /collaborations/contributions/:id/reviewer
+func (h *ContributionHandler) AssignReviewer(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	var req dto.AssignReviewerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid reviewer", err.Error()))
+		return
+	}
+
+	contribution, err := h.contributionService.AssignReviewer(c.Request.Context(), contributionID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Reviewer assigned successfully", contribution)
+}
+
+// VoteContribution handles casting or changing a vote on a contribution
+// PUT /collaborations/contributions/:id/vote
+func (h *ContributionHandler) VoteContribution(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	var req dto.VoteContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid vote", err.Error()))
+		return
+	}
+
+	vote, err := h.contributionService.VoteContribution(c.Request.Context(), contributionID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Vote recorded successfully", vote)
+}
+
+// RemoveContributionVote handles withdrawing a vote on a contribution
+// DELETE /collaborations/contributions/:id/vote
+func (h *ContributionHandler) RemoveContributionVote(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	contributionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid contribution ID"))
+		return
+	}
+
+	vote, err := h.contributionService.RemoveContributionVote(c.Request.Context(), contributionID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Vote removed successfully", vote)
+}
+
+// GetMergePolicy handles reading the merge policy of a weave
+// GET /collaborations/weaves/:id/merge-policy
+func (h *ContributionHandler) GetMergePolicy(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	policy, err := h.contributionService.GetMergePolicy(c.Request.Context(), weaveID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Merge policy retrieved successfully", policy)
+}
+
+// UpdateMergePolicy handles changing the merge policy of a weave
+// PUT /collaborations/weaves/:id/merge-policy
+func (h *ContributionHandler) UpdateMergePolicy(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.UpdateMergePolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid merge policy", err.Error()))
+		return
+	}
+
+	policy, err := h.contributionService.UpdateMergePolicy(c.Request.Context(), weaveID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Merge policy updated successfully", policy)
+}
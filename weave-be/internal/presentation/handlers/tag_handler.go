@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/services"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+// TagHandler handles HTTP requests related to tags
+type TagHandler struct {
+	tagService *services.TagApplicationService
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(tagService *services.TagApplicationService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+// AutocompleteTags handles tag suggestions while typing
+// GET /tags?q=...&limit=...
+func (h *TagHandler) AutocompleteTags(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid limit"))
+		return
+	}
+
+	tags, err := h.tagService.AutocompleteTags(c.Request.Context(), c.Query("q"), limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Tags retrieved successfully", tags)
+}
+
+// GetTagWeaves handles listing the published weaves carrying a tag
+// GET /tags/:name/weaves
+func (h *TagHandler) GetTagWeaves(c *gin.Context) {
+	page, limit := utils.GetPaginationParams(c)
+
+	weaves, err := h.tagService.GetTagWeaves(c.Request.Context(), c.Param("name"), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(weaves.Total))
+	utils.PaginatedSuccessResponse(c, "Tagged weaves retrieved successfully", weaves.Weaves, pagination)
+}
+
+// GetRelatedTags handles suggesting tags used together with a tag
+// GET /tags/:name/related
+func (h *TagHandler) GetRelatedTags(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid limit"))
+		return
+	}
+
+	related, err := h.tagService.GetRelatedTags(c.Request.Context(), c.Param("name"), limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Related tags retrieved successfully", related)
+}
+
+// MergeTags handles folding a duplicate tag into another one
+// POST /tags/:name/merge
+func (h *TagHandler) MergeTags(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	var req dto.MergeTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid tag merge", err.Error()))
+		return
+	}
+
+	tag, err := h.tagService.MergeTags(c.Request.Context(), userID, c.Param("name"), req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Tags merged successfully", tag)
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/services"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+// TemplateHandler handles HTTP requests related to weave templates
+type TemplateHandler struct {
+	templateService *services.TemplateApplicationService
+}
+
+// NewTemplateHandler creates a new template handler
+func NewTemplateHandler(templateService *services.TemplateApplicationService) *TemplateHandler {
+	return &TemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// ListTemplates handles browsing published templates
+// GET /weaves/templates?channel_id=...
+func (h *TemplateHandler) ListTemplates(c *gin.Context) {
+	var channelID *uuid.UUID
+	if channelIDStr := c.Query("channel_id"); channelIDStr != "" {
+		parsed, err := uuid.Parse(channelIDStr)
+		if err != nil {
+			utils.ErrorResponse(c, errors.BadRequest("Invalid channel ID"))
+			return
+		}
+		channelID = &parsed
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	templates, err := h.templateService.ListTemplates(c.Request.Context(), channelID, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(templates.Total))
+	utils.PaginatedSuccessResponse(c, "Templates retrieved successfully", templates.Weaves, pagination)
+}
+
+// MarkTemplate handles offering a published weave as a template
+// PUT /weaves/:id/template
+func (h *TemplateHandler) MarkTemplate(c *gin.Context) {
+	h.setTemplate(c, true, "Weave marked as template successfully")
+}
+
+// UnmarkTemplate handles withdrawing a weave from the templates
+// DELETE /weaves/:id/template
+func (h *TemplateHandler) UnmarkTemplate(c *gin.Context) {
+	h.setTemplate(c, false, "Weave is no longer a template")
+}
+
+func (h *TemplateHandler) setTemplate(c *gin.Context, isTemplate bool, message string) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	weave, err := h.templateService.SetTemplate(c.Request.Context(), weaveID, userID, isTemplate)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, message, weave)
+}
+
+// ListChannelTemplates handles listing the official templates of a channel
+// GET /channels/:id/templates
+func (h *TemplateHandler) ListChannelTemplates(c *gin.Context) {
+	channelID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid channel ID"))
+		return
+	}
+
+	templates, err := h.templateService.ListChannelTemplates(c.Request.Context(), channelID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Channel templates retrieved successfully", templates)
+}
+
+// PinTemplate handles pinning a template as one of a channel's official templates
+// POST /channels/:id/templates
+func (h *TemplateHandler) PinTemplate(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	channelID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid channel ID"))
+		return
+	}
+
+	var req dto.PinTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid template pin", err.Error()))
+		return
+	}
+
+	pin, err := h.templateService.PinTemplate(c.Request.Context(), channelID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Template pinned successfully", pin)
+}
+
+// UnpinTemplate handles removing a template from a channel's official templates
+// DELETE /channels/:id/templates/:weaveId
+func (h *TemplateHandler) UnpinTemplate(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	channelID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid channel ID"))
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("weaveId"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	if err := h.templateService.UnpinTemplate(c.Request.Context(), channelID, userID, weaveID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Template unpinned successfully", nil)
+}
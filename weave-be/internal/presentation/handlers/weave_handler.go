@@ -0,0 +1,924 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"weave-be/internal/application/dto"
+	"weave-be/internal/application/services"
+	"weave-module/errors"
+	"weave-module/utils"
+)
+
+// WeaveHandler handles HTTP requests related to weaves
+type WeaveHandler struct {
+	weaveService *services.WeaveApplicationService
+}
+
+// NewWeaveHandler creates a new weave handler
+func NewWeaveHandler(weaveService *services.WeaveApplicationService) *WeaveHandler {
+	return &WeaveHandler{
+		weaveService: weaveService,
+	}
+}
+
+// GetPublishedWeaves handles listing published weaves
+// GET /weaves
+func (h *WeaveHandler) GetPublishedWeaves(c *gin.Context) {
+	page, limit := utils.GetPaginationParams(c)
+
+	weaves, err := h.weaveService.GetPublishedWeaves(c.Request.Context(), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(weaves.Total))
+	utils.PaginatedSuccessResponse(c, "Weaves retrieved successfully", weaves.Weaves, pagination)
+}
+
+// GetFeaturedWeaves handles listing featured weaves
+// GET /weaves/featured
+func (h *WeaveHandler) GetFeaturedWeaves(c *gin.Context) {
+	page, limit := utils.GetPaginationParams(c)
+
+	weaves, err := h.weaveService.GetFeaturedWeaves(c.Request.Context(), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(weaves.Total))
+	utils.PaginatedSuccessResponse(c, "Featured weaves retrieved successfully", weaves.Weaves, pagination)
+}
+
+// GetTrendingWeaves handles listing trending weaves
+// GET /weaves/trending?timeframe=hour|day|week|month
+func (h *WeaveHandler) GetTrendingWeaves(c *gin.Context) {
+	page, limit := utils.GetPaginationParams(c)
+
+	weaves, err := h.weaveService.GetTrendingWeaves(c.Request.Context(), c.DefaultQuery("timeframe", "week"), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(weaves.Total))
+	utils.PaginatedSuccessResponse(c, "Trending weaves retrieved successfully", weaves.Weaves, pagination)
+}
+
+// GetPopularWeaves handles listing popular weaves
+// GET /weaves/popular
+func (h *WeaveHandler) GetPopularWeaves(c *gin.Context) {
+	page, limit := utils.GetPaginationParams(c)
+
+	weaves, err := h.weaveService.GetPopularWeaves(c.Request.Context(), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(weaves.Total))
+	utils.PaginatedSuccessResponse(c, "Popular weaves retrieved successfully", weaves.Weaves, pagination)
+}
+
+// SearchWeaves handles weave search requests
+// GET /weaves/search?q=...&channel_id=...
+func (h *WeaveHandler) SearchWeaves(c *gin.Context) {
+	query := utils.GetSearchQuery(c)
+	if query == "" {
+		utils.ErrorResponse(c, errors.BadRequest("Search query is required"))
+		return
+	}
+
+	var channelID *uuid.UUID
+	if channelIDStr := c.Query("channel_id"); channelIDStr != "" {
+		parsed, err := uuid.Parse(channelIDStr)
+		if err != nil {
+			utils.ErrorResponse(c, errors.BadRequest("Invalid channel ID"))
+			return
+		}
+		channelID = &parsed
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	weaves, err := h.weaveService.SearchWeaves(c.Request.Context(), query, channelID, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(weaves.Total))
+	utils.PaginatedSuccessResponse(c, "Weaves retrieved successfully", weaves.Weaves, pagination)
+}
+
+// GetWeave handles get weave by ID requests
+// GET /weaves/:id
+func (h *WeaveHandler) GetWeave(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	weave, err := h.weaveService.GetWeave(c.Request.Context(), weaveID, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("ETag", weaveETag(weave.Weave.Version))
+	utils.SuccessResponse(c, "Weave retrieved successfully", weave)
+}
+
+// GetWeaveForks handles listing forks of a weave
+// GET /weaves/:id/forks
+func (h *WeaveHandler) GetWeaveForks(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	forks, err := h.weaveService.GetWeaveForks(c.Request.Context(), weaveID, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(forks.Total))
+	utils.PaginatedSuccessResponse(c, "Forks retrieved successfully", forks.Weaves, pagination)
+}
+
+// GetWeaveVersions handles listing the version history of a weave
+// GET /weaves/:id/versions
+func (h *WeaveHandler) GetWeaveVersions(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	versions, err := h.weaveService.GetWeaveVersions(c.Request.Context(), weaveID, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Versions retrieved successfully", versions)
+}
+
+// GetWeaveVersion handles get specific version requests
+// GET /weaves/:id/versions/:version
+func (h *WeaveHandler) GetWeaveVersion(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid version number"))
+		return
+	}
+
+	weaveVersion, err := h.weaveService.GetWeaveVersion(c.Request.Context(), weaveID, version, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Version retrieved successfully", weaveVersion)
+}
+
+// GetWeaveVersionDiff handles version comparison requests
+// GET /weaves/:id/versions/:version/diff/:target
+func (h *WeaveHandler) GetWeaveVersionDiff(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	fromVersion, err := strconv.Atoi(c.Param("version"))
+	if err != nil || fromVersion < 1 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid version number"))
+		return
+	}
+
+	toVersion, err := strconv.Atoi(c.Param("target"))
+	if err != nil || toVersion < 1 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid version number"))
+		return
+	}
+
+	versionDiff, err := h.weaveService.GetWeaveVersionDiff(c.Request.Context(), weaveID, fromVersion, toVersion, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Version diff retrieved successfully", versionDiff)
+}
+
+// GetWeaveLineage handles fork family tree requests
+// GET /weaves/:id/lineage?depth=3&limit=100
+func (h *WeaveHandler) GetWeaveLineage(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	depth, err := strconv.Atoi(c.DefaultQuery("depth", "0"))
+	if err != nil || depth < 0 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid depth"))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid limit"))
+		return
+	}
+
+	lineage, err := h.weaveService.GetWeaveLineage(c.Request.Context(), weaveID, getOptionalUserIDFromContext(c), depth, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave lineage retrieved successfully", lineage)
+}
+
+// GetContentTypes lists content type schemas for the weave editor
+// GET /content-types?channel_id=...
+func (h *WeaveHandler) GetContentTypes(c *gin.Context) {
+	var channelID *uuid.UUID
+	if raw := c.Query("channel_id"); raw != "" {
+		parsed, err := uuid.Parse(raw)
+		if err != nil {
+			utils.ErrorResponse(c, errors.BadRequest("Invalid channel ID"))
+			return
+		}
+		channelID = &parsed
+	}
+
+	contentTypes, err := h.weaveService.GetContentTypes(c.Request.Context(), channelID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Content types retrieved successfully", contentTypes)
+}
+
+// CreateWeave handles weave creation requests
+// POST /weaves
+func (h *WeaveHandler) CreateWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	var req dto.CreateWeaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid weave data", err.Error()))
+		return
+	}
+
+	weave, err := h.weaveService.CreateWeave(c.Request.Context(), userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Weave created successfully", weave)
+}
+
+// ImportWeave handles weave import requests
+// POST /weaves/import
+func (h *WeaveHandler) ImportWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	var req dto.ImportWeaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid import data", err.Error()))
+		return
+	}
+
+	result, err := h.weaveService.ImportWeave(c.Request.Context(), userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	if result.Weave == nil {
+		utils.AcceptedResponse(c, "Weave import queued", result)
+		return
+	}
+	utils.CreatedResponse(c, "Weave imported successfully", result)
+}
+
+// UpdateWeave handles weave update requests
+// PUT /weaves/:id
+func (h *WeaveHandler) UpdateWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.UpdateWeaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid weave data", err.Error()))
+		return
+	}
+
+	// Edits must name the version they were made against, either as an
+	// If-Match header or as expected_version
+	ifMatch := c.GetHeader("If-Match")
+	var expectedVersions []int
+	anyVersion := false
+	switch {
+	case ifMatch != "":
+		expectedVersions, anyVersion, err = parseIfMatch(ifMatch)
+		if err != nil {
+			utils.ErrorResponse(c, errors.BadRequest("Invalid If-Match header"))
+			return
+		}
+		// If-Match uses strong comparison, which a weak tag never passes
+		if !anyVersion && len(expectedVersions) == 0 {
+			utils.ErrorResponse(c, errors.PreconditionFailed("If-Match requires a strong entity tag"))
+			return
+		}
+		if req.ExpectedVersion != nil {
+			if !anyVersion && !slices.Contains(expectedVersions, *req.ExpectedVersion) {
+				utils.ErrorResponse(c, errors.BadRequest("If-Match and expected_version disagree"))
+				return
+			}
+			expectedVersions, anyVersion = []int{*req.ExpectedVersion}, false
+		}
+	case req.ExpectedVersion != nil:
+		expectedVersions = []int{*req.ExpectedVersion}
+	default:
+		utils.ErrorResponse(c, errors.PreconditionRequired("If-Match header or expected_version is required"))
+		return
+	}
+
+	weave, err := h.weaveService.UpdateWeave(c.Request.Context(), weaveID, userID, expectedVersions, anyVersion, req)
+	if err != nil {
+		// A failed If-Match is a precondition failure; other conflicts stay conflicts
+		if appErr, ok := err.(*errors.AppError); ok && ifMatch != "" {
+			if _, stale := appErr.Data.(dto.StaleVersionResponse); stale {
+				err = appErr.WithCode(http.StatusPreconditionFailed)
+			}
+		}
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("ETag", weaveETag(weave.Version))
+	utils.SuccessResponse(c, "Weave updated successfully", weave)
+}
+
+// DeleteWeave handles weave deletion requests
+// DELETE /weaves/:id
+func (h *WeaveHandler) DeleteWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	if err := h.weaveService.DeleteWeave(c.Request.Context(), weaveID, userID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave deleted successfully", nil)
+}
+
+// RestoreWeave handles restoring a weave from the trash
+// POST /weaves/:id/restore
+func (h *WeaveHandler) RestoreWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	weave, err := h.weaveService.RestoreWeave(c.Request.Context(), weaveID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave restored successfully", weave)
+}
+
+// ForkWeave handles weave fork requests
+// POST /weaves/:id/fork
+func (h *WeaveHandler) ForkWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	forked, err := h.weaveService.ForkWeave(c.Request.Context(), weaveID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Weave forked successfully", forked)
+}
+
+// LikeWeave handles like weave requests
+// POST /weaves/:id/like
+func (h *WeaveHandler) LikeWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	if err := h.weaveService.LikeWeave(c.Request.Context(), weaveID, userID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave liked successfully", nil)
+}
+
+// UnlikeWeave handles unlike weave requests
+// DELETE /weaves/:id/like
+func (h *WeaveHandler) UnlikeWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	if err := h.weaveService.UnlikeWeave(c.Request.Context(), weaveID, userID); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave unliked successfully", nil)
+}
+
+// PublishWeave handles publish weave requests
+// POST /weaves/:id/publish
+func (h *WeaveHandler) PublishWeave(c *gin.Context) {
+	h.setPublishStatus(c, true, "Weave published successfully")
+}
+
+// UnpublishWeave handles unpublish weave requests
+// POST /weaves/:id/unpublish
+func (h *WeaveHandler) UnpublishWeave(c *gin.Context) {
+	h.setPublishStatus(c, false, "Weave unpublished successfully")
+}
+
+func (h *WeaveHandler) setPublishStatus(c *gin.Context, publish bool, message string) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	weave, err := h.weaveService.PublishWeave(c.Request.Context(), weaveID, userID, publish)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, message, weave)
+}
+
+// SchedulePublication handles scheduling or rescheduling a weave's publication
+// PUT /weaves/:id/schedule
+func (h *WeaveHandler) SchedulePublication(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.SchedulePublicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid schedule", err.Error()))
+		return
+	}
+
+	weave, err := h.weaveService.SchedulePublication(c.Request.Context(), weaveID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave publication scheduled successfully", weave)
+}
+
+// CancelScheduledPublication handles dropping a weave's publication schedule
+// DELETE /weaves/:id/schedule
+func (h *WeaveHandler) CancelScheduledPublication(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	weave, err := h.weaveService.CancelScheduledPublication(c.Request.Context(), weaveID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Scheduled publication cancelled successfully", weave)
+}
+
+// ChangeWeaveStatus handles weave status transition requests
+// PUT /weaves/:id/status
+func (h *WeaveHandler) ChangeWeaveStatus(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var req dto.ChangeWeaveStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid request body"))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		utils.ErrorResponse(c, errors.BadRequestWithDetails("Invalid status", err.Error()))
+		return
+	}
+
+	weave, err := h.weaveService.ChangeWeaveStatus(c.Request.Context(), weaveID, userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave status updated successfully", weave)
+}
+
+// RevertWeave handles version revert requests
+// POST /weaves/:id/versions/:version/revert
+func (h *WeaveHandler) RevertWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid version number"))
+		return
+	}
+
+	reverted, err := h.weaveService.RevertWeave(c.Request.Context(), weaveID, userID, version)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Weave reverted successfully", reverted)
+}
+
+// GetUpstreamStatus handles fork ahead/behind requests
+// GET /weaves/:id/upstream
+func (h *WeaveHandler) GetUpstreamStatus(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	status, err := h.weaveService.GetUpstreamStatus(c.Request.Context(), weaveID, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Upstream status retrieved successfully", status)
+}
+
+// GetWeaveBlame handles field attribution requests
+// GET /weaves/:id/blame
+func (h *WeaveHandler) GetWeaveBlame(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	blame, err := h.weaveService.GetWeaveBlame(c.Request.Context(), weaveID, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave blame retrieved successfully", blame)
+}
+
+// ExportWeave handles weave export requests, answering with a file download
+// GET /weaves/:id/export?format=markdown|html|json&version=N
+func (h *WeaveHandler) ExportWeave(c *gin.Context) {
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	var version *int
+	if raw := c.Query("version"); raw != "" {
+		number, err := strconv.Atoi(raw)
+		if err != nil || number < 1 {
+			utils.ErrorResponse(c, errors.BadRequest("Invalid version number"))
+			return
+		}
+		version = &number
+	}
+
+	exported, err := h.weaveService.ExportWeave(c.Request.Context(), weaveID, c.DefaultQuery("format", "markdown"), version, getOptionalUserIDFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exported.Filename))
+	c.Data(http.StatusOK, exported.ContentType, exported.Body)
+}
+
+// SyncWeave handles sync from upstream requests
+// POST /weaves/:id/sync
+func (h *WeaveHandler) SyncWeave(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	weaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, errors.BadRequest("Invalid weave ID"))
+		return
+	}
+
+	result, err := h.weaveService.SyncWeave(c.Request.Context(), weaveID, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	if !result.Synced {
+		utils.ConflictResponse(c, "Upstream changes conflict with the fork", result)
+		return
+	}
+
+	utils.SuccessResponse(c, "Weave synced with upstream successfully", result)
+}
+
+// GetDrafts handles listing the authenticated user's drafts
+// GET /weaves/drafts
+func (h *WeaveHandler) GetDrafts(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	drafts, err := h.weaveService.GetDrafts(c.Request.Context(), userID, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(drafts.Total))
+	utils.PaginatedSuccessResponse(c, "Drafts retrieved successfully", drafts.Weaves, pagination)
+}
+
+// GetTrash handles listing the authenticated user's deleted weaves
+// GET /weaves/trash
+func (h *WeaveHandler) GetTrash(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	trash, err := h.weaveService.GetTrash(c.Request.Context(), userID, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(trash.Total))
+	utils.PaginatedSuccessResponse(c, "Trash retrieved successfully", trash.Weaves, pagination)
+}
+
+// GetLikedWeaves handles listing weaves liked by the authenticated user
+// GET /weaves/liked
+func (h *WeaveHandler) GetLikedWeaves(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	page, limit := utils.GetPaginationParams(c)
+
+	liked, err := h.weaveService.GetLikedWeaves(c.Request.Context(), userID, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	pagination := utils.CalculatePagination(page, limit, int64(liked.Total))
+	utils.PaginatedSuccessResponse(c, "Liked weaves retrieved successfully", liked.Weaves, pagination)
+}
+
+// Helper functions
+
+// getUserIDFromContext reads the authenticated user ID set by the auth middleware
+func getUserIDFromContext(c *gin.Context) (uuid.UUID, error) {
+	userIDValue, exists := c.Get("user_id")
+	if !exists {
+		return uuid.Nil, errors.Unauthorized("User not authenticated")
+	}
+
+	switch userID := userIDValue.(type) {
+	case uuid.UUID:
+		return userID, nil
+	case string:
+		parsed, err := uuid.Parse(userID)
+		if err != nil {
+			return uuid.Nil, errors.InternalServerError("Invalid user ID format")
+		}
+		return parsed, nil
+	default:
+		return uuid.Nil, errors.InternalServerError("Invalid user ID format")
+	}
+}
+
+// getOptionalUserIDFromContext returns the user ID for routes that also serve anonymous users
+func getOptionalUserIDFromContext(c *gin.Context) *uuid.UUID {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return nil
+	}
+	return &userID
+}
+
+// weaveETag derives the entity tag of a weave from its version. The tag is
+// weak because view and like counts change without a new version. If-Match
+// compares strongly, so edits send the version as the strong tag "v<version>".
+func weaveETag(version int) string {
+	return fmt.Sprintf(`W/"v%d"`, version)
+}
+
+// parseIfMatch reads the versions an If-Match header accepts, or whether it
+// accepts any version. Weak tags are skipped since they never match strongly.
+func parseIfMatch(value string) ([]int, bool, error) {
+	if strings.TrimSpace(value) == "*" {
+		return nil, true, nil
+	}
+
+	var versions []int
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		version, err := parseWeaveETag(tag)
+		if err != nil {
+			return nil, false, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, false, nil
+}
+
+// parseWeaveETag extracts the weave version from a strong entity tag
+func parseWeaveETag(value string) (int, error) {
+	tag := strings.TrimSpace(value)
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return 0, fmt.Errorf("unrecognized entity tag %q", value)
+	}
+	tag = tag[1 : len(tag)-1]
+	if !strings.HasPrefix(tag, "v") {
+		return 0, fmt.Errorf("unrecognized entity tag %q", value)
+	}
+
+	version, err := strconv.Atoi(strings.TrimPrefix(tag, "v"))
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("unrecognized entity tag %q", value)
+	}
+	return version, nil
+}
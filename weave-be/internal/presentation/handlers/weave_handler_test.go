@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"weave-be/internal/application/services"
+	"weave-be/internal/domain/entities"
+	"weave-be/internal/domain/repositories"
+)
+
+// Mock WeaveRepository for testing; methods a test does not set panic
+type mockWeaveRepository struct {
+	repositories.WeaveRepository
+	weave *entities.Weave
+}
+
+func (m *mockWeaveRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Weave, error) {
+	stored := *m.weave
+	return &stored, nil
+}
+
+func (m *mockWeaveRepository) Update(ctx context.Context, weave *entities.Weave, expectedVersion int, userID uuid.UUID, changeLog *string) (*entities.WeaveVersion, error) {
+	if m.weave.Version != expectedVersion {
+		return nil, repositories.ErrWeaveVersionConflict
+	}
+	m.weave = weave
+	return entities.NewWeaveVersion(weave.ID, userID, weave.Version, weave.Title, weave.Content, changeLog), nil
+}
+
+func TestWeaveHandler_UpdateWeave(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ownerID := uuid.New()
+	newRouter := func() (*gin.Engine, *entities.Weave) {
+		weave := entities.NewWeave(ownerID, uuid.New(), "Weekend ramen", entities.WeaveContent{
+			Type: "recipe",
+			Data: map[string]interface{}{"servings": 2},
+		})
+		weave.Version = 3
+
+		repo := &mockWeaveRepository{weave: weave}
+		service := services.NewWeaveApplicationService(repo, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+		handler := NewWeaveHandler(service)
+
+		router := gin.New()
+		router.PUT("/weaves/:id", func(c *gin.Context) {
+			c.Set("user_id", ownerID)
+			handler.UpdateWeave(c)
+		})
+		return router, weave
+	}
+
+	tests := []struct {
+		name     string
+		ifMatch  string
+		body     string
+		wantCode int
+		wantETag string
+	}{
+		{name: "current strong tag", ifMatch: `"v3"`, body: `{"title":"Midweek ramen"}`, wantCode: http.StatusOK, wantETag: `W/"v4"`},
+		{name: "current expected_version", body: `{"title":"Midweek ramen","expected_version":3}`, wantCode: http.StatusOK, wantETag: `W/"v4"`},
+		{name: "any version", ifMatch: `*`, body: `{"title":"Midweek ramen"}`, wantCode: http.StatusOK, wantETag: `W/"v4"`},
+		{name: "tag list naming the current version", ifMatch: `"v2", W/"v3", "v3"`, body: `{"title":"Midweek ramen"}`, wantCode: http.StatusOK, wantETag: `W/"v4"`},
+		{name: "stale tag", ifMatch: `"v2"`, body: `{"title":"Midweek ramen"}`, wantCode: http.StatusPreconditionFailed},
+		{name: "stale tag list", ifMatch: `"v1", "v2"`, body: `{"title":"Midweek ramen"}`, wantCode: http.StatusPreconditionFailed},
+		{name: "weak tag", ifMatch: `W/"v3"`, body: `{"title":"Midweek ramen"}`, wantCode: http.StatusPreconditionFailed},
+		{name: "stale expected_version", body: `{"title":"Midweek ramen","expected_version":2}`, wantCode: http.StatusConflict},
+		{name: "no precondition", body: `{"title":"Midweek ramen"}`, wantCode: http.StatusPreconditionRequired},
+		{name: "tag and body disagree", ifMatch: `"v3"`, body: `{"title":"Midweek ramen","expected_version":2}`, wantCode: http.StatusBadRequest},
+		{name: "unquoted tag", ifMatch: `v3`, body: `{"title":"Midweek ramen"}`, wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, weave := newRouter()
+
+			req := httptest.NewRequest(http.MethodPut, "/weaves/"+weave.ID.String(), strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("Expected status %d, got %d: %s", tt.wantCode, rec.Code, rec.Body.String())
+			}
+			if etag := rec.Header().Get("ETag"); etag != tt.wantETag {
+				t.Errorf("Expected ETag %q, got %q", tt.wantETag, etag)
+			}
+		})
+	}
+}
@@ -16,6 +16,13 @@ func SetupRoutes(router *gin.Engine, cfg *config.Config) {
 	// Get handlers from container
 	userHandler := c.UserHandler()
 	oauthHandler := c.OAuthHandler()
+	weaveHandler := c.WeaveHandler()
+	contributionHandler := c.ContributionHandler()
+	collaboratorHandler := c.CollaboratorHandler()
+	templateHandler := c.TemplateHandler()
+	collectionHandler := c.CollectionHandler()
+	tagHandler := c.TagHandler()
+	commentHandler := c.CommentHandler()
 
 	// Setup API routes
 	api := router.Group("/v1/api")
@@ -50,6 +57,7 @@ func SetupRoutes(router *gin.Engine, cfg *config.Config) {
 			users.GET("/:id", userHandler.GetUserByID)
 			users.GET("/:id/followers", userHandler.GetFollowers)
 			users.GET("/:id/following", userHandler.GetFollowing)
+			users.GET("/:id/collections", middleware.OptionalAuthMiddleware(cfg), collectionHandler.ListUserCollections) // Private ones too for their owner
 
 			// Protected routes (require authentication)
 			protected := users.Group("", middleware.AuthMiddleware(cfg))
@@ -64,49 +72,115 @@ func SetupRoutes(router *gin.Engine, cfg *config.Config) {
 		// Weave routes
 		weaves := api.Group("/weaves")
 		{
-			// Public routes
-			weaves.GET("", nil)                      // Get published weaves (with pagination)
-			weaves.GET("/featured", nil)             // Get featured weaves
-			weaves.GET("/trending", nil)             // Get trending weaves
-			weaves.GET("/popular", nil)              // Get popular weaves
-			weaves.GET("/search", nil)               // Search weaves
-			weaves.GET("/:id", nil)                  // Get weave by ID
-			weaves.GET("/:id/forks", nil)            // Get weave forks
-			weaves.GET("/:id/versions", nil)         // Get weave versions
-			weaves.GET("/:id/versions/:version", nil) // Get specific version
+			// Public routes (drafts are visible to their owner when a token is supplied)
+			public := weaves.Group("", middleware.OptionalAuthMiddleware(cfg))
+			{
+				public.GET("", weaveHandler.GetPublishedWeaves)                                     // Get published weaves (with pagination)
+				public.GET("/featured", weaveHandler.GetFeaturedWeaves)                             // Get featured weaves
+				public.GET("/trending", weaveHandler.GetTrendingWeaves)                             // Get trending weaves
+				public.GET("/popular", weaveHandler.GetPopularWeaves)                               // Get popular weaves
+				public.GET("/search", weaveHandler.SearchWeaves)                                    // Search weaves
+				public.GET("/templates", templateHandler.ListTemplates)                             // Browse templates, optionally by channel
+				public.GET("/:id", weaveHandler.GetWeave)                                           // Get weave by ID
+				public.GET("/:id/lineage", weaveHandler.GetWeaveLineage)                            // Get fork family tree
+				public.GET("/:id/upstream", weaveHandler.GetUpstreamStatus)                         // Fork ahead/behind its parent
+				public.GET("/:id/blame", weaveHandler.GetWeaveBlame)                                // Who last changed each field
+				public.GET("/:id/export", weaveHandler.ExportWeave)                                 // Markdown, HTML or JSON bundle download
+				public.GET("/:id/forks", weaveHandler.GetWeaveForks)                                // Get weave forks
+				public.GET("/:id/collaborators", collaboratorHandler.ListCollaborators)             // Get owner and collaborators
+				public.GET("/:id/versions", weaveHandler.GetWeaveVersions)                          // Get weave versions
+				public.GET("/:id/versions/:version", weaveHandler.GetWeaveVersion)                  // Get specific version
+				public.GET("/:id/versions/:version/diff/:target", weaveHandler.GetWeaveVersionDiff) // Diff between two versions
+			}
 
 			// Protected routes (require authentication)
 			protected := weaves.Group("", middleware.AuthMiddleware(cfg))
 			{
-				protected.POST("", nil)                    // Create weave
-				protected.PUT("/:id", nil)                 // Update weave
-				protected.DELETE("/:id", nil)              // Delete weave
-				protected.POST("/:id/fork", nil)           // Fork weave
-				protected.POST("/:id/like", nil)           // Like weave
-				protected.DELETE("/:id/like", nil)         // Unlike weave
-				protected.POST("/:id/publish", nil)        // Publish weave
-				protected.POST("/:id/unpublish", nil)      // Unpublish weave
-				protected.GET("/drafts", nil)              // Get user's drafts
-				protected.GET("/liked", nil)               // Get liked weaves
+				protected.POST("", weaveHandler.CreateWeave)                                           // Create weave
+				protected.POST("/import", weaveHandler.ImportWeave)                                    // Import from a JSON bundle or Markdown
+				protected.PUT("/:id", weaveHandler.UpdateWeave)                                        // Update weave
+				protected.DELETE("/:id", weaveHandler.DeleteWeave)                                     // Move weave to the trash
+				protected.POST("/:id/restore", weaveHandler.RestoreWeave)                              // Restore weave from the trash
+				protected.POST("/:id/fork", weaveHandler.ForkWeave)                                    // Fork weave
+				protected.POST("/:id/like", weaveHandler.LikeWeave)                                    // Like weave
+				protected.DELETE("/:id/like", weaveHandler.UnlikeWeave)                                // Unlike weave
+				protected.POST("/:id/publish", weaveHandler.PublishWeave)                              // Publish weave
+				protected.POST("/:id/unpublish", weaveHandler.UnpublishWeave)                          // Unpublish weave
+				protected.PUT("/:id/status", weaveHandler.ChangeWeaveStatus)                           // Move weave to another status
+				protected.PUT("/:id/schedule", weaveHandler.SchedulePublication)                       // Schedule or reschedule publication
+				protected.DELETE("/:id/schedule", weaveHandler.CancelScheduledPublication)             // Cancel scheduled publication
+				protected.PUT("/:id/template", templateHandler.MarkTemplate)                           // Offer weave as a template
+				protected.DELETE("/:id/template", templateHandler.UnmarkTemplate)                      // Withdraw weave from the templates
+				protected.POST("/:id/versions/:version/revert", weaveHandler.RevertWeave)              // Revert to an earlier version
+				protected.POST("/:id/sync", weaveHandler.SyncWeave)                                    // Sync fork from upstream
+				protected.POST("/:id/collaborators", collaboratorHandler.InviteCollaborator)           // Invite a collaborator
+				protected.POST("/:id/collaborators/accept", collaboratorHandler.AcceptCollaboration)   // Accept an invitation
+				protected.DELETE("/:id/collaborators/:userId", collaboratorHandler.RemoveCollaborator) // Remove, decline or leave
+				protected.GET("/drafts", weaveHandler.GetDrafts)                                       // Get user's drafts
+				protected.GET("/trash", weaveHandler.GetTrash)                                         // Get user's deleted weaves
+				protected.GET("/liked", weaveHandler.GetLikedWeaves)                                   // Get liked weaves
+			}
+		}
+
+		// Collection routes
+		collections := api.Group("/collections")
+		{
+			// Public routes (private collections are visible to their owner when a token is supplied)
+			public := collections.Group("", middleware.OptionalAuthMiddleware(cfg))
+			{
+				public.GET("/:id", collectionHandler.GetCollection)              // Get collection by ID
+				public.GET("/:id/weaves", collectionHandler.GetCollectionWeaves) // Get weaves in collection order
+			}
+
+			// Protected routes (require authentication)
+			protected := collections.Group("", middleware.AuthMiddleware(cfg))
+			{
+				protected.POST("", collectionHandler.CreateCollection)                  // Create collection
+				protected.PUT("/:id", collectionHandler.UpdateCollection)               // Rename or change visibility
+				protected.DELETE("/:id", collectionHandler.DeleteCollection)            // Delete collection
+				protected.POST("/:id/weaves", collectionHandler.AddWeave)               // Add a weave
+				protected.PUT("/:id/weaves/order", collectionHandler.ReorderWeaves)     // Reorder weaves
+				protected.DELETE("/:id/weaves/:weaveId", collectionHandler.RemoveWeave) // Remove a weave
+			}
+		}
+
+		// Tag routes (names are normalized, so any spelling or alias of a tag works)
+		tags := api.Group("/tags")
+		{
+			// Public routes
+			tags.GET("", tagHandler.AutocompleteTags)             // Suggest tags for a prefix
+			tags.GET("/:name/weaves", tagHandler.GetTagWeaves)    // Get published weaves with the tag
+			tags.GET("/:name/related", tagHandler.GetRelatedTags) // Get tags used together with the tag
+
+			// Protected routes (moderators only)
+			protected := tags.Group("", middleware.AuthMiddleware(cfg))
+			{
+				protected.POST("/:name/merge", tagHandler.MergeTags) // Merge a duplicate tag into another
 			}
 		}
 
+		// Content type schemas for the weave editor
+		api.GET("/content-types", weaveHandler.GetContentTypes)
+
 		// Channel routes
 		channels := api.Group("/channels")
 		{
 			// Public routes
-			channels.GET("", nil)           // Get public channels
-			channels.GET("/:id", nil)       // Get channel by ID
-			channels.GET("/:id/weaves", nil) // Get weaves in channel
+			channels.GET("", nil)                                                // Get public channels
+			channels.GET("/:id", nil)                                            // Get channel by ID
+			channels.GET("/:id/weaves", nil)                                     // Get weaves in channel
+			channels.GET("/:id/templates", templateHandler.ListChannelTemplates) // Get official templates
 
 			// Protected routes
 			protected := channels.Group("", middleware.AuthMiddleware(cfg))
 			{
-				protected.POST("", nil)         // Create channel
-				protected.PUT("/:id", nil)      // Update channel
-				protected.DELETE("/:id", nil)   // Delete channel
-				protected.POST("/:id/join", nil) // Join channel
-				protected.DELETE("/:id/leave", nil) // Leave channel
+				protected.POST("", nil)                                                    // Create channel
+				protected.PUT("/:id", nil)                                                 // Update channel
+				protected.DELETE("/:id", nil)                                              // Delete channel
+				protected.POST("/:id/join", nil)                                           // Join channel
+				protected.DELETE("/:id/leave", nil)                                        // Leave channel
+				protected.POST("/:id/templates", templateHandler.PinTemplate)              // Pin an official template
+				protected.DELETE("/:id/templates/:weaveId", templateHandler.UnpinTemplate) // Unpin an official template
 			}
 		}
 
@@ -115,14 +189,33 @@ func SetupRoutes(router *gin.Engine, cfg *config.Config) {
 		{
 			protected := collaborations.Group("", middleware.AuthMiddleware(cfg))
 			{
-				protected.POST("/weaves/:id/contributions", nil)     // Create contribution
-				protected.GET("/weaves/:id/contributions", nil)      // Get contributions for weave
-				protected.PUT("/contributions/:id", nil)             // Update contribution
-				protected.DELETE("/contributions/:id", nil)          // Delete contribution
-				protected.POST("/contributions/:id/review", nil)     // Review contribution
-				protected.POST("/contributions/:id/merge", nil)      // Merge contribution
-				protected.POST("/weaves/:id/comments", nil)          // Add comment to weave
-				protected.GET("/weaves/:id/comments", nil)           // Get comments for weave
+				protected.POST("/weaves/:id/contributions", nil)                                                // Create contribution
+				protected.GET("/weaves/:id/contributions", nil)                                                 // Get contributions for weave
+				protected.POST("/weaves/:id/merge-requests", contributionHandler.CreateMergeRequest)            // Propose a fork back upstream
+				protected.POST("/weaves/:id/suggestions", contributionHandler.CreateSuggestion)                 // Suggest a change to one value
+				protected.GET("/weaves/:id/merge-policy", contributionHandler.GetMergePolicy)                   // Get merge policy
+				protected.PUT("/weaves/:id/merge-policy", contributionHandler.UpdateMergePolicy)                // Set merge policy
+				protected.PUT("/contributions/:id", nil)                                                        // Update contribution
+				protected.DELETE("/contributions/:id", nil)                                                     // Delete contribution
+				protected.POST("/contributions/:id/review", contributionHandler.ReviewContribution)             // Review contribution
+				protected.GET("/contributions/:id/reviews", contributionHandler.GetContributionReviews)         // Get reviews and merge readiness
+				protected.PUT("/contributions/:id/reviewer", contributionHandler.AssignReviewer)                // Assign reviewer
+				protected.PUT("/contributions/:id/vote", contributionHandler.VoteContribution)                  // Vote on contribution
+				protected.DELETE("/contributions/:id/vote", contributionHandler.RemoveContributionVote)         // Withdraw vote
+				protected.POST("/contributions/:id/merge", contributionHandler.MergeContribution)               // Merge contribution
+				protected.POST("/contributions/:id/accept", contributionHandler.AcceptSuggestion)               // Accept suggestion as a new version
+				protected.GET("/contributions/:id/conflicts", contributionHandler.GetContributionConflicts)     // Get conflicts with latest version
+				protected.PUT("/contributions/:id/conflicts", contributionHandler.ResolveContributionConflicts) // Resolve conflicts and rebase
+				protected.POST("/weaves/:id/comments", commentHandler.CreateComment)                            // Add comment to weave
+				protected.GET("/weaves/:id/comments", commentHandler.ListComments)                              // Get comments for weave
+				protected.GET("/weaves/:id/issues", commentHandler.GetIssueSummary)                             // Open issues summary for the owner
+				protected.PUT("/comments/:id", commentHandler.EditComment)                                      // Edit comment
+				protected.GET("/comments/:id/thread", commentHandler.GetCommentThread)                          // Get comment with its replies
+				protected.GET("/comments/:id/history", commentHandler.GetCommentHistory)                        // Get comment edit history
+				protected.POST("/comments/:id/resolve", commentHandler.ResolveComment)                          // Resolve thread
+				protected.POST("/comments/:id/reopen", commentHandler.ReopenComment)                            // Reopen thread
+				protected.POST("/comments/:id/like", commentHandler.LikeComment)                                // Like comment
+				protected.DELETE("/comments/:id/like", commentHandler.UnlikeComment)                            // Unlike comment
 			}
 		}
 
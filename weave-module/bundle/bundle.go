@@ -0,0 +1,127 @@
+// Package bundle defines the JSON archive a weave is exported to and imported from.
+// A bundle carries the current state of a weave together with its full version
+// history and timeline, so the weave can be archived or recreated elsewhere.
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Format identifies weave bundles among other JSON documents
+	Format = "weave-bundle"
+	// CurrentVersion is the bundle layout written by this code
+	CurrentVersion = 1
+)
+
+// Bundle is a self-contained archive of a single weave
+type Bundle struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	Source     Source    `json:"source"`
+	Weave      Weave     `json:"weave"`
+	Versions   []Version `json:"versions"` // Oldest first
+	Timeline   []Event   `json:"timeline"` // Oldest first
+}
+
+// Source records where a bundle was exported from
+type Source struct {
+	WeaveID        uuid.UUID `json:"weave_id"`
+	ChannelID      uuid.UUID `json:"channel_id"`
+	AuthorID       uuid.UUID `json:"author_id"`
+	AuthorUsername string    `json:"author_username,omitempty"`
+}
+
+// Content mirrors the structured content stored with weaves and versions
+type Content struct {
+	Type          string                 `json:"type"`
+	SchemaVersion int                    `json:"schema_version,omitempty"`
+	Data          map[string]interface{} `json:"data"`
+}
+
+// Weave is the state of the weave at export time
+type Weave struct {
+	Title       string     `json:"title"`
+	Description *string    `json:"description,omitempty"`
+	CoverImage  *string    `json:"cover_image,omitempty"`
+	Content     Content    `json:"content"`
+	Version     int        `json:"version"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// Version is one entry of the weave's version history
+type Version struct {
+	Version   int       `json:"version"`
+	Title     string    `json:"title"`
+	Content   Content   `json:"content"`
+	ChangeLog *string   `json:"change_log,omitempty"`
+	AuthorID  uuid.UUID `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Event is one entry of the weave's timeline
+type Event struct {
+	Type        string                 `json:"type"`
+	Title       string                 `json:"title"`
+	Description *string                `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	UserID      uuid.UUID              `json:"user_id"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
+// Parse decodes and validates a bundle
+func Parse(data []byte) (*Bundle, error) {
+	var b Bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("invalid bundle JSON: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Validate checks that the bundle is one this code can read and that its
+// history is consistent: versions are unique and in ascending order.
+func (b *Bundle) Validate() error {
+	if b.Format != Format {
+		return fmt.Errorf("not a weave bundle (format %q)", b.Format)
+	}
+	if b.Version < 1 || b.Version > CurrentVersion {
+		return fmt.Errorf("unsupported bundle version %d", b.Version)
+	}
+	if b.Weave.Title == "" {
+		return fmt.Errorf("weave title is required")
+	}
+	if b.Weave.Content.Type == "" {
+		return fmt.Errorf("weave content type is required")
+	}
+
+	previous := 0
+	for i, version := range b.Versions {
+		if version.Version <= previous {
+			return fmt.Errorf("versions[%d]: version %d is out of order", i, version.Version)
+		}
+		if version.Content.Type == "" {
+			return fmt.Errorf("versions[%d]: content type is required", i)
+		}
+		previous = version.Version
+	}
+	return nil
+}
+
+// Latest returns the newest version in the history, or nil without history
+func (b *Bundle) Latest() *Version {
+	if len(b.Versions) == 0 {
+		return nil
+	}
+	return &b.Versions[len(b.Versions)-1]
+}
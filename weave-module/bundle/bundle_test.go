@@ -0,0 +1,75 @@
+package bundle
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newBundle() *Bundle {
+	content := Content{Type: "recipe", Data: map[string]interface{}{"servings": 2.0}}
+	return &Bundle{
+		Format:     Format,
+		Version:    CurrentVersion,
+		ExportedAt: time.Now(),
+		Source:     Source{WeaveID: uuid.New(), ChannelID: uuid.New(), AuthorID: uuid.New()},
+		Weave:      Weave{Title: "Kimchi stew", Content: content, Version: 2, Status: "published"},
+		Versions: []Version{
+			{Version: 1, Title: "Kimchi stew", Content: content},
+			{Version: 2, Title: "Kimchi stew", Content: content},
+		},
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	data, err := json.Marshal(newBundle())
+	if err != nil {
+		t.Fatalf("Expected bundle to marshal, got %v", err)
+	}
+
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Expected bundle to parse, got %v", err)
+	}
+	if parsed.Weave.Title != "Kimchi stew" || len(parsed.Versions) != 2 {
+		t.Errorf("Expected weave and history to survive the round trip, got %+v", parsed)
+	}
+	if latest := parsed.Latest(); latest == nil || latest.Version != 2 {
+		t.Errorf("Expected latest version 2, got %+v", latest)
+	}
+}
+
+func TestValidate_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(b *Bundle)
+		want   string
+	}{
+		{"foreign format", func(b *Bundle) { b.Format = "other" }, "not a weave bundle"},
+		{"newer version", func(b *Bundle) { b.Version = CurrentVersion + 1 }, "unsupported bundle version"},
+		{"missing title", func(b *Bundle) { b.Weave.Title = "" }, "title is required"},
+		{"missing content type", func(b *Bundle) { b.Weave.Content.Type = "" }, "content type is required"},
+		{"duplicate version", func(b *Bundle) { b.Versions[1].Version = 1 }, "out of order"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBundle()
+			tt.modify(b)
+
+			err := b.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestParse_InvalidJSON(t *testing.T) {
+	if _, err := Parse([]byte("# Kimchi stew")); err == nil {
+		t.Error("Expected non-JSON input to be rejected")
+	}
+}
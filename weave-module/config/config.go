@@ -14,6 +14,7 @@ type Config struct {
 	JWT      JWTConfig
 	OAuth    OAuthConfig
 	External ExternalConfig
+	Trash    TrashConfig
 }
 
 type AppConfig struct {
@@ -67,6 +68,11 @@ type GoogleOAuthConfig struct {
 	Scopes       string `json:"scopes"`
 }
 
+// TrashConfig controls how long deleted weaves can be restored
+type TrashConfig struct {
+	RetentionDays int
+}
+
 type ExternalConfig struct {
 	AWS   AWSConfig
 	Email EmailConfig
@@ -143,6 +149,9 @@ func Load() *Config {
 				SMTPPassword: getEnv("SMTP_PASSWORD", ""),
 			},
 		},
+		Trash: TrashConfig{
+			RetentionDays: getEnvAsInt("TRASH_RETENTION_DAYS", 30),
+		},
 	}
 }
 
@@ -0,0 +1,137 @@
+// Package contentschema upgrades stored weave content when the schema of its
+// content type changes. Weaves live for years, so content written against an
+// old schema is upgraded step by step, one version at a time.
+package contentschema
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Upgrade converts content data from one schema version to the next.
+// It may modify data in place and return it.
+type Upgrade func(data map[string]interface{}) (map[string]interface{}, error)
+
+// Migrator holds the upgrade chain of each content type. Content without a
+// recorded schema version is treated as version 1.
+type Migrator struct {
+	mu       sync.RWMutex
+	upgrades map[string][]Upgrade // upgrades[type][i] moves data from v(i+1) to v(i+2)
+}
+
+// NewMigrator creates a migrator without any upgrades
+func NewMigrator() *Migrator {
+	return &Migrator{
+		upgrades: make(map[string][]Upgrade),
+	}
+}
+
+// Register adds the upgrade from schema version from to from+1. Upgrades must
+// be registered in order, so from has to be the type's current version.
+func (m *Migrator) Register(contentType string, from int, upgrade Upgrade) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current := len(m.upgrades[contentType]) + 1
+	if from != current {
+		return fmt.Errorf("upgrade for %q must start at v%d, got v%d", contentType, current, from)
+	}
+	m.upgrades[contentType] = append(m.upgrades[contentType], upgrade)
+	return nil
+}
+
+// CurrentVersion returns the latest schema version of a content type
+func (m *Migrator) CurrentVersion(contentType string) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.upgrades[contentType]) + 1
+}
+
+// Types lists the content types that have at least one upgrade
+func (m *Migrator) Types() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	types := make([]string, 0, len(m.upgrades))
+	for contentType := range m.upgrades {
+		types = append(types, contentType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Upgrade brings data written against the given schema version up to the
+// current one and returns it with its new version. Content from a newer
+// schema than this migrator knows is refused rather than guessed at.
+func (m *Migrator) Upgrade(contentType string, version int, data map[string]interface{}) (map[string]interface{}, int, error) {
+	if version < 1 {
+		version = 1
+	}
+
+	m.mu.RLock()
+	chain := m.upgrades[contentType]
+	m.mu.RUnlock()
+
+	current := len(chain) + 1
+	if version > current {
+		return nil, version, fmt.Errorf("%s content is at schema v%d, newer than the supported v%d", contentType, version, current)
+	}
+
+	for ; version < current; version++ {
+		upgraded, err := chain[version-1](data)
+		if err != nil {
+			return nil, version, fmt.Errorf("failed to upgrade %s content from schema v%d: %w", contentType, version, err)
+		}
+		data = upgraded
+	}
+	return data, version, nil
+}
+
+// UpgradeDocument upgrades a raw content document of the form
+// {"type": ..., "schema_version": ..., "data": {...}} in place.
+// It reports whether anything changed.
+func (m *Migrator) UpgradeDocument(document map[string]interface{}) (bool, error) {
+	contentType, _ := document["type"].(string)
+	version := 1
+	switch raw := document["schema_version"].(type) {
+	case float64: // Decoded from JSON
+		version = int(raw)
+	case int:
+		version = raw
+	}
+	if version >= m.CurrentVersion(contentType) {
+		return false, nil
+	}
+
+	data, _ := document["data"].(map[string]interface{})
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	upgraded, newVersion, err := m.Upgrade(contentType, version, data)
+	if err != nil {
+		return false, err
+	}
+	document["data"] = upgraded
+	document["schema_version"] = newVersion
+	return true, nil
+}
+
+var defaultMigrator = newDefaultMigrator()
+
+// Default returns the migrator holding the upgrades of the built-in content types
+func Default() *Migrator {
+	return defaultMigrator
+}
+
+// newDefaultMigrator registers the upgrades of the built-in content types.
+// Every built-in type is still at schema v1; when one of their schemas
+// changes, register the upgrade from the previous version here, e.g.
+//
+//	m.Register("recipe", 1, splitIngredientAmounts)
+func newDefaultMigrator() *Migrator {
+	m := NewMigrator()
+	return m
+}
@@ -0,0 +1,123 @@
+package contentschema
+
+import (
+	"fmt"
+	"testing"
+)
+
+func newRecipeMigrator(t *testing.T) *Migrator {
+	m := NewMigrator()
+
+	// v1 -> v2: "time" was split into prep and cook time
+	err := m.Register("recipe", 1, func(data map[string]interface{}) (map[string]interface{}, error) {
+		if minutes, ok := data["time"]; ok {
+			data["cook_time"] = minutes
+			data["prep_time"] = 0.0
+			delete(data, "time")
+		}
+		return data, nil
+	})
+	if err != nil {
+		t.Fatalf("Expected v1 upgrade to register, got %v", err)
+	}
+
+	// v2 -> v3: servings became mandatory
+	err = m.Register("recipe", 2, func(data map[string]interface{}) (map[string]interface{}, error) {
+		if _, ok := data["servings"]; !ok {
+			data["servings"] = 1.0
+		}
+		return data, nil
+	})
+	if err != nil {
+		t.Fatalf("Expected v2 upgrade to register, got %v", err)
+	}
+	return m
+}
+
+func TestMigrator_UpgradeAppliesChainInOrder(t *testing.T) {
+	m := newRecipeMigrator(t)
+
+	data, version, err := m.Upgrade("recipe", 1, map[string]interface{}{"time": 30.0})
+	if err != nil {
+		t.Fatalf("Expected upgrade to succeed, got %v", err)
+	}
+	if version != 3 {
+		t.Errorf("Expected version 3, got %d", version)
+	}
+	if data["cook_time"] != 30.0 || data["servings"] != 1.0 {
+		t.Errorf("Expected both upgrades to apply, got %v", data)
+	}
+	if _, ok := data["time"]; ok {
+		t.Error("Expected time to be removed")
+	}
+}
+
+func TestMigrator_UpgradeStartsFromGivenVersion(t *testing.T) {
+	m := newRecipeMigrator(t)
+
+	// Already at v2, so "time" is not a legacy field any more
+	data, version, err := m.Upgrade("recipe", 2, map[string]interface{}{"time": "evening"})
+	if err != nil {
+		t.Fatalf("Expected upgrade to succeed, got %v", err)
+	}
+	if version != 3 || data["time"] != "evening" {
+		t.Errorf("Expected only the v2 upgrade to apply, got v%d %v", version, data)
+	}
+}
+
+func TestMigrator_UpgradeRefusesNewerContent(t *testing.T) {
+	m := newRecipeMigrator(t)
+
+	if _, _, err := m.Upgrade("recipe", 4, map[string]interface{}{}); err == nil {
+		t.Error("Expected content from a newer schema to be refused")
+	}
+}
+
+func TestMigrator_UpgradeReportsFailingStep(t *testing.T) {
+	m := NewMigrator()
+	m.Register("workout", 1, func(data map[string]interface{}) (map[string]interface{}, error) {
+		return nil, fmt.Errorf("exercises missing")
+	})
+
+	_, version, err := m.Upgrade("workout", 1, map[string]interface{}{})
+	if err == nil || version != 1 {
+		t.Errorf("Expected failure at v1, got v%d %v", version, err)
+	}
+}
+
+func TestMigrator_RegisterRequiresOrder(t *testing.T) {
+	m := NewMigrator()
+
+	if err := m.Register("recipe", 2, nil); err == nil {
+		t.Error("Expected an upgrade skipping v1 to be refused")
+	}
+}
+
+func TestMigrator_UpgradeDocument(t *testing.T) {
+	m := newRecipeMigrator(t)
+
+	document := map[string]interface{}{
+		"type": "recipe",
+		"data": map[string]interface{}{"time": 10.0},
+	}
+	changed, err := m.UpgradeDocument(document)
+	if err != nil || !changed {
+		t.Fatalf("Expected document to be upgraded, got %v %v", changed, err)
+	}
+	if document["schema_version"] != 3 {
+		t.Errorf("Expected schema_version 3, got %v", document["schema_version"])
+	}
+
+	changed, err = m.UpgradeDocument(document)
+	if err != nil || changed {
+		t.Errorf("Expected current document to be left alone, got %v %v", changed, err)
+	}
+}
+
+func TestMigrator_UnknownTypesAreCurrent(t *testing.T) {
+	m := newRecipeMigrator(t)
+
+	if m.CurrentVersion("playlist") != 1 {
+		t.Errorf("Expected unversioned types to be at v1")
+	}
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-module/diff"
+	"weave-module/models"
+)
+
+// ReanchorComments moves the anchors of a weave's lab comments from the old
+// content to where it ended up in the new content, now stored as version.
+// Resolved comments are carried along too so they are still in place when
+// reopened. Comments whose anchored value or text is gone are marked outdated
+// and keep their old anchor. It must run inside the transaction that stores the
+// version.
+func ReanchorComments(tx *gorm.DB, weaveID uuid.UUID, oldContent, newContent string, version int) error {
+	var comments []models.LabComment
+	err := tx.Select("id", "content_position").
+		Where("weave_id = ? AND content_position IS NOT NULL", weaveID).
+		Where("is_outdated = ?", false).
+		Find(&comments).Error
+	if err != nil || len(comments) == 0 {
+		return err
+	}
+
+	var oldDoc, newDoc interface{}
+	if err := json.Unmarshal([]byte(oldContent), &oldDoc); err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(newContent), &newDoc); err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		var anchor diff.Anchor
+		if err := json.Unmarshal([]byte(*comment.ContentPosition), &anchor); err != nil || anchor.Path == "" {
+			continue
+		}
+
+		// Columns are updated directly so re-anchoring does not count as an edit
+		updates := map[string]interface{}{"is_outdated": true}
+		if tracked, ok := anchor.Track(oldDoc, newDoc); ok {
+			tracked.Version = version
+			position, err := json.Marshal(tracked)
+			if err != nil {
+				return err
+			}
+			updates = map[string]interface{}{"content_position": string(position)}
+		}
+		if err := tx.Model(&models.LabComment{}).Where("id = ?", comment.ID).UpdateColumns(updates).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
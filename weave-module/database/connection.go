@@ -38,6 +38,14 @@ func Migrate() error {
 		return fmt.Errorf("database connection not established")
 	}
 
+	// collection_weaves carries the position of each weave within its collection
+	if err := DB.SetupJoinTable(&models.WeaveCollection{}, "Weaves", &models.CollectionWeave{}); err != nil {
+		return fmt.Errorf("failed to set up collection weaves: %w", err)
+	}
+	if err := DB.SetupJoinTable(&models.Weave{}, "Collections", &models.CollectionWeave{}); err != nil {
+		return fmt.Errorf("failed to set up collection weaves: %w", err)
+	}
+
 	err := DB.AutoMigrate(
 		// User models
 		&models.User{},
@@ -53,13 +61,20 @@ func Migrate() error {
 		&models.WeaveTimeline{},
 		&models.WeaveLike{},
 		&models.WeaveTag{},
+		&models.WeaveTagAlias{},
 		&models.WeaveCollection{},
+		&models.ChannelTemplate{},
 		
 		// Collaboration models
 		&models.Contribution{},
+		&models.WeaveCollaborator{},
 		&models.ContributionComment{},
 		&models.ContributionVote{},
+		&models.ContributionReview{},
+		&models.WeaveMergePolicy{},
 		&models.LabComment{},
+		&models.LabCommentRevision{},
+		&models.LabCommentLike{},
 		
 		// Analytics models
 		&models.WeaveView{},
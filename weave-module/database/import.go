@@ -0,0 +1,149 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-module/bundle"
+	"weave-module/diff"
+	"weave-module/models"
+)
+
+// ImportBundle creates a draft weave owned by userID in channelID from a bundle.
+// It must run inside a transaction, and the bundle must already be validated.
+//
+// The history is restored as new versions owned by the importer, numbered from 1
+// and keeping their original timestamps and change logs. If the bundle's current
+// content differs from its latest version, it is recorded as one more version.
+// Where the weave came from is recorded in the metadata of its created event,
+// along with importID so retried imports can be recognised with FindImportedWeave.
+func ImportBundle(tx *gorm.DB, b *bundle.Bundle, userID, channelID, importID uuid.UUID) (*models.Weave, error) {
+	history := append([]bundle.Version(nil), b.Versions...)
+	if latest := b.Latest(); latest == nil || latest.Title != b.Weave.Title || !sameContent(latest.Content, b.Weave.Content) {
+		changeLog := "Imported"
+		history = append(history, bundle.Version{
+			Title:     b.Weave.Title,
+			Content:   b.Weave.Content,
+			ChangeLog: &changeLog,
+		})
+	}
+
+	content, err := json.Marshal(b.Weave.Content)
+	if err != nil {
+		return nil, err
+	}
+	weave := &models.Weave{
+		UserID:      userID,
+		ChannelID:   channelID,
+		Title:       b.Weave.Title,
+		Description: b.Weave.Description,
+		CoverImage:  b.Weave.CoverImage,
+		Content:     string(content),
+		Status:      models.WeaveStatusDraft,
+		Type:        models.WeaveTypeOriginal,
+		Version:     len(history),
+	}
+	if err := tx.Create(weave).Error; err != nil {
+		return nil, err
+	}
+
+	var previous *bundle.Content
+	for i, entry := range history {
+		if err := createImportedVersion(tx, weave, i+1, entry, previous); err != nil {
+			return nil, err
+		}
+		previous = &history[i].Content
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"import_id":        importID,
+		"imported_from":    b.Source.WeaveID,
+		"source_channel":   b.Source.ChannelID,
+		"source_author_id": b.Source.AuthorID,
+		"source_author":    b.Source.AuthorUsername,
+		"source_version":   b.Weave.Version,
+		"exported_at":      b.ExportedAt,
+		"bundle_version":   b.Version,
+		"versions":         len(history),
+	})
+	if err != nil {
+		return nil, err
+	}
+	serializedMetadata := string(metadata)
+	description := "Imported from a weave bundle"
+	timeline := &models.WeaveTimeline{
+		WeaveID:     weave.ID,
+		UserID:      userID,
+		EventType:   models.TimelineCreated,
+		Title:       "Weave imported",
+		Description: &description,
+		Metadata:    &serializedMetadata,
+	}
+	if err := tx.Create(timeline).Error; err != nil {
+		return nil, err
+	}
+
+	return weave, nil
+}
+
+// FindImportedWeave returns the ID of the weave created by an import, or uuid.Nil if it has not run
+func FindImportedWeave(tx *gorm.DB, importID uuid.UUID) (uuid.UUID, error) {
+	var timeline models.WeaveTimeline
+	err := tx.Select("weave_id").
+		Where("event_type = ? AND metadata->>'import_id' = ?", models.TimelineCreated, importID.String()).
+		Limit(1).
+		Find(&timeline).Error
+	return timeline.WeaveID, err
+}
+
+func createImportedVersion(tx *gorm.DB, weave *models.Weave, number int, entry bundle.Version, previous *bundle.Content) error {
+	content, err := json.Marshal(entry.Content)
+	if err != nil {
+		return err
+	}
+
+	version := &models.WeaveVersion{
+		WeaveID:   weave.ID,
+		UserID:    weave.UserID,
+		Version:   number,
+		Title:     entry.Title,
+		Content:   string(content),
+		ChangeLog: entry.ChangeLog,
+		CreatedAt: entry.CreatedAt, // Zero times are filled in on create
+	}
+	if number == weave.Version {
+		version.Description = weave.Description
+	}
+	if version.CreatedAt.After(time.Now()) {
+		version.CreatedAt = time.Time{}
+	}
+
+	// Diffs are recomputed rather than trusted from the bundle
+	if previous != nil {
+		contentDiff, err := diff.Compare(previous, entry.Content)
+		if err != nil {
+			return err
+		}
+		serialized, err := contentDiff.JSON()
+		if err != nil {
+			return err
+		}
+		version.ContentDiff = &serialized
+	}
+
+	return tx.Create(version).Error
+}
+
+func sameContent(a, b bundle.Content) bool {
+	left, err := diff.Normalize(a)
+	if err != nil {
+		return false
+	}
+	right, err := diff.Normalize(b)
+	if err != nil {
+		return false
+	}
+	return diff.Equal(left, right)
+}
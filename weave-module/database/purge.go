@@ -0,0 +1,74 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-module/models"
+)
+
+// PurgeWeave permanently removes a weave and every row that belongs to it.
+// It must run inside a transaction. Forks and merge requests made from the weave
+// survive but lose their link to it.
+func PurgeWeave(tx *gorm.DB, weaveID uuid.UUID) error {
+	// Remove dependent rows first to respect foreign key constraints
+	contributionIDs := tx.Model(&models.Contribution{}).Select("id").Where("weave_id = ?", weaveID)
+	if err := tx.Where("contribution_id IN (?)", contributionIDs).Delete(&models.ContributionVote{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("contribution_id IN (?)", contributionIDs).Delete(&models.ContributionComment{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("contribution_id IN (?)", contributionIDs).Delete(&models.ContributionReview{}).Error; err != nil {
+		return err
+	}
+	commentIDs := tx.Model(&models.LabComment{}).Select("id").Where("weave_id = ?", weaveID)
+	if err := tx.Where("comment_id IN (?)", commentIDs).Delete(&models.LabCommentLike{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("comment_id IN (?)", commentIDs).Delete(&models.LabCommentRevision{}).Error; err != nil {
+		return err
+	}
+
+	dependents := []interface{}{
+		&models.Contribution{},
+		&models.WeaveCollaborator{},
+		&models.WeaveMergePolicy{},
+		&models.ChannelTemplate{},
+		&models.LabComment{},
+		&models.WeaveLike{},
+		&models.WeaveTimeline{},
+		&models.WeaveVersion{},
+		&models.WeaveView{},
+		&models.WeaveAnalytics{},
+		&models.TrendingWeave{},
+	}
+	for _, dependent := range dependents {
+		if err := tx.Where("weave_id = ?", weaveID).Delete(dependent).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Exec("DELETE FROM weave_tag_relations WHERE weave_id = ?", weaveID).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM collection_weaves WHERE weave_id = ?", weaveID).Error; err != nil {
+		return err
+	}
+
+	detached := []struct {
+		model  interface{}
+		column string
+	}{
+		{&models.Weave{}, "parent_weave_id"},
+		{&models.Weave{}, "original_weave_id"},
+		{&models.Weave{}, "template_id"},
+		{&models.Contribution{}, "source_weave_id"},
+	}
+	for _, ref := range detached {
+		if err := tx.Model(ref.model).Where(ref.column+" = ?", weaveID).Update(ref.column, nil).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Delete(&models.Weave{}, "id = ?", weaveID).Error
+}
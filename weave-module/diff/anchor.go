@@ -0,0 +1,189 @@
+package diff
+
+import (
+	"errors"
+	"strconv"
+)
+
+var (
+	// ErrAnchorNotFound is returned when an anchor's path does not exist in the document
+	ErrAnchorNotFound = errors.New("anchor path does not exist")
+	// ErrInvalidAnchorRange is returned when a text range does not fit the anchored value
+	ErrInvalidAnchorRange = errors.New("anchor range does not fit the anchored text")
+)
+
+// Anchor pins a position in a JSON document: the value at a JSON Pointer and,
+// for strings, optionally a range of it. Offsets count characters (Unicode code
+// points), so the range [Start, End) means the same text in every client.
+type Anchor struct {
+	Path    string `json:"path"`
+	Start   *int   `json:"start,omitempty"`
+	End     *int   `json:"end,omitempty"`
+	Quote   string `json:"quote,omitempty"`   // Text the range covered when last anchored
+	Version int    `json:"version,omitempty"` // Revision of the document the anchor refers to
+}
+
+// HasRange reports whether the anchor points into a string rather than at a whole value
+func (a Anchor) HasRange() bool {
+	return a.Start != nil
+}
+
+// Pin checks the anchor against doc and records the text its range covers
+func (a *Anchor) Pin(doc interface{}) error {
+	value, ok := Resolve(doc, a.Path)
+	if !ok {
+		return ErrAnchorNotFound
+	}
+
+	a.Quote = ""
+	if a.Start == nil && a.End == nil {
+		return nil
+	}
+	if a.Start == nil || a.End == nil {
+		return ErrInvalidAnchorRange
+	}
+	text, ok := value.(string)
+	if !ok {
+		return ErrInvalidAnchorRange
+	}
+	runes := []rune(text)
+	if *a.Start < 0 || *a.End <= *a.Start || *a.End > len(runes) {
+		return ErrInvalidAnchorRange
+	}
+	a.Quote = string(runes[*a.Start:*a.End])
+	return nil
+}
+
+// Track follows the anchor from oldDoc to where its value ended up in newDoc.
+// List items are followed through the same alignment the diff uses, so inserting,
+// removing or reordering items around the anchored one does not lose it. A text
+// range follows its quoted text within the string. It returns false when the
+// anchored value or the quoted text no longer exists.
+func (a Anchor) Track(oldDoc, newDoc interface{}) (Anchor, bool) {
+	oldValue, newValue := oldDoc, newDoc
+	path := ""
+	for _, token := range SplitPath(a.Path) {
+		switch oldTyped := oldValue.(type) {
+		case map[string]interface{}:
+			newObj, ok := newValue.(map[string]interface{})
+			if !ok {
+				return a, false
+			}
+			oldChild, inOld := oldTyped[token]
+			newChild, inNew := newObj[token]
+			if !inOld || !inNew {
+				return a, false
+			}
+			oldValue, newValue = oldChild, newChild
+			path = JoinPath(path, token)
+		case []interface{}:
+			newList, ok := newValue.([]interface{})
+			if !ok {
+				return a, false
+			}
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(oldTyped) {
+				return a, false
+			}
+			newIndex, ok := alignLists(oldTyped, newList).follow(index)
+			if !ok {
+				return a, false
+			}
+			oldValue, newValue = oldTyped[index], newList[newIndex]
+			path = JoinIndex(path, newIndex)
+		default:
+			return a, false
+		}
+	}
+
+	tracked := a
+	tracked.Path = path
+	if !a.HasRange() {
+		return tracked, true
+	}
+
+	newText, ok := newValue.(string)
+	if !ok {
+		return a, false
+	}
+	if oldText, ok := oldValue.(string); ok && oldText == newText {
+		return tracked, true
+	}
+	start, ok := findQuote([]rune(newText), []rune(a.Quote), *a.Start)
+	if !ok {
+		return a, false
+	}
+	end := start + len([]rune(a.Quote))
+	tracked.Start, tracked.End = &start, &end
+	return tracked, true
+}
+
+// follow returns the index an old list item has in the new list
+func (l listAlignment) follow(index int) (int, bool) {
+	for _, pair := range l.anchors {
+		if pair[0] == index {
+			return pair[1], true
+		}
+	}
+	for _, pair := range l.moves {
+		if pair[0] == index {
+			return pair[1], true
+		}
+	}
+	for _, gap := range l.gaps {
+		for _, pair := range gap.paired {
+			if pair[0] == index {
+				return pair[1], true
+			}
+		}
+	}
+	return 0, false
+}
+
+// findQuote finds the occurrence of quote in text closest to where it used to start
+func findQuote(text, quote []rune, near int) (int, bool) {
+	if len(quote) == 0 {
+		return 0, false
+	}
+	best, found := 0, false
+	for i := 0; i+len(quote) <= len(text); i++ {
+		if string(text[i:i+len(quote)]) != string(quote) {
+			continue
+		}
+		if !found || distance(i, near) < distance(best, near) {
+			best, found = i, true
+		}
+	}
+	return best, found
+}
+
+func distance(a, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// Resolve returns the value at a JSON Pointer in a normalized document
+func Resolve(doc interface{}, path string) (interface{}, bool) {
+	value := doc
+	for _, token := range SplitPath(path) {
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			child, ok := typed[token]
+			if !ok {
+				return nil, false
+			}
+			value = child
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			value = typed[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
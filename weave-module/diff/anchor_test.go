@@ -0,0 +1,104 @@
+package diff
+
+import (
+	"testing"
+)
+
+func recipe(steps ...interface{}) interface{} {
+	doc, err := Normalize(map[string]interface{}{
+		"type": "recipe",
+		"data": map[string]interface{}{"steps": steps},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return doc
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func TestAnchorPin(t *testing.T) {
+	doc := recipe("Soak the rice", "Chop the 김치 finely")
+
+	anchor := Anchor{Path: "/data/steps/1", Start: intPtr(9), End: intPtr(11)}
+	if err := anchor.Pin(doc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if anchor.Quote != "김치" {
+		t.Errorf("quote = %q, want the characters in range", anchor.Quote)
+	}
+
+	missing := Anchor{Path: "/data/steps/5"}
+	if err := missing.Pin(doc); err != ErrAnchorNotFound {
+		t.Errorf("expected ErrAnchorNotFound, got %v", err)
+	}
+
+	overlong := Anchor{Path: "/data/steps/0", Start: intPtr(0), End: intPtr(50)}
+	if err := overlong.Pin(doc); err != ErrInvalidAnchorRange {
+		t.Errorf("expected ErrInvalidAnchorRange, got %v", err)
+	}
+
+	notText := Anchor{Path: "/data/steps", Start: intPtr(0), End: intPtr(1)}
+	if err := notText.Pin(doc); err != ErrInvalidAnchorRange {
+		t.Errorf("expected a range on a list to be rejected, got %v", err)
+	}
+}
+
+func TestAnchorTrackThroughListInsert(t *testing.T) {
+	oldDoc := recipe("Soak the rice", "Chop the onions", "Simmer")
+	newDoc := recipe("Preheat the pot", "Soak the rice", "Chop the onions", "Simmer")
+
+	anchor := Anchor{Path: "/data/steps/1", Start: intPtr(9), End: intPtr(15)}
+	if err := anchor.Pin(oldDoc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tracked, ok := anchor.Track(oldDoc, newDoc)
+	if !ok {
+		t.Fatal("expected the anchor to survive an insert before it")
+	}
+	if tracked.Path != "/data/steps/2" || *tracked.Start != 9 || *tracked.End != 15 {
+		t.Errorf("tracked = %s [%d,%d), want /data/steps/2 [9,15)", tracked.Path, *tracked.Start, *tracked.End)
+	}
+}
+
+func TestAnchorTrackEditedText(t *testing.T) {
+	oldDoc := recipe("Chop the onions")
+	newDoc := recipe("Finely chop the onions and garlic")
+
+	anchor := Anchor{Path: "/data/steps/0", Start: intPtr(9), End: intPtr(15)}
+	if err := anchor.Pin(oldDoc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tracked, ok := anchor.Track(oldDoc, newDoc)
+	if !ok {
+		t.Fatal("expected the quoted text to be found again")
+	}
+	if *tracked.Start != 16 || *tracked.End != 22 {
+		t.Errorf("range = [%d,%d), want the new position of %q", *tracked.Start, *tracked.End, anchor.Quote)
+	}
+
+	gone := recipe("Finely chop the leeks")
+	if _, ok := anchor.Track(oldDoc, gone); ok {
+		t.Error("expected the anchor to be lost once its text was rewritten")
+	}
+}
+
+func TestAnchorTrackRemovedValue(t *testing.T) {
+	oldDoc := recipe("Soak the rice", "Chop the onions", "Simmer")
+	newDoc := recipe("Soak the rice", "Simmer")
+
+	anchor := Anchor{Path: "/data/steps/1"}
+	if _, ok := anchor.Track(oldDoc, newDoc); ok {
+		t.Error("expected an anchor on a removed step to be lost")
+	}
+
+	kept := Anchor{Path: "/data/steps/2"}
+	tracked, ok := kept.Track(oldDoc, newDoc)
+	if !ok || tracked.Path != "/data/steps/1" {
+		t.Errorf("expected the last step to move to /data/steps/1, got %q (%v)", tracked.Path, ok)
+	}
+}
@@ -0,0 +1,132 @@
+package diff
+
+import "sort"
+
+// Attribution names the revision that last changed the value at Path
+type Attribution struct {
+	Path     string `json:"path"`
+	Revision int    `json:"revision"` // Index into the revisions passed to Blame
+}
+
+// Blame attributes every leaf of the last revision to the revision that last
+// changed it. Revisions are ordered oldest first. Leaves are scalars and empty
+// objects or lists, listed in document order. List items are followed through
+// insertions, removals and moves the same way Compare aligns them.
+func Blame(revisions ...interface{}) ([]Attribution, error) {
+	if len(revisions) == 0 {
+		return []Attribution{}, nil
+	}
+
+	var previous interface{}
+	var tree *blameNode
+	for revision, value := range revisions {
+		doc, err := Normalize(value)
+		if err != nil {
+			return nil, err
+		}
+		if revision == 0 {
+			tree = newBlameNode(doc, revision)
+		} else {
+			tree = tree.carry(previous, doc, revision)
+		}
+		previous = doc
+	}
+
+	attributions := []Attribution{}
+	tree.collect("", &attributions)
+	return attributions, nil
+}
+
+// blameNode mirrors a document, recording the revision each part came from.
+// Nodes are never modified, so unchanged subtrees are shared between revisions.
+type blameNode struct {
+	revision int
+	fields   map[string]*blameNode
+	items    []*blameNode
+}
+
+func newBlameNode(value interface{}, revision int) *blameNode {
+	node := &blameNode{revision: revision}
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		node.fields = make(map[string]*blameNode, len(typed))
+		for key, child := range typed {
+			node.fields[key] = newBlameNode(child, revision)
+		}
+	case []interface{}:
+		node.items = make([]*blameNode, len(typed))
+		for i, child := range typed {
+			node.items[i] = newBlameNode(child, revision)
+		}
+	}
+	return node
+}
+
+// carry builds the node for newValue, keeping the attribution of everything
+// that is unchanged from oldValue and crediting the rest to revision
+func (n *blameNode) carry(oldValue, newValue interface{}, revision int) *blameNode {
+	if Equal(oldValue, newValue) {
+		return n
+	}
+
+	switch newTyped := newValue.(type) {
+	case map[string]interface{}:
+		oldTyped, ok := oldValue.(map[string]interface{})
+		if !ok {
+			break
+		}
+		node := &blameNode{revision: revision, fields: make(map[string]*blameNode, len(newTyped))}
+		for key, child := range newTyped {
+			if oldChild, ok := oldTyped[key]; ok {
+				node.fields[key] = n.fields[key].carry(oldChild, child, revision)
+			} else {
+				node.fields[key] = newBlameNode(child, revision)
+			}
+		}
+		return node
+	case []interface{}:
+		oldTyped, ok := oldValue.([]interface{})
+		if !ok {
+			break
+		}
+		node := &blameNode{revision: revision, items: make([]*blameNode, len(newTyped))}
+		alignment := alignLists(oldTyped, newTyped)
+		for _, pair := range alignment.anchors {
+			node.items[pair[1]] = n.items[pair[0]]
+		}
+		for _, pair := range alignment.moves {
+			node.items[pair[1]] = n.items[pair[0]]
+		}
+		for _, gap := range alignment.gaps {
+			for _, pair := range gap.paired {
+				node.items[pair[1]] = n.items[pair[0]].carry(oldTyped[pair[0]], newTyped[pair[1]], revision)
+			}
+			for _, j := range gap.added {
+				node.items[j] = newBlameNode(newTyped[j], revision)
+			}
+		}
+		return node
+	}
+
+	return newBlameNode(newValue, revision)
+}
+
+func (n *blameNode) collect(path string, attributions *[]Attribution) {
+	switch {
+	case len(n.fields) > 0:
+		keys := make([]string, 0, len(n.fields))
+		for key := range n.fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			n.fields[key].collect(JoinPath(path, key), attributions)
+		}
+	case len(n.items) > 0:
+		for i, item := range n.items {
+			item.collect(JoinIndex(path, i), attributions)
+		}
+	default:
+		*attributions = append(*attributions, Attribution{Path: path, Revision: n.revision})
+	}
+}
@@ -0,0 +1,99 @@
+package diff
+
+import (
+	"testing"
+)
+
+func blameByPath(t *testing.T, revisions ...interface{}) map[string]int {
+	attributions, err := Blame(revisions...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	byPath := map[string]int{}
+	for _, attribution := range attributions {
+		byPath[attribution.Path] = attribution.Revision
+	}
+	return byPath
+}
+
+func TestBlameObjects(t *testing.T) {
+	byPath := blameByPath(t,
+		map[string]interface{}{"title": "Kimchi stew", "servings": 2},
+		map[string]interface{}{"title": "Kimchi stew", "servings": 4},
+		map[string]interface{}{"title": "Kimchi stew", "servings": 4, "time": "30m"},
+	)
+
+	want := map[string]int{"/title": 0, "/servings": 1, "/time": 2}
+	if len(byPath) != len(want) {
+		t.Fatalf("blame = %v, want %v", byPath, want)
+	}
+	for path, revision := range want {
+		if byPath[path] != revision {
+			t.Errorf("%s blamed on revision %d, want %d", path, byPath[path], revision)
+		}
+	}
+}
+
+func TestBlameFollowsListInsertions(t *testing.T) {
+	byPath := blameByPath(t,
+		map[string]interface{}{"steps": []interface{}{"boil", "serve"}},
+		map[string]interface{}{"steps": []interface{}{"chop", "boil", "serve"}},
+		map[string]interface{}{"steps": []interface{}{"chop", "boil", "season", "serve"}},
+	)
+
+	want := map[string]int{"/steps/0": 1, "/steps/1": 0, "/steps/2": 2, "/steps/3": 0}
+	for path, revision := range want {
+		if byPath[path] != revision {
+			t.Errorf("%s blamed on revision %d, want %d", path, byPath[path], revision)
+		}
+	}
+}
+
+func TestBlameKeepsMovedItems(t *testing.T) {
+	byPath := blameByPath(t,
+		map[string]interface{}{"steps": []interface{}{"a", "b"}},
+		map[string]interface{}{"steps": []interface{}{"a", "b", "c"}},
+		map[string]interface{}{"steps": []interface{}{"c", "a", "b"}},
+	)
+
+	if byPath["/steps/0"] != 1 || byPath["/steps/1"] != 0 || byPath["/steps/2"] != 0 {
+		t.Errorf("expected moved item to keep its author, got %v", byPath)
+	}
+}
+
+func TestBlameEditedListItem(t *testing.T) {
+	byPath := blameByPath(t,
+		map[string]interface{}{"ingredients": []interface{}{
+			map[string]interface{}{"name": "egg", "amount": "1"},
+			map[string]interface{}{"name": "rice", "amount": "1 cup"},
+		}},
+		map[string]interface{}{"ingredients": []interface{}{
+			map[string]interface{}{"name": "egg", "amount": "2"},
+			map[string]interface{}{"name": "rice", "amount": "1 cup"},
+		}},
+	)
+
+	want := map[string]int{
+		"/ingredients/0/name":   0,
+		"/ingredients/0/amount": 1,
+		"/ingredients/1/name":   0,
+		"/ingredients/1/amount": 0,
+	}
+	for path, revision := range want {
+		if byPath[path] != revision {
+			t.Errorf("%s blamed on revision %d, want %d", path, byPath[path], revision)
+		}
+	}
+}
+
+func TestBlameRevertedValueIsCreditedToRevert(t *testing.T) {
+	byPath := blameByPath(t,
+		map[string]interface{}{"servings": 2},
+		map[string]interface{}{"servings": 4},
+		map[string]interface{}{"servings": 2},
+	)
+
+	if byPath["/servings"] != 2 {
+		t.Errorf("expected the revert to own the value, got revision %d", byPath["/servings"])
+	}
+}
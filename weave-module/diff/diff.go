@@ -0,0 +1,342 @@
+package diff
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// OpType describes the kind of change recorded at a path
+type OpType string
+
+const (
+	OpAdd    OpType = "add"
+	OpRemove OpType = "remove"
+	OpChange OpType = "change"
+	OpMove   OpType = "move"
+)
+
+// maxAlignedCells caps the table longestCommonSubsequence fills, which holds
+// one cell per pair of items. Longer lists are aligned index by index instead:
+// an insertion then shows up as edits down to the end of the list, but memory
+// and time stay linear.
+const maxAlignedCells = 500 * 500
+
+// Change is a single structural difference between two JSON documents.
+// Paths use JSON Pointer syntax (RFC 6901), e.g. "/data/steps/2/text".
+// Removals refer to indexes in the old document, everything else to the new one.
+type Change struct {
+	Op       OpType      `json:"op"`
+	Path     string      `json:"path"`
+	From     string      `json:"from,omitempty"` // Source path for moves
+	OldValue interface{} `json:"old_value,omitempty"`
+	NewValue interface{} `json:"new_value,omitempty"`
+}
+
+// Summary counts the changes in a diff by kind
+type Summary struct {
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+	Changed int `json:"changed"`
+	Moved   int `json:"moved"`
+}
+
+// Diff is the structural difference between two JSON documents
+type Diff struct {
+	Changes []Change `json:"changes"`
+	Summary Summary  `json:"summary"`
+}
+
+// Compare computes the diff between two values that can be marshalled to JSON
+func Compare(oldValue, newValue interface{}) (*Diff, error) {
+	oldDoc, err := Normalize(oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newDoc, err := Normalize(newValue)
+	if err != nil {
+		return nil, err
+	}
+	return Values(oldDoc, newDoc), nil
+}
+
+// Values computes the diff between two already normalized JSON documents
+func Values(oldDoc, newDoc interface{}) *Diff {
+	d := &Diff{Changes: []Change{}}
+	d.compare(oldDoc, newDoc, "")
+	return d
+}
+
+// Parse decodes a diff previously serialized with JSON
+func Parse(data string) (*Diff, error) {
+	var d Diff
+	if err := json.Unmarshal([]byte(data), &d); err != nil {
+		return nil, err
+	}
+	if d.Changes == nil {
+		d.Changes = []Change{}
+	}
+	return &d, nil
+}
+
+// IsEmpty reports whether the two documents were identical
+func (d *Diff) IsEmpty() bool {
+	return len(d.Changes) == 0
+}
+
+// JSON serializes the diff for storage in a jsonb column
+func (d *Diff) JSON() (string, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (d *Diff) add(change Change) {
+	d.Changes = append(d.Changes, change)
+	switch change.Op {
+	case OpAdd:
+		d.Summary.Added++
+	case OpRemove:
+		d.Summary.Removed++
+	case OpChange:
+		d.Summary.Changed++
+	case OpMove:
+		d.Summary.Moved++
+	}
+}
+
+func (d *Diff) compare(oldValue, newValue interface{}, path string) {
+	switch oldTyped := oldValue.(type) {
+	case map[string]interface{}:
+		if newTyped, ok := newValue.(map[string]interface{}); ok {
+			d.compareObjects(oldTyped, newTyped, path)
+			return
+		}
+	case []interface{}:
+		if newTyped, ok := newValue.([]interface{}); ok {
+			d.compareLists(oldTyped, newTyped, path)
+			return
+		}
+	}
+
+	if !Equal(oldValue, newValue) {
+		d.add(Change{Op: OpChange, Path: path, OldValue: oldValue, NewValue: newValue})
+	}
+}
+
+func (d *Diff) compareObjects(oldObj, newObj map[string]interface{}, path string) {
+	keys := make([]string, 0, len(oldObj)+len(newObj))
+	for key := range oldObj {
+		keys = append(keys, key)
+	}
+	for key := range newObj {
+		if _, ok := oldObj[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		oldValue, inOld := oldObj[key]
+		newValue, inNew := newObj[key]
+		childPath := JoinPath(path, key)
+
+		switch {
+		case !inNew:
+			d.add(Change{Op: OpRemove, Path: childPath, OldValue: oldValue})
+		case !inOld:
+			d.add(Change{Op: OpAdd, Path: childPath, NewValue: newValue})
+		default:
+			d.compare(oldValue, newValue, childPath)
+		}
+	}
+}
+
+// compareLists reports how newList differs from oldList, following alignLists
+func (d *Diff) compareLists(oldList, newList []interface{}, path string) {
+	alignment := alignLists(oldList, newList)
+
+	for _, move := range alignment.moves {
+		d.add(Change{Op: OpMove, From: JoinIndex(path, move[0]), Path: JoinIndex(path, move[1])})
+	}
+
+	for _, gap := range alignment.gaps {
+		for _, pair := range gap.paired {
+			d.compare(oldList[pair[0]], newList[pair[1]], JoinIndex(path, pair[1]))
+		}
+		for _, i := range gap.removed {
+			d.add(Change{Op: OpRemove, Path: JoinIndex(path, i), OldValue: oldList[i]})
+		}
+		for _, j := range gap.added {
+			d.add(Change{Op: OpAdd, Path: JoinIndex(path, j), NewValue: newList[j]})
+		}
+	}
+}
+
+// listAlignment maps the items of an old list onto a new one. Index pairs are
+// [old, new]; removed holds old indexes and added new ones.
+type listAlignment struct {
+	anchors [][2]int // Identical items kept in order
+	moves   [][2]int // Identical items found elsewhere in the list
+	gaps    []listGap
+}
+
+// listGap holds the unaligned items between two anchors
+type listGap struct {
+	paired  [][2]int // Items edited in place
+	removed []int
+	added   []int
+}
+
+// alignLists aligns both lists on their longest common subsequence.
+// Unaligned items that appear unchanged elsewhere in the list are treated
+// as moves; the rest are paired up positionally between alignment points.
+// Lists past maxAlignedCells are aligned index by index and have no moves.
+func alignLists(oldList, newList []interface{}) listAlignment {
+	oldKeys := canonicalKeys(oldList)
+	newKeys := canonicalKeys(newList)
+
+	alignment := listAlignment{anchors: longestCommonSubsequence(oldKeys, newKeys)}
+	oldMatched := make([]bool, len(oldList))
+	newMatched := make([]bool, len(newList))
+	for _, anchor := range alignment.anchors {
+		oldMatched[anchor[0]] = true
+		newMatched[anchor[1]] = true
+	}
+
+	if alignable(oldKeys, newKeys) {
+		for i := range oldList {
+			if oldMatched[i] {
+				continue
+			}
+			for j := range newList {
+				if !newMatched[j] && oldKeys[i] == newKeys[j] {
+					oldMatched[i] = true
+					newMatched[j] = true
+					alignment.moves = append(alignment.moves, [2]int{i, j})
+					break
+				}
+			}
+		}
+	}
+
+	// Sentinel anchor so the trailing gap is handled like the others
+	bounds := append(alignment.anchors[:len(alignment.anchors):len(alignment.anchors)], [2]int{len(oldList), len(newList)})
+	oldStart, newStart := 0, 0
+	for _, anchor := range bounds {
+		var gap listGap
+		var removed, added []int
+		for i := oldStart; i < anchor[0]; i++ {
+			if !oldMatched[i] {
+				removed = append(removed, i)
+			}
+		}
+		for j := newStart; j < anchor[1]; j++ {
+			if !newMatched[j] {
+				added = append(added, j)
+			}
+		}
+
+		paired := len(removed)
+		if len(added) < paired {
+			paired = len(added)
+		}
+		for k := 0; k < paired; k++ {
+			gap.paired = append(gap.paired, [2]int{removed[k], added[k]})
+		}
+		gap.removed = removed[paired:]
+		gap.added = added[paired:]
+		alignment.gaps = append(alignment.gaps, gap)
+
+		oldStart, newStart = anchor[0]+1, anchor[1]+1
+	}
+	return alignment
+}
+
+// longestCommonSubsequence returns the aligned index pairs of two key lists.
+// Lists too long to align pair up the equal keys at the same index.
+func longestCommonSubsequence(a, b []string) [][2]int {
+	if !alignable(a, b) {
+		var pairs [][2]int
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if a[i] == b[i] {
+				pairs = append(pairs, [2]int{i, i})
+			}
+		}
+		return pairs
+	}
+
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var pairs [][2]int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			pairs = append(pairs, [2]int{i, j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
+
+// alignable reports whether two key lists are short enough for an LCS table
+func alignable(a, b []string) bool {
+	return len(a)*len(b) <= maxAlignedCells
+}
+
+func canonicalKeys(list []interface{}) []string {
+	keys := make([]string, len(list))
+	for i, item := range list {
+		keys[i] = canonical(item)
+	}
+	return keys
+}
+
+// canonical returns a stable string form of a normalized value;
+// encoding/json sorts map keys so equal documents encode identically
+func canonical(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// Equal reports whether two normalized JSON values are structurally identical
+func Equal(a, b interface{}) bool {
+	return canonical(a) == canonical(b)
+}
+
+// Normalize converts any JSON-marshallable value into its generic form
+// (maps, slices, float64, string, bool and nil)
+func Normalize(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
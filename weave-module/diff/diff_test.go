@@ -0,0 +1,189 @@
+package diff
+
+import (
+	"testing"
+)
+
+func TestCompareObjects(t *testing.T) {
+	oldDoc := map[string]interface{}{
+		"type": "recipe",
+		"data": map[string]interface{}{
+			"title":    "Kimchi stew",
+			"servings": 2,
+			"spicy":    true,
+		},
+	}
+	newDoc := map[string]interface{}{
+		"type": "recipe",
+		"data": map[string]interface{}{
+			"title":    "Kimchi stew",
+			"servings": 4,
+			"time":     "30m",
+		},
+	}
+
+	d, err := Compare(oldDoc, newDoc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := Summary{Added: 1, Removed: 1, Changed: 1}
+	if d.Summary != want {
+		t.Fatalf("summary = %+v, want %+v", d.Summary, want)
+	}
+
+	byPath := map[string]Change{}
+	for _, change := range d.Changes {
+		byPath[change.Path] = change
+	}
+	if c := byPath["/data/servings"]; c.Op != OpChange || c.OldValue != float64(2) || c.NewValue != float64(4) {
+		t.Errorf("unexpected servings change: %+v", c)
+	}
+	if c := byPath["/data/spicy"]; c.Op != OpRemove {
+		t.Errorf("expected spicy to be removed, got %+v", c)
+	}
+	if c := byPath["/data/time"]; c.Op != OpAdd {
+		t.Errorf("expected time to be added, got %+v", c)
+	}
+}
+
+func TestCompareIdentical(t *testing.T) {
+	doc := map[string]interface{}{"steps": []interface{}{"a", "b"}}
+
+	d, err := Compare(doc, doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !d.IsEmpty() {
+		t.Errorf("expected empty diff, got %+v", d.Changes)
+	}
+}
+
+func TestCompareListInsertAndRemove(t *testing.T) {
+	oldDoc := []interface{}{"boil water", "add noodles", "serve"}
+	newDoc := []interface{}{"boil water", "add soup base", "add noodles"}
+
+	d, err := Compare(oldDoc, newDoc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := Summary{Added: 1, Removed: 1}
+	if d.Summary != want {
+		t.Fatalf("summary = %+v, want %+v (changes %+v)", d.Summary, want, d.Changes)
+	}
+	for _, change := range d.Changes {
+		switch change.Op {
+		case OpAdd:
+			if change.Path != "/1" {
+				t.Errorf("add path = %s, want /1", change.Path)
+			}
+		case OpRemove:
+			if change.Path != "/2" {
+				t.Errorf("remove path = %s, want /2", change.Path)
+			}
+		}
+	}
+}
+
+func TestCompareListMove(t *testing.T) {
+	oldDoc := []interface{}{"a", "b", "c", "d"}
+	newDoc := []interface{}{"d", "a", "b", "c"}
+
+	d, err := Compare(oldDoc, newDoc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d.Summary != (Summary{Moved: 1}) {
+		t.Fatalf("summary = %+v, want one move (changes %+v)", d.Summary, d.Changes)
+	}
+	if c := d.Changes[0]; c.From != "/3" || c.Path != "/0" {
+		t.Errorf("move = %+v, want /3 -> /0", c)
+	}
+}
+
+func TestCompareListItemEdited(t *testing.T) {
+	oldDoc := []interface{}{
+		map[string]interface{}{"name": "flour", "amount": "200g"},
+		map[string]interface{}{"name": "sugar", "amount": "50g"},
+	}
+	newDoc := []interface{}{
+		map[string]interface{}{"name": "flour", "amount": "250g"},
+		map[string]interface{}{"name": "sugar", "amount": "50g"},
+	}
+
+	d, err := Compare(oldDoc, newDoc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(d.Changes) != 1 || d.Changes[0].Path != "/0/amount" || d.Changes[0].Op != OpChange {
+		t.Errorf("unexpected changes: %+v", d.Changes)
+	}
+}
+
+func TestCompareLongListIndexWise(t *testing.T) {
+	oldDoc := make([]interface{}, 600)
+	for i := range oldDoc {
+		oldDoc[i] = float64(i)
+	}
+	newDoc := append([]interface{}{}, oldDoc...)
+	newDoc[10] = "ten"
+	newDoc = append(newDoc, float64(0))
+
+	d, err := Compare(oldDoc, newDoc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Past the alignment cap items are compared at the same index
+	want := Summary{Added: 1, Changed: 1}
+	if d.Summary != want {
+		t.Fatalf("summary = %+v, want %+v (changes %+v)", d.Summary, want, d.Changes)
+	}
+	if c := d.Changes[0]; c.Op != OpChange || c.Path != "/10" {
+		t.Errorf("change = %+v, want a change at /10", c)
+	}
+	if c := d.Changes[1]; c.Op != OpAdd || c.Path != "/600" {
+		t.Errorf("change = %+v, want an addition at /600", c)
+	}
+}
+
+func TestPathRoundTrip(t *testing.T) {
+	path := JoinIndex(JoinPath(JoinPath("", "data"), "a/b~c"), 3)
+	if path != "/data/a~1b~0c/3" {
+		t.Fatalf("path = %s", path)
+	}
+
+	tokens := SplitPath(path)
+	want := []string{"data", "a/b~c", "3"}
+	if len(tokens) != len(want) {
+		t.Fatalf("tokens = %v, want %v", tokens, want)
+	}
+	for i := range want {
+		if tokens[i] != want[i] {
+			t.Errorf("token %d = %q, want %q", i, tokens[i], want[i])
+		}
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	d, err := Compare(map[string]interface{}{"a": 1}, map[string]interface{}{"a": 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := d.JSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed.Summary != d.Summary || len(parsed.Changes) != 1 {
+		t.Errorf("parsed = %+v, want %+v", parsed, d)
+	}
+}
@@ -0,0 +1,231 @@
+package diff
+
+import "sort"
+
+// Conflict describes a path that both sides changed in incompatible ways.
+// Conflicting runs of list items are reported item by item; a side whose run
+// is shorter has no value at the paths past its end.
+type Conflict struct {
+	Path   string      `json:"path"`
+	Base   interface{} `json:"base"`
+	Ours   interface{} `json:"ours"`
+	Theirs interface{} `json:"theirs"`
+}
+
+// MergeResult is the outcome of a three-way merge. When conflicts are
+// present, Merged holds "ours" at each conflicting path.
+type MergeResult struct {
+	Merged    interface{} `json:"merged"`
+	Conflicts []Conflict  `json:"conflicts"`
+}
+
+// HasConflicts reports whether the merge needs manual resolution
+func (r *MergeResult) HasConflicts() bool {
+	return len(r.Conflicts) > 0
+}
+
+// Merge performs a three-way merge of values that can be marshalled to JSON
+func Merge(base, ours, theirs interface{}) (*MergeResult, error) {
+	baseDoc, err := Normalize(base)
+	if err != nil {
+		return nil, err
+	}
+	oursDoc, err := Normalize(ours)
+	if err != nil {
+		return nil, err
+	}
+	theirsDoc, err := Normalize(theirs)
+	if err != nil {
+		return nil, err
+	}
+	return MergeValues(baseDoc, oursDoc, theirsDoc), nil
+}
+
+// MergeValues performs a three-way merge of already normalized JSON documents
+func MergeValues(base, ours, theirs interface{}) *MergeResult {
+	m := &merger{conflicts: []Conflict{}}
+	merged := m.merge(present(base), present(ours), present(theirs), "")
+	return &MergeResult{Merged: merged.value, Conflicts: m.conflicts}
+}
+
+// slot distinguishes an absent object key from an explicit null
+type slot struct {
+	value   interface{}
+	present bool
+}
+
+func present(value interface{}) slot {
+	return slot{value: value, present: true}
+}
+
+var absent = slot{}
+
+func (s slot) equal(other slot) bool {
+	if s.present != other.present {
+		return false
+	}
+	return !s.present || Equal(s.value, other.value)
+}
+
+type merger struct {
+	conflicts []Conflict
+}
+
+func (m *merger) conflict(path string, base, ours, theirs slot) slot {
+	m.conflicts = append(m.conflicts, Conflict{
+		Path:   path,
+		Base:   base.value,
+		Ours:   ours.value,
+		Theirs: theirs.value,
+	})
+	return ours
+}
+
+func (m *merger) merge(base, ours, theirs slot, path string) slot {
+	switch {
+	case ours.equal(theirs):
+		return ours
+	case base.equal(ours):
+		return theirs
+	case base.equal(theirs):
+		return ours
+	}
+
+	if !ours.present || !theirs.present {
+		return m.conflict(path, base, ours, theirs)
+	}
+
+	switch oursTyped := ours.value.(type) {
+	case map[string]interface{}:
+		if theirsTyped, ok := theirs.value.(map[string]interface{}); ok {
+			baseTyped, _ := base.value.(map[string]interface{})
+			return present(m.mergeObjects(baseTyped, oursTyped, theirsTyped, path))
+		}
+	case []interface{}:
+		if theirsTyped, ok := theirs.value.([]interface{}); ok {
+			baseTyped, _ := base.value.([]interface{})
+			return present(m.mergeLists(baseTyped, oursTyped, theirsTyped, path))
+		}
+	}
+
+	return m.conflict(path, base, ours, theirs)
+}
+
+func (m *merger) mergeObjects(base, ours, theirs map[string]interface{}, path string) map[string]interface{} {
+	keySet := map[string]struct{}{}
+	for _, obj := range []map[string]interface{}{base, ours, theirs} {
+		for key := range obj {
+			keySet[key] = struct{}{}
+		}
+	}
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	merged := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		result := m.merge(lookup(base, key), lookup(ours, key), lookup(theirs, key), JoinPath(path, key))
+		if result.present {
+			merged[key] = result.value
+		}
+	}
+	return merged
+}
+
+func lookup(obj map[string]interface{}, key string) slot {
+	if obj == nil {
+		return absent
+	}
+	value, ok := obj[key]
+	if !ok {
+		return absent
+	}
+	return present(value)
+}
+
+// mergeLists is a diff3 merge: the base is aligned against both sides, items
+// that are stable in all three are kept, and the unstable chunks between them
+// are taken from whichever side changed them.
+func (m *merger) mergeLists(base, ours, theirs []interface{}, path string) []interface{} {
+	baseKeys := canonicalKeys(base)
+	oursMatch := matchIndexes(baseKeys, canonicalKeys(ours))
+	theirsMatch := matchIndexes(baseKeys, canonicalKeys(theirs))
+
+	merged := []interface{}{}
+	b, o, t := 0, 0, 0
+	for {
+		// Copy the stable run where all three lists line up
+		stable := 0
+		for b+stable < len(base) &&
+			oursMatch[b+stable] == o+stable &&
+			theirsMatch[b+stable] == t+stable {
+			stable++
+		}
+		if stable > 0 {
+			merged = append(merged, base[b:b+stable]...)
+			b, o, t = b+stable, o+stable, t+stable
+			continue
+		}
+
+		// Find where the three lists line up again
+		nextBase, nextOurs, nextTheirs := len(base), len(ours), len(theirs)
+		for i := b; i < len(base); i++ {
+			if oursMatch[i] >= 0 && theirsMatch[i] >= 0 {
+				nextBase, nextOurs, nextTheirs = i, oursMatch[i], theirsMatch[i]
+				break
+			}
+		}
+
+		if b == nextBase && o == nextOurs && t == nextTheirs {
+			return merged
+		}
+
+		merged = m.mergeChunk(merged, base[b:nextBase], ours[o:nextOurs], theirs[t:nextTheirs], path)
+		b, o, t = nextBase, nextOurs, nextTheirs
+	}
+}
+
+func (m *merger) mergeChunk(merged, base, ours, theirs []interface{}, path string) []interface{} {
+	switch {
+	case Equal(ours, theirs), Equal(base, theirs):
+		return append(merged, ours...)
+	case Equal(base, ours):
+		return append(merged, theirs...)
+	}
+
+	// Items edited in place on both sides can still merge field by field
+	if len(base) == len(ours) && len(ours) == len(theirs) {
+		for i := range ours {
+			itemPath := JoinIndex(path, len(merged))
+			merged = append(merged, m.merge(present(base[i]), present(ours[i]), present(theirs[i]), itemPath).value)
+		}
+		return merged
+	}
+
+	for i := 0; i < max(len(base), len(ours), len(theirs)); i++ {
+		m.conflict(JoinIndex(path, len(merged)+i), item(base, i), item(ours, i), item(theirs, i))
+	}
+	return append(merged, ours...)
+}
+
+// item returns the list item at index i, or absent past the end of the list
+func item(list []interface{}, i int) slot {
+	if i >= len(list) {
+		return absent
+	}
+	return present(list[i])
+}
+
+// matchIndexes maps each base index to its aligned index in other, or -1
+func matchIndexes(baseKeys, otherKeys []string) []int {
+	matches := make([]int, len(baseKeys))
+	for i := range matches {
+		matches[i] = -1
+	}
+	for _, pair := range longestCommonSubsequence(baseKeys, otherKeys) {
+		matches[pair[0]] = pair[1]
+	}
+	return matches
+}
@@ -0,0 +1,125 @@
+package diff
+
+import "testing"
+
+func TestMergeIndependentFieldEdits(t *testing.T) {
+	base := map[string]interface{}{"title": "Bibimbap", "servings": 2, "spicy": false}
+	ours := map[string]interface{}{"title": "Bibimbap", "servings": 4, "spicy": false}
+	theirs := map[string]interface{}{"title": "Dolsot bibimbap", "servings": 2, "spicy": false, "notes": "use a stone pot"}
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.HasConflicts() {
+		t.Fatalf("unexpected conflicts: %+v", result.Conflicts)
+	}
+
+	want := map[string]interface{}{"title": "Dolsot bibimbap", "servings": 4, "spicy": false, "notes": "use a stone pot"}
+	if !Equal(result.Merged, mustNormalize(t, want)) {
+		t.Errorf("merged = %v, want %v", result.Merged, want)
+	}
+}
+
+func TestMergeConflictingEdit(t *testing.T) {
+	base := map[string]interface{}{"data": map[string]interface{}{"time": "20m"}}
+	ours := map[string]interface{}{"data": map[string]interface{}{"time": "25m"}}
+	theirs := map[string]interface{}{"data": map[string]interface{}{"time": "30m"}}
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("conflicts = %+v, want exactly one", result.Conflicts)
+	}
+
+	c := result.Conflicts[0]
+	if c.Path != "/data/time" || c.Base != "20m" || c.Ours != "25m" || c.Theirs != "30m" {
+		t.Errorf("unexpected conflict: %+v", c)
+	}
+}
+
+func TestMergeDeleteVersusEdit(t *testing.T) {
+	base := map[string]interface{}{"tip": "rest the dough"}
+	ours := map[string]interface{}{}
+	theirs := map[string]interface{}{"tip": "rest the dough overnight"}
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Conflicts) != 1 || result.Conflicts[0].Path != "/tip" {
+		t.Errorf("conflicts = %+v, want one at /tip", result.Conflicts)
+	}
+}
+
+func TestMergeListInsertionsOnBothSides(t *testing.T) {
+	base := []interface{}{"a", "b", "c"}
+	ours := []interface{}{"start", "a", "b", "c"}
+	theirs := []interface{}{"a", "b", "c", "end"}
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.HasConflicts() {
+		t.Fatalf("unexpected conflicts: %+v", result.Conflicts)
+	}
+
+	want := []interface{}{"start", "a", "b", "c", "end"}
+	if !Equal(result.Merged, want) {
+		t.Errorf("merged = %v, want %v", result.Merged, want)
+	}
+}
+
+func TestMergeListItemFieldEdits(t *testing.T) {
+	base := []interface{}{map[string]interface{}{"name": "flour", "amount": "200g"}}
+	ours := []interface{}{map[string]interface{}{"name": "bread flour", "amount": "200g"}}
+	theirs := []interface{}{map[string]interface{}{"name": "flour", "amount": "250g"}}
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.HasConflicts() {
+		t.Fatalf("unexpected conflicts: %+v", result.Conflicts)
+	}
+
+	want := []interface{}{map[string]interface{}{"name": "bread flour", "amount": "250g"}}
+	if !Equal(result.Merged, want) {
+		t.Errorf("merged = %v, want %v", result.Merged, want)
+	}
+}
+
+func TestMergeListConflictingInsertions(t *testing.T) {
+	base := []interface{}{"a", "c"}
+	ours := []interface{}{"a", "b1", "b2", "c"}
+	theirs := []interface{}{"a", "x", "c"}
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Conflicts) != 2 {
+		t.Fatalf("conflicts = %+v, want one per item at /1 and /2", result.Conflicts)
+	}
+	if c := result.Conflicts[0]; c.Path != "/1" || c.Base != nil || c.Ours != "b1" || c.Theirs != "x" {
+		t.Errorf("conflict = %+v, want b1 against x at /1", c)
+	}
+	if c := result.Conflicts[1]; c.Path != "/2" || c.Base != nil || c.Ours != "b2" || c.Theirs != nil {
+		t.Errorf("conflict = %+v, want b2 against nothing at /2", c)
+	}
+	if !Equal(result.Merged, ours) {
+		t.Errorf("merged = %v, want ours %v", result.Merged, ours)
+	}
+}
+
+func mustNormalize(t *testing.T, value interface{}) interface{} {
+	t.Helper()
+	doc, err := Normalize(value)
+	if err != nil {
+		t.Fatalf("normalize: %v", err)
+	}
+	return doc
+}
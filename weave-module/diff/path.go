@@ -0,0 +1,33 @@
+package diff
+
+import (
+	"strconv"
+	"strings"
+)
+
+var (
+	pointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// JoinPath appends an object key to a JSON Pointer
+func JoinPath(path, key string) string {
+	return path + "/" + pointerEscaper.Replace(key)
+}
+
+// JoinIndex appends a list index to a JSON Pointer
+func JoinIndex(path string, index int) string {
+	return path + "/" + strconv.Itoa(index)
+}
+
+// SplitPath breaks a JSON Pointer into its unescaped tokens
+func SplitPath(path string) []string {
+	if path == "" || path == "/" {
+		return []string{}
+	}
+	tokens := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+	return tokens
+}
@@ -0,0 +1,53 @@
+package diff
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrPathNotFound is returned when a JSON Pointer does not point at a value in the document
+var ErrPathNotFound = errors.New("path does not exist")
+
+// Replace returns a copy of a normalized document with the value at a JSON
+// Pointer swapped for value. Like the replace operation of JSON Patch the path
+// must already exist; the document itself is left untouched.
+func Replace(doc interface{}, path string, value interface{}) (interface{}, error) {
+	replacement, err := Normalize(value)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" || path == "/" {
+		return replacement, nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, ErrPathNotFound
+	}
+	replaced, err := Normalize(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	separator := strings.LastIndex(path, "/")
+	parent, ok := Resolve(replaced, path[:separator])
+	if !ok {
+		return nil, ErrPathNotFound
+	}
+	token := pointerUnescaper.Replace(path[separator+1:])
+	switch typed := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := typed[token]; !ok {
+			return nil, ErrPathNotFound
+		}
+		typed[token] = replacement
+	case []interface{}:
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index >= len(typed) {
+			return nil, ErrPathNotFound
+		}
+		typed[index] = replacement
+	default:
+		return nil, ErrPathNotFound
+	}
+	return replaced, nil
+}
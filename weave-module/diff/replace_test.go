@@ -0,0 +1,47 @@
+package diff
+
+import (
+	"testing"
+)
+
+func TestReplace(t *testing.T) {
+	doc := recipe("Soak the rice", "Chop the onions")
+
+	replaced, err := Replace(doc, "/data/steps/1", "Chop the leeks")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, _ := Resolve(replaced, "/data/steps/1"); value != "Chop the leeks" {
+		t.Errorf("replaced value = %v, want the new step", value)
+	}
+	if value, _ := Resolve(doc, "/data/steps/1"); value != "Chop the onions" {
+		t.Errorf("original document was modified: %v", value)
+	}
+
+	changes := Values(doc, replaced).Changes
+	if len(changes) != 1 || changes[0].Op != OpChange || changes[0].Path != "/data/steps/1" {
+		t.Errorf("expected a single change at the replaced path, got %+v", changes)
+	}
+}
+
+func TestReplaceStructuredValue(t *testing.T) {
+	doc := recipe("Soak the rice")
+
+	replaced, err := Replace(doc, "/data/steps", []string{"Rinse the rice", "Soak it"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, _ := Resolve(replaced, "/data/steps/1"); value != "Soak it" {
+		t.Errorf("expected the list to be replaced as a whole, got %v", value)
+	}
+}
+
+func TestReplaceMissingPath(t *testing.T) {
+	doc := recipe("Soak the rice")
+
+	for _, path := range []string{"/data/servings", "/data/steps/1", "/data/steps/first", "/data/steps/0/text", "data/steps/0"} {
+		if _, err := Replace(doc, path, "x"); err != ErrPathNotFound {
+			t.Errorf("Replace(%q) error = %v, want ErrPathNotFound", path, err)
+		}
+	}
+}
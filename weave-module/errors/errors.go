@@ -6,15 +6,31 @@ import (
 )
 
 type AppError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Details string      `json:"details,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
 }
 
 func (e *AppError) Error() string {
 	return e.Message
 }
 
+// WithData returns a copy of the error carrying data for the client, such as
+// the current state of a resource the request conflicted with
+func (e *AppError) WithData(data interface{}) *AppError {
+	copied := *e
+	copied.Data = data
+	return &copied
+}
+
+// WithCode returns a copy of the error reported with a different status code
+func (e *AppError) WithCode(code int) *AppError {
+	copied := *e
+	copied.Code = code
+	return &copied
+}
+
 func NewAppError(code int, message, details string) *AppError {
 	return &AppError{
 		Code:    code,
@@ -48,6 +64,14 @@ func Conflict(message string) *AppError {
 	return NewAppError(http.StatusConflict, message, "")
 }
 
+func PreconditionFailed(message string) *AppError {
+	return NewAppError(http.StatusPreconditionFailed, message, "")
+}
+
+func PreconditionRequired(message string) *AppError {
+	return NewAppError(http.StatusPreconditionRequired, message, "")
+}
+
 func InternalServerError(message string) *AppError {
 	return NewAppError(http.StatusInternalServerError, message, "")
 }
@@ -105,6 +129,13 @@ func IsUnauthorized(err error) bool {
 	return false
 }
 
+func IsForbidden(err error) bool {
+	if appErr, ok := err.(*AppError); ok {
+		return appErr.Code == http.StatusForbidden
+	}
+	return false
+}
+
 func IsConflict(err error) bool {
 	if appErr, ok := err.(*AppError); ok {
 		return appErr.Code == http.StatusConflict
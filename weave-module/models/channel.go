@@ -15,8 +15,10 @@ type Channel struct {
 	CoverImage  *string   `gorm:"size:500" json:"cover_image"`
 	IsActive    bool      `gorm:"default:true" json:"is_active"`
 	IsPublic    bool      `gorm:"default:true" json:"is_public"`
-	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	// JSON array of content type names; null accepts every registered type
+	AcceptedContentTypes *string   `gorm:"type:jsonb" json:"accepted_content_types"`
+	CreatedAt            time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime" json:"updated_at"`
 
 	// Relationships
 	Weaves []Weave `gorm:"foreignKey:ChannelID" json:"weaves,omitempty"`
@@ -27,4 +29,25 @@ func (c *Channel) BeforeCreate(tx *gorm.DB) error {
 		c.ID = uuid.New()
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// ChannelTemplate pins a template weave as one of a channel's official templates
+type ChannelTemplate struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ChannelID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_channel_template" json:"channel_id"`
+	WeaveID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_channel_template;index" json:"weave_id"`
+	PinnedBy  uuid.UUID `gorm:"type:uuid;not null" json:"pinned_by"`
+	Position  int       `gorm:"default:0" json:"position"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	// Relationships
+	Channel Channel `gorm:"foreignKey:ChannelID" json:"channel,omitempty"`
+	Weave   Weave   `gorm:"foreignKey:WeaveID" json:"weave,omitempty"`
+}
+
+func (t *ChannelTemplate) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
@@ -37,10 +37,14 @@ type Contribution struct {
 	OriginalContent  *string            `gorm:"type:jsonb" json:"original_content"`
 	ProposedContent  *string            `gorm:"type:jsonb" json:"proposed_content"`
 	ContentDiff      *string            `gorm:"type:jsonb" json:"content_diff"`
+	ProposedAt       *time.Time         `json:"proposed_at"` // When the proposed content last changed; earlier reviews are outdated
+	SourceWeaveID    *uuid.UUID         `gorm:"type:uuid;index" json:"source_weave_id"` // fork proposed by a merge request
 	Status           ContributionStatus `gorm:"type:varchar(20);default:'pending';index" json:"status"`
 	ReviewerID       *uuid.UUID         `gorm:"type:uuid;index" json:"reviewer_id"`
 	ReviewedAt       *time.Time         `json:"reviewed_at"`
 	ReviewComment    *string            `gorm:"type:text" json:"review_comment"`
+	MergedBy         *uuid.UUID         `gorm:"type:uuid;index" json:"merged_by"` // owner or maintainer who merged it
+	MergedAt         *time.Time         `json:"merged_at"`
 	VoteScore        int                `gorm:"default:0" json:"vote_score"`
 	Priority         int                `gorm:"default:0;index" json:"priority"`
 	CreatedAt        time.Time          `gorm:"autoCreateTime;index" json:"created_at"`
@@ -54,6 +58,40 @@ type Contribution struct {
 	Comments []ContributionComment `gorm:"foreignKey:ContributionID" json:"comments,omitempty"`
 }
 
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleOwner      CollaboratorRole = "owner"
+	CollaboratorRoleMaintainer CollaboratorRole = "maintainer"
+	CollaboratorRoleEditor     CollaboratorRole = "editor"
+	CollaboratorRoleReviewer   CollaboratorRole = "reviewer"
+)
+
+type CollaboratorStatus string
+
+const (
+	CollaboratorStatusInvited CollaboratorStatus = "invited"
+	CollaboratorStatusActive  CollaboratorStatus = "active"
+)
+
+// WeaveCollaborator grants a user a role on a weave besides its creator
+type WeaveCollaborator struct {
+	ID         uuid.UUID          `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	WeaveID    uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_weave_collaborator" json:"weave_id"`
+	UserID     uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_weave_collaborator;index" json:"user_id"`
+	Role       CollaboratorRole   `gorm:"type:varchar(20);not null" json:"role"`
+	Status     CollaboratorStatus `gorm:"type:varchar(20);default:'invited';index" json:"status"`
+	InvitedBy  uuid.UUID          `gorm:"type:uuid;not null" json:"invited_by"`
+	AcceptedAt *time.Time         `json:"accepted_at"`
+	CreatedAt  time.Time          `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time          `gorm:"autoUpdateTime" json:"updated_at"`
+
+	// Relationships
+	Weave   Weave `gorm:"foreignKey:WeaveID" json:"weave,omitempty"`
+	User    User  `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Inviter User  `gorm:"foreignKey:InvitedBy" json:"inviter,omitempty"`
+}
+
 type CommentType string
 
 const (
@@ -71,11 +109,13 @@ type LabComment struct {
 	ParentCommentID  *uuid.UUID  `gorm:"type:uuid;index" json:"parent_comment_id"`
 	Type             CommentType `gorm:"type:varchar(20);default:'general'" json:"type"`
 	Content          string      `gorm:"type:text;not null" json:"content"`
-	ContentPosition  *string     `gorm:"type:jsonb" json:"content_position"`
+	ContentPosition  *string     `gorm:"type:jsonb" json:"content_position"`     // diff.Anchor into the weave content
+	IsOutdated       bool        `gorm:"default:false;index" json:"is_outdated"` // Anchored content was removed by a later version
 	IsResolved       bool        `gorm:"default:false;index" json:"is_resolved"`
 	ResolvedBy       *uuid.UUID  `gorm:"type:uuid" json:"resolved_by"`
 	ResolvedAt       *time.Time  `json:"resolved_at"`
 	LikeCount        int         `gorm:"default:0" json:"like_count"`
+	EditedAt         *time.Time  `json:"edited_at"` // Last change to the content by its author
 	CreatedAt        time.Time   `gorm:"autoCreateTime;index" json:"created_at"`
 	UpdatedAt        time.Time   `gorm:"autoUpdateTime" json:"updated_at"`
 
@@ -87,6 +127,28 @@ type LabComment struct {
 	Replies       []LabComment `gorm:"foreignKey:ParentCommentID" json:"replies,omitempty"`
 }
 
+// LabCommentRevision keeps the content a lab comment had before one of its edits
+type LabCommentRevision struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	CommentID uuid.UUID `gorm:"type:uuid;not null;index" json:"comment_id"`
+	Content   string    `gorm:"type:text;not null" json:"content"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"` // When the content was replaced
+
+	// Relationships
+	Comment LabComment `gorm:"foreignKey:CommentID" json:"comment,omitempty"`
+}
+
+type LabCommentLike struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_lab_comment_like" json:"user_id"`
+	CommentID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_lab_comment_like;index" json:"comment_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	// Relationships
+	User    User       `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Comment LabComment `gorm:"foreignKey:CommentID" json:"comment,omitempty"`
+}
+
 type ContributionComment struct {
 	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
 	UserID         uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
@@ -102,8 +164,8 @@ type ContributionComment struct {
 
 type ContributionVote struct {
 	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	UserID         uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
-	ContributionID uuid.UUID `gorm:"type:uuid;not null" json:"contribution_id"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_contribution_vote" json:"user_id"`
+	ContributionID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_contribution_vote;index" json:"contribution_id"`
 	VoteType       string    `gorm:"not null;size:10" json:"vote_type"` // up, down
 	CreatedAt      time.Time `gorm:"autoCreateTime" json:"created_at"`
 
@@ -112,6 +174,46 @@ type ContributionVote struct {
 	Contribution Contribution `gorm:"foreignKey:ContributionID" json:"contribution,omitempty"`
 }
 
+type ReviewDecision string
+
+const (
+	ReviewDecisionApprove        ReviewDecision = "approve"
+	ReviewDecisionRequestChanges ReviewDecision = "request_changes"
+	ReviewDecisionReject         ReviewDecision = "reject"
+)
+
+// ContributionReview is one review left on a contribution; the latest one is
+// also copied onto the contribution's review fields
+type ContributionReview struct {
+	ID             uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ContributionID uuid.UUID      `gorm:"type:uuid;not null;index" json:"contribution_id"`
+	ReviewerID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"reviewer_id"`
+	Decision       ReviewDecision `gorm:"type:varchar(20);not null" json:"decision"`
+	Comment        *string        `gorm:"type:text" json:"comment"`
+	CreatedAt      time.Time      `gorm:"autoCreateTime;index" json:"created_at"`
+
+	// Relationships
+	Reviewer     User         `gorm:"foreignKey:ReviewerID" json:"reviewer,omitempty"`
+	Contribution Contribution `gorm:"foreignKey:ContributionID" json:"contribution,omitempty"`
+}
+
+// WeaveMergePolicy sets what a contribution needs before it can be merged into
+// a weave. Weaves without one can be merged by owners and maintainers at any time.
+type WeaveMergePolicy struct {
+	ID                      uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	WeaveID                 uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"weave_id"`
+	RequireOwnerApproval    bool      `gorm:"default:false" json:"require_owner_approval"`
+	RequiredApprovals       int       `gorm:"default:0" json:"required_approvals"` // Approving reviewers needed
+	MinVoteScore            *int      `json:"min_vote_score"`                      // Net vote score needed, nil for none
+	BlockOnChangesRequested bool      `gorm:"default:false" json:"block_on_changes_requested"`
+	UpdatedBy               uuid.UUID `gorm:"type:uuid;not null" json:"updated_by"`
+	CreatedAt               time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt               time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	// Relationships
+	Weave Weave `gorm:"foreignKey:WeaveID" json:"weave,omitempty"`
+}
+
 func (c *Contribution) BeforeCreate(tx *gorm.DB) error {
 	if c.ID == uuid.Nil {
 		c.ID = uuid.New()
@@ -119,6 +221,13 @@ func (c *Contribution) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+func (wc *WeaveCollaborator) BeforeCreate(tx *gorm.DB) error {
+	if wc.ID == uuid.Nil {
+		wc.ID = uuid.New()
+	}
+	return nil
+}
+
 func (lc *LabComment) BeforeCreate(tx *gorm.DB) error {
 	if lc.ID == uuid.Nil {
 		lc.ID = uuid.New()
@@ -126,6 +235,20 @@ func (lc *LabComment) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+func (lr *LabCommentRevision) BeforeCreate(tx *gorm.DB) error {
+	if lr.ID == uuid.Nil {
+		lr.ID = uuid.New()
+	}
+	return nil
+}
+
+func (ll *LabCommentLike) BeforeCreate(tx *gorm.DB) error {
+	if ll.ID == uuid.Nil {
+		ll.ID = uuid.New()
+	}
+	return nil
+}
+
 func (cc *ContributionComment) BeforeCreate(tx *gorm.DB) error {
 	if cc.ID == uuid.Nil {
 		cc.ID = uuid.New()
@@ -138,4 +261,18 @@ func (cv *ContributionVote) BeforeCreate(tx *gorm.DB) error {
 		cv.ID = uuid.New()
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+func (cr *ContributionReview) BeforeCreate(tx *gorm.DB) error {
+	if cr.ID == uuid.Nil {
+		cr.ID = uuid.New()
+	}
+	return nil
+}
+
+func (mp *WeaveMergePolicy) BeforeCreate(tx *gorm.DB) error {
+	if mp.ID == uuid.Nil {
+		mp.ID = uuid.New()
+	}
+	return nil
+}
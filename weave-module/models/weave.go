@@ -39,13 +39,21 @@ type Weave struct {
 	Version             int         `gorm:"default:1" json:"version"`
 	ParentWeaveID       *uuid.UUID  `gorm:"type:uuid;index" json:"parent_weave_id"`
 	OriginalWeaveID     *uuid.UUID  `gorm:"type:uuid;index" json:"original_weave_id"`
+	UpstreamVersion     *int        `json:"upstream_version"` // Parent version last incorporated into a fork
+	SyncedVersion       *int        `json:"synced_version"`   // Fork's own version at that point
 	IsCollaborationOpen bool        `gorm:"default:true" json:"is_collaboration_open"`
 	IsFeatured          bool        `gorm:"default:false;index" json:"is_featured"`
+	IsTemplate          bool        `gorm:"default:false;index" json:"is_template"`
+	TemplateID          *uuid.UUID  `gorm:"type:uuid;index" json:"template_id"`   // Template the weave was started from
+	TemplateVersion     *int        `json:"template_version"`                     // Template version copied at that point
 	ViewCount           int         `gorm:"default:0" json:"view_count"`
 	LikeCount           int         `gorm:"default:0" json:"like_count"`
 	ForkCount           int         `gorm:"default:0" json:"fork_count"`
 	ContributionCount   int         `gorm:"default:0" json:"contribution_count"`
 	PublishedAt         *time.Time  `json:"published_at"`
+	PublishAt           *time.Time  `gorm:"index" json:"publish_at"` // Scheduled publication while draft or in review
+	TrashedAt           *time.Time  `gorm:"index" json:"trashed_at"`
+	PreviousStatus      *WeaveStatus `gorm:"type:varchar(20)" json:"previous_status"` // Status to restore from the trash
 	CreatedAt           time.Time   `gorm:"autoCreateTime;index" json:"created_at"`
 	UpdatedAt           time.Time   `gorm:"autoUpdateTime" json:"updated_at"`
 
@@ -60,15 +68,16 @@ type Weave struct {
 	Versions       []WeaveVersion `gorm:"foreignKey:WeaveID" json:"versions,omitempty"`
 	LabComments    []LabComment   `gorm:"foreignKey:WeaveID" json:"lab_comments,omitempty"`
 	Contributions  []Contribution `gorm:"foreignKey:WeaveID" json:"contributions,omitempty"`
+	Collaborators  []WeaveCollaborator `gorm:"foreignKey:WeaveID" json:"collaborators,omitempty"`
 	Tags           []WeaveTag     `gorm:"many2many:weave_tag_relations;" json:"tags,omitempty"`
 	Collections    []WeaveCollection `gorm:"many2many:collection_weaves;" json:"collections,omitempty"`
 }
 
 type WeaveVersion struct {
 	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	WeaveID     uuid.UUID `gorm:"type:uuid;not null;index" json:"weave_id"`
+	WeaveID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_weave_version" json:"weave_id"`
 	UserID      uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
-	Version     int       `gorm:"not null;index" json:"version"`
+	Version     int       `gorm:"not null;uniqueIndex:idx_weave_version;index" json:"version"`
 	Title       string    `gorm:"not null;size:200" json:"title"`
 	Description *string   `gorm:"type:text" json:"description"`
 	Content     string    `gorm:"type:jsonb;not null" json:"content"`
@@ -114,8 +123,8 @@ const (
 
 type WeaveLike struct {
 	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	UserID    uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
-	WeaveID   uuid.UUID `gorm:"type:uuid;not null" json:"weave_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_weave_like" json:"user_id"`
+	WeaveID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_weave_like;index" json:"weave_id"`
 	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
 
 	// Relationships
@@ -133,9 +142,20 @@ type WeaveTag struct {
 	Weaves []Weave `gorm:"many2many:weave_tag_relations;" json:"weaves,omitempty"`
 }
 
+// WeaveTagAlias points a retired tag name at the tag it was merged into
+type WeaveTagAlias struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Alias     string    `gorm:"uniqueIndex;not null;size:50" json:"alias"`
+	TagID     uuid.UUID `gorm:"type:uuid;not null;index" json:"tag_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	// Relationships
+	Tag WeaveTag `gorm:"foreignKey:TagID" json:"tag,omitempty"`
+}
+
 type WeaveCollection struct {
 	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	UserID      uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
 	Name        string    `gorm:"not null;size:100" json:"name"`
 	Description *string   `gorm:"type:text" json:"description"`
 	IsPublic    bool      `gorm:"default:false" json:"is_public"`
@@ -147,6 +167,14 @@ type WeaveCollection struct {
 	Weaves []Weave `gorm:"many2many:collection_weaves;" json:"weaves,omitempty"`
 }
 
+// CollectionWeave is the collection_weaves join table, keeping the order of weaves in a collection
+type CollectionWeave struct {
+	WeaveCollectionID uuid.UUID `gorm:"type:uuid;primaryKey" json:"collection_id"`
+	WeaveID           uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"weave_id"`
+	Position          int       `gorm:"not null;default:0" json:"position"`
+	CreatedAt         time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
 func (w *Weave) BeforeCreate(tx *gorm.DB) error {
 	if w.ID == uuid.Nil {
 		w.ID = uuid.New()
@@ -182,6 +210,13 @@ func (wt *WeaveTag) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+func (wta *WeaveTagAlias) BeforeCreate(tx *gorm.DB) error {
+	if wta.ID == uuid.Nil {
+		wta.ID = uuid.New()
+	}
+	return nil
+}
+
 func (wc *WeaveCollection) BeforeCreate(tx *gorm.DB) error {
 	if wc.ID == uuid.Nil {
 		wc.ID = uuid.New()
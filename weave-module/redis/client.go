@@ -112,4 +112,28 @@ func CacheUserProfile(ctx context.Context, userID string, data interface{}, expi
 func GetCachedUserProfile(ctx context.Context, userID string) (string, error) {
 	key := fmt.Sprintf("user:profile:%s", userID)
 	return Get(ctx, key)
-}
\ No newline at end of file
+}
+
+// WeaveBlameKey is the cache key of the blame of one weave version
+func WeaveBlameKey(weaveID string, version int) string {
+	return fmt.Sprintf("weave_blame:%s:v%d", weaveID, version)
+}
+
+// DeleteWeaveBlame drops the cached blame of every version of a weave
+func DeleteWeaveBlame(ctx context.Context, weaveID string) error {
+	if Client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+	iter := Client.Scan(ctx, 0, fmt.Sprintf("weave_blame:%s:v*", weaveID), 100).Iterator()
+	var keys []string
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return Del(ctx, keys...)
+}
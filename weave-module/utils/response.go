@@ -38,6 +38,15 @@ func CreatedResponse(c *gin.Context, message string, data interface{}) {
 	})
 }
 
+// AcceptedResponse answers requests whose work continues in the background
+func AcceptedResponse(c *gin.Context, message string, data interface{}) {
+	c.JSON(http.StatusAccepted, Response{
+		Success: true,
+		Message: message,
+		Data:    data,
+	})
+}
+
 func PaginatedSuccessResponse(c *gin.Context, message string, data interface{}, pagination Pagination) {
 	c.JSON(http.StatusOK, PaginatedResponse{
 		Success:    true,
@@ -52,6 +61,7 @@ func ErrorResponse(c *gin.Context, err error) {
 		c.JSON(appErr.Code, Response{
 			Success: false,
 			Error:   appErr.Message,
+			Data:    appErr.Data,
 		})
 		return
 	}
@@ -63,6 +73,15 @@ func ErrorResponse(c *gin.Context, err error) {
 	})
 }
 
+// ConflictResponse reports a 409 with data describing what conflicted
+func ConflictResponse(c *gin.Context, message string, data interface{}) {
+	c.JSON(http.StatusConflict, Response{
+		Success: false,
+		Error:   message,
+		Data:    data,
+	})
+}
+
 func ValidationErrorResponse(c *gin.Context, validationErrors interface{}) {
 	c.JSON(http.StatusBadRequest, Response{
 		Success: false,
@@ -44,6 +44,18 @@ func SendWeeklyDigest(notificationService *services.NotificationService) func()
 	}
 }
 
+// PublishScheduledWeaves creates a job function for publishing weaves whose schedule is due
+func PublishScheduledWeaves(publishingService *services.PublishingService) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		if err := publishingService.PublishScheduledWeaves(ctx); err != nil {
+			log.Printf("Failed to publish scheduled weaves: %v", err)
+		}
+	}
+}
+
 // ProcessAnalyticsEvents creates a job function for processing analytics events
 func ProcessAnalyticsEvents(analyticsService *services.AnalyticsService) func() {
 	return func() {
@@ -176,6 +188,18 @@ func DatabaseMaintenance(cleanupService *services.CleanupService) func() {
 	}
 }
 
+// PurgeTrashedWeaves creates a job function for permanently deleting weaves past their trash retention
+func PurgeTrashedWeaves(cleanupService *services.CleanupService) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancel()
+
+		if err := cleanupService.PurgeTrashedWeaves(ctx); err != nil {
+			log.Printf("Failed to purge trashed weaves: %v", err)
+		}
+	}
+}
+
 // ArchiveOldData creates a job function for archiving old data
 func ArchiveOldData(cleanupService *services.CleanupService) func() {
 	return func() {
@@ -186,4 +210,4 @@ func ArchiveOldData(cleanupService *services.CleanupService) func() {
 			log.Printf("Failed to archive old data: %v", err)
 		}
 	}
-}
\ No newline at end of file
+}
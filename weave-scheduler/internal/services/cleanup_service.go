@@ -6,14 +6,24 @@ import (
 	"log"
 	"time"
 
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"weave-module/database"
+	"weave-module/models"
 	"weave-module/redis"
 )
 
-type CleanupService struct{}
+const trashPurgeBatchSize = 100
 
-func NewCleanupService() *CleanupService {
-	return &CleanupService{}
+type CleanupService struct {
+	trashRetention time.Duration
+}
+
+func NewCleanupService(trashRetention time.Duration) *CleanupService {
+	return &CleanupService{
+		trashRetention: trashRetention,
+	}
 }
 
 // CleanupExpiredSessions removes expired session data from Redis
@@ -283,4 +293,75 @@ func (s *CleanupService) ArchiveOldData(ctx context.Context) error {
 
 	log.Printf("Archived %d analytics events", archivedCount)
 	return nil
-}
\ No newline at end of file
+}
+
+// PurgeTrashedWeaves permanently deletes weaves that have been in the trash longer than
+// the retention period, together with their versions, likes, comments and contributions.
+// Each weave is locked with SKIP LOCKED before purging, so concurrent replicas and
+// restores never act on the same weave.
+func (s *CleanupService) PurgeTrashedWeaves(ctx context.Context) error {
+	log.Println("Purging trashed weaves...")
+
+	db := database.GetDB()
+	cutoff := time.Now().Add(-s.trashRetention)
+
+	purgedCount := 0
+	var lastID uuid.UUID
+	for {
+		// Weaves deleted before the trash existed have no trashed_at, so fall back to updated_at
+		var weaveIDs []uuid.UUID
+		err := db.WithContext(ctx).Model(&models.Weave{}).
+			Where("status = ? AND COALESCE(trashed_at, updated_at) <= ? AND id > ?", models.WeaveStatusDeleted, cutoff, lastID).
+			Order("id ASC").
+			Limit(trashPurgeBatchSize).
+			Pluck("id", &weaveIDs).Error
+		if err != nil {
+			return fmt.Errorf("failed to fetch trashed weaves: %w", err)
+		}
+
+		for _, weaveID := range weaveIDs {
+			purged, err := s.purgeTrashedWeave(ctx, weaveID, cutoff)
+			if err != nil {
+				log.Printf("Failed to purge weave %s: %v", weaveID, err)
+				continue
+			}
+			if purged {
+				purgedCount++
+			}
+		}
+
+		if len(weaveIDs) < trashPurgeBatchSize {
+			break
+		}
+		lastID = weaveIDs[len(weaveIDs)-1]
+	}
+
+	log.Printf("Purged %d trashed weaves", purgedCount)
+	return nil
+}
+
+// purgeTrashedWeave deletes one weave if it is still due and no one else holds it
+func (s *CleanupService) purgeTrashedWeave(ctx context.Context, weaveID uuid.UUID, cutoff time.Time) (bool, error) {
+	purged := false
+	err := database.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var weave models.Weave
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Select("id").
+			Where("id = ? AND status = ? AND COALESCE(trashed_at, updated_at) <= ?", weaveID, models.WeaveStatusDeleted, cutoff).
+			Limit(1).
+			Find(&weave)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil // Restored, already purged or being purged elsewhere
+		}
+
+		if err := database.PurgeWeave(tx, weaveID); err != nil {
+			return err
+		}
+		purged = true
+		return nil
+	})
+	return purged, err
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"weave-module/database"
+	"weave-module/models"
+	"weave-module/queue"
+)
+
+const (
+	scheduledPublishBatchSize = 100
+	followerNotifyBatchSize   = 500
+)
+
+type PublishingService struct{}
+
+func NewPublishingService() *PublishingService {
+	return &PublishingService{}
+}
+
+// PublishScheduledWeaves publishes draft and in-review weaves whose publish_at has passed.
+// Due weaves are claimed with FOR UPDATE SKIP LOCKED, so replicas running the job at the
+// same time split the work instead of publishing a weave twice.
+func (s *PublishingService) PublishScheduledWeaves(ctx context.Context) error {
+	log.Println("Publishing scheduled weaves...")
+
+	total := 0
+	for {
+		published, err := s.publishDueBatch(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to publish scheduled weaves: %w", err)
+		}
+
+		// Notifications go out after the commit; a crash in between only loses notifications
+		for _, weave := range published {
+			s.notifyPublished(ctx, weave)
+		}
+
+		total += len(published)
+		if len(published) < scheduledPublishBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Printf("Published %d scheduled weaves", total)
+	}
+	return nil
+}
+
+// publishDueBatch publishes up to one batch of due weaves in a single transaction
+func (s *PublishingService) publishDueBatch(ctx context.Context) ([]models.Weave, error) {
+	db := database.GetDB()
+	now := time.Now()
+
+	var due []models.Weave
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ? AND publish_at <= ?",
+				[]models.WeaveStatus{models.WeaveStatusDraft, models.WeaveStatusInReview}, now).
+			Order("publish_at ASC").
+			Limit(scheduledPublishBatchSize).
+			Find(&due).Error
+		if err != nil {
+			return err
+		}
+
+		for i := range due {
+			weave := &due[i]
+			from := weave.Status
+			scheduledAt := *weave.PublishAt
+
+			if weave.PublishedAt == nil {
+				weave.PublishedAt = &now
+			}
+			err := tx.Model(&models.Weave{}).Where("id = ?", weave.ID).Updates(map[string]interface{}{
+				"status":       models.WeaveStatusPublished,
+				"published_at": weave.PublishedAt,
+				"publish_at":   nil,
+				"updated_at":   now,
+			}).Error
+			if err != nil {
+				return err
+			}
+			weave.Status = models.WeaveStatusPublished
+			weave.PublishAt = nil
+
+			metadata, err := json.Marshal(map[string]interface{}{
+				"from":         from,
+				"to":           models.WeaveStatusPublished,
+				"scheduled_at": scheduledAt,
+			})
+			if err != nil {
+				return err
+			}
+			serializedMetadata := string(metadata)
+
+			// Recorded like any other status change, so timeline readers need not special-case the scheduler
+			timeline := &models.WeaveTimeline{
+				WeaveID:   weave.ID,
+				UserID:    weave.UserID,
+				EventType: models.TimelineStatusChanged,
+				Title:     fmt.Sprintf("Status changed to %s", models.WeaveStatusPublished),
+				Metadata:  &serializedMetadata,
+			}
+			if err := tx.Create(timeline).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return due, nil
+}
+
+// notifyPublished tells the author the schedule fired and fans the news out to their followers
+func (s *PublishingService) notifyPublished(ctx context.Context, weave models.Weave) {
+	db := database.GetDB()
+
+	var author models.User
+	if err := db.WithContext(ctx).Select("id", "username").First(&author, "id = ?", weave.UserID).Error; err != nil {
+		log.Printf("Failed to load author of weave %s: %v", weave.ID, err)
+		return
+	}
+
+	data := map[string]interface{}{
+		"type":      "weave_published",
+		"weave_id":  weave.ID.String(),
+		"author_id": author.ID.String(),
+	}
+
+	if err := queue.PublishNotification(queue.NotificationMessage{
+		UserID:  author.ID.String(),
+		Type:    "weave_published",
+		Title:   "Weave Published",
+		Message: fmt.Sprintf("Your weave \"%s\" was published as scheduled", weave.Title),
+		Data:    data,
+	}); err != nil {
+		log.Printf("Failed to notify author of weave %s: %v", weave.ID, err)
+	}
+
+	for offset := 0; ; offset += followerNotifyBatchSize {
+		var followerIDs []uuid.UUID
+		err := db.WithContext(ctx).Model(&models.UserFollow{}).
+			Where("following_id = ?", author.ID).
+			Order("follower_id").
+			Limit(followerNotifyBatchSize).
+			Offset(offset).
+			Pluck("follower_id", &followerIDs).Error
+		if err != nil {
+			log.Printf("Failed to fetch followers of user %s: %v", author.ID, err)
+			return
+		}
+
+		for _, followerID := range followerIDs {
+			err := queue.PublishNotification(queue.NotificationMessage{
+				UserID:  followerID.String(),
+				Type:    "weave_published",
+				Title:   "New Weave",
+				Message: fmt.Sprintf("%s published \"%s\"", author.Username, weave.Title),
+				Data:    data,
+			})
+			if err != nil {
+				log.Printf("Failed to notify follower %s of weave %s: %v", followerID, weave.ID, err)
+			}
+		}
+
+		if len(followerIDs) < followerNotifyBatchSize {
+			return
+		}
+	}
+}
@@ -43,14 +43,15 @@ func main() {
 	// Initialize services
 	notificationService := services.NewNotificationService()
 	analyticsService := services.NewAnalyticsService()
-	cleanupService := services.NewCleanupService()
+	cleanupService := services.NewCleanupService(time.Duration(cfg.Trash.RetentionDays) * 24 * time.Hour)
 	trendsService := services.NewTrendsService()
+	publishingService := services.NewPublishingService()
 
 	// Create cron scheduler with logger
 	c := cron.New(cron.WithLogger(cron.VerbosePrintfLogger(log.New(os.Stdout, "cron: ", log.LstdFlags))))
 
 	// Register jobs
-	registerJobs(c, notificationService, analyticsService, cleanupService, trendsService)
+	registerJobs(c, notificationService, analyticsService, cleanupService, trendsService, publishingService)
 
 	// Start the cron scheduler
 	c.Start()
@@ -81,12 +82,16 @@ func registerJobs(
 	analyticsService *services.AnalyticsService,
 	cleanupService *services.CleanupService,
 	trendsService *services.TrendsService,
+	publishingService *services.PublishingService,
 ) {
 	// Notification jobs
 	c.AddFunc("@every 1m", jobs.SendPendingNotifications(notificationService))
 	c.AddFunc("0 9 * * *", jobs.SendDailyDigest(notificationService))         // Daily at 9 AM
 	c.AddFunc("0 9 * * MON", jobs.SendWeeklyDigest(notificationService))      // Weekly on Monday at 9 AM
 
+	// Publishing jobs
+	c.AddFunc("@every 1m", jobs.PublishScheduledWeaves(publishingService))
+
 	// Analytics jobs
 	c.AddFunc("@every 5m", jobs.ProcessAnalyticsEvents(analyticsService))
 	c.AddFunc("@every 30m", jobs.UpdateUserStats(analyticsService))
@@ -102,6 +107,7 @@ func registerJobs(
 	c.AddFunc("0 1 * * *", jobs.CleanupExpiredSessions(cleanupService))       // Daily at 1 AM
 	c.AddFunc("0 0 * * SUN", jobs.CleanupOldLogs(cleanupService))             // Weekly on Sunday at midnight
 	c.AddFunc("0 4 * * *", jobs.CleanupTempFiles(cleanupService))             // Daily at 4 AM
+	c.AddFunc("30 4 * * *", jobs.PurgeTrashedWeaves(cleanupService))          // Daily at 4:30 AM
 
 	// Database maintenance jobs
 	c.AddFunc("0 5 * * SUN", jobs.DatabaseMaintenance(cleanupService))        // Weekly on Sunday at 5 AM
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"weave-module/contentschema"
+	"weave-module/database"
+	"weave-module/models"
+	"weave-module/redis"
+)
+
+const (
+	defaultUpgradeBatchSize = 100
+	maxUpgradeBatchSize     = 1000
+
+	// Progress stays readable for a week after the last batch
+	upgradeProgressTTL = 7 * 24 * time.Hour
+)
+
+// staleContentCondition matches weaves of a type stored below the given schema version
+const staleContentCondition = "content->>'type' = ? AND COALESCE((content->>'schema_version')::int, 1) < ?"
+
+// ContentUpgradeProgress is published to Redis after every batch of a bulk upgrade
+type ContentUpgradeProgress struct {
+	TaskID        string    `json:"task_id"`
+	ContentType   string    `json:"content_type"`
+	TargetVersion int       `json:"target_version"`
+	Total         int64     `json:"total"`
+	Processed     int       `json:"processed"`
+	Upgraded      int       `json:"upgraded"`
+	Skipped       int       `json:"skipped"` // Edited while the batch ran; they are upgraded on their next read
+	Failed        int       `json:"failed"`
+	Status        string    `json:"status"` // running, completed
+	StartedAt     time.Time `json:"started_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ContentUpgradeProgressKey is the Redis key holding the progress of a content type in an upgrade task
+func ContentUpgradeProgressKey(taskID, contentType string) string {
+	return fmt.Sprintf("content_upgrade:%s:%s", taskID, contentType)
+}
+
+// upgradeWeaveContent rewrites stored weave content to the current schema of its
+// content type. Reads already upgrade lazily, so this only saves that work and
+// lets old schema upgrades eventually be retired.
+func (s *ProcessingService) upgradeWeaveContent(ctx context.Context, data interface{}) error {
+	upgradeData, ok := data.(map[string]interface{})
+	if !ok {
+		upgradeData = make(map[string]interface{})
+	}
+
+	taskID, _ := upgradeData["task_id"].(string)
+	if taskID == "" {
+		taskID = uuid.New().String()
+	}
+
+	batchSize := defaultUpgradeBatchSize
+	if size, ok := upgradeData["batch_size"].(float64); ok && size > 0 {
+		batchSize = int(size)
+	}
+	if batchSize > maxUpgradeBatchSize {
+		batchSize = maxUpgradeBatchSize
+	}
+
+	migrator := contentschema.Default()
+	contentTypes := migrator.Types()
+	if contentType, ok := upgradeData["content_type"].(string); ok && contentType != "" {
+		contentTypes = []string{contentType}
+	}
+
+	for _, contentType := range contentTypes {
+		if err := s.upgradeContentType(ctx, migrator, taskID, contentType, batchSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ProcessingService) upgradeContentType(ctx context.Context, migrator *contentschema.Migrator, taskID, contentType string, batchSize int) error {
+	db := database.GetDB()
+
+	progress := &ContentUpgradeProgress{
+		TaskID:        taskID,
+		ContentType:   contentType,
+		TargetVersion: migrator.CurrentVersion(contentType),
+		Status:        "running",
+		StartedAt:     time.Now(),
+	}
+
+	err := db.WithContext(ctx).Model(&models.Weave{}).
+		Where(staleContentCondition, contentType, progress.TargetVersion).
+		Count(&progress.Total).Error
+	if err != nil {
+		return fmt.Errorf("failed to count %s weaves to upgrade: %w", contentType, err)
+	}
+	log.Printf("Upgrading %d %s weaves to schema v%d", progress.Total, contentType, progress.TargetVersion)
+
+	// Walk by ID so rows that fail to upgrade are not picked up again
+	lastID := uuid.Nil
+	for {
+		var weaves []models.Weave
+		err := db.WithContext(ctx).
+			Select("id", "version", "content").
+			Where(staleContentCondition, contentType, progress.TargetVersion).
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(batchSize).
+			Find(&weaves).Error
+		if err != nil {
+			return fmt.Errorf("failed to load %s weaves to upgrade: %w", contentType, err)
+		}
+		if len(weaves) == 0 {
+			break
+		}
+
+		for i := range weaves {
+			switch err := s.upgradeStoredWeave(ctx, migrator, &weaves[i]); {
+			case err == errWeaveChanged:
+				progress.Skipped++
+			case err != nil:
+				log.Printf("Failed to upgrade weave %s: %v", weaves[i].ID, err)
+				progress.Failed++
+			default:
+				progress.Upgraded++
+			}
+			progress.Processed++
+		}
+		lastID = weaves[len(weaves)-1].ID
+
+		s.reportUpgradeProgress(ctx, progress)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	progress.Status = "completed"
+	s.reportUpgradeProgress(ctx, progress)
+	return nil
+}
+
+var errWeaveChanged = fmt.Errorf("weave changed during upgrade")
+
+// upgradeStoredWeave rewrites one weave's content without touching its version or
+// updated_at, since a schema upgrade is not an edit
+func (s *ProcessingService) upgradeStoredWeave(ctx context.Context, migrator *contentschema.Migrator, weave *models.Weave) error {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(weave.Content), &document); err != nil {
+		return fmt.Errorf("invalid content: %w", err)
+	}
+
+	changed, err := migrator.UpgradeDocument(document)
+	if err != nil || !changed {
+		return err
+	}
+
+	content, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	result := database.GetDB().WithContext(ctx).Model(&models.Weave{}).
+		Where("id = ? AND version = ?", weave.ID, weave.Version).
+		UpdateColumn("content", string(content))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errWeaveChanged
+	}
+	return nil
+}
+
+func (s *ProcessingService) reportUpgradeProgress(ctx context.Context, progress *ContentUpgradeProgress) {
+	progress.UpdatedAt = time.Now()
+	log.Printf("Content upgrade %s: %s %d/%d processed (%d upgraded, %d skipped, %d failed)",
+		progress.TaskID, progress.ContentType, progress.Processed, progress.Total,
+		progress.Upgraded, progress.Skipped, progress.Failed)
+
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return
+	}
+	key := ContentUpgradeProgressKey(progress.TaskID, progress.ContentType)
+	if err := redis.Set(ctx, key, string(data), upgradeProgressTTL); err != nil {
+		log.Printf("Failed to store content upgrade progress: %v", err)
+	}
+}
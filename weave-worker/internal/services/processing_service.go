@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"log"
 
+	"gorm.io/gorm"
 	"weave-module/database"
 	"weave-module/models"
 	"weave-module/queue"
+	"weave-module/redis"
 )
 
 type ProcessingService struct{}
@@ -25,14 +27,16 @@ func (s *ProcessingService) ProcessTask(ctx context.Context, msg queue.Processin
 		return s.generateWeaveTimeline(ctx, msg.WeaveID, msg.Data)
 	case "update_user_stats":
 		return s.updateUserStats(ctx, msg.UserID, msg.Data)
-	case "process_contribution":
-		return s.processContribution(ctx, msg.Data)
 	case "cleanup_user_data":
 		return s.cleanupUserData(ctx, msg.UserID, msg.Data)
 	case "regenerate_recommendations":
 		return s.regenerateRecommendations(ctx, msg.UserID, msg.Data)
 	case "process_image_upload":
 		return s.processImageUpload(ctx, msg.Data)
+	case "upgrade_weave_content":
+		return s.upgradeWeaveContent(ctx, msg.Data)
+	case "import_weave_bundle":
+		return s.importWeaveBundle(ctx, msg.UserID, msg.Data)
 	default:
 		log.Printf("Unknown processing task type: %s", msg.Type)
 		return fmt.Errorf("unknown task type: %s", msg.Type)
@@ -134,46 +138,6 @@ func (s *ProcessingService) updateUserStats(ctx context.Context, userID string,
 	return nil
 }
 
-// processContribution handles contribution processing
-func (s *ProcessingService) processContribution(ctx context.Context, data interface{}) error {
-	log.Println("Processing contribution")
-
-	contributionData, ok := data.(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("invalid contribution data format")
-	}
-
-	contributionID, ok := contributionData["contribution_id"].(string)
-	if !ok {
-		return fmt.Errorf("missing contribution_id")
-	}
-
-	db := database.GetDB()
-	
-	// Get contribution details
-	var contribution models.Contribution
-	if err := db.WithContext(ctx).Where("id = ?", contributionID).First(&contribution).Error; err != nil {
-		return fmt.Errorf("failed to find contribution %s: %w", contributionID, err)
-	}
-
-	// Process based on contribution type
-	switch contribution.Type {
-	case "suggestion":
-		log.Printf("Processing suggestion contribution %s", contributionID)
-		// Handle suggestion logic
-	case "fork":
-		log.Printf("Processing fork contribution %s", contributionID)
-		// Handle fork logic
-	case "merge":
-		log.Printf("Processing merge contribution %s", contributionID)
-		// Handle merge logic
-	default:
-		log.Printf("Unknown contribution type: %s", contribution.Type)
-	}
-
-	return nil
-}
-
 // cleanupUserData removes user data when account is deleted
 func (s *ProcessingService) cleanupUserData(ctx context.Context, userID string, data interface{}) error {
 	log.Printf("Cleaning up data for user %s", userID)
@@ -212,46 +176,91 @@ func (s *ProcessingService) cleanupUserData(ctx context.Context, userID string,
 		}
 		defer tx.Rollback()
 
-		// Delete in order to respect foreign key constraints
+		// Delete in order to respect foreign key constraints. Postgres aborts the
+		// transaction on the first failed statement, so any failure ends the job.
 		
 		// 1. Delete user likes
 		if err := tx.Where("user_id = ?", userID).Delete(&models.WeaveLike{}).Error; err != nil {
-			log.Printf("Failed to delete user likes: %v", err)
+			return fmt.Errorf("failed to delete user likes: %w", err)
 		}
 		
-		// 2. Delete user comments
+		// 2. Delete user comments, along with their likes and edit history
+		if err := tx.Where("user_id = ?", userID).Delete(&models.LabCommentLike{}).Error; err != nil {
+			return fmt.Errorf("failed to delete user comment likes: %w", err)
+		}
+		userCommentIDs := tx.Model(&models.LabComment{}).Select("id").Where("user_id = ?", userID)
+		if err := tx.Where("comment_id IN (?)", userCommentIDs).Delete(&models.LabCommentLike{}).Error; err != nil {
+			return fmt.Errorf("failed to delete likes on user comments: %w", err)
+		}
+		if err := tx.Where("comment_id IN (?)", userCommentIDs).Delete(&models.LabCommentRevision{}).Error; err != nil {
+			return fmt.Errorf("failed to delete user comment history: %w", err)
+		}
 		if err := tx.Where("user_id = ?", userID).Delete(&models.LabComment{}).Error; err != nil {
-			log.Printf("Failed to delete user comments: %v", err)
+			return fmt.Errorf("failed to delete user comments: %w", err)
 		}
 		
-		// 3. Delete user contributions
+		// 3. Delete user contributions, along with the user's reviews and the reviews they received
+		if err := tx.Where("reviewer_id = ?", userID).Delete(&models.ContributionReview{}).Error; err != nil {
+			return fmt.Errorf("failed to delete user reviews: %w", err)
+		}
+		userContributionIDs := tx.Model(&models.Contribution{}).Select("id").Where("user_id = ?", userID)
+		if err := tx.Where("contribution_id IN (?)", userContributionIDs).Delete(&models.ContributionReview{}).Error; err != nil {
+			return fmt.Errorf("failed to delete reviews of user contributions: %w", err)
+		}
 		if err := tx.Where("user_id = ?", userID).Delete(&models.Contribution{}).Error; err != nil {
-			log.Printf("Failed to delete user contributions: %v", err)
+			return fmt.Errorf("failed to delete user contributions: %w", err)
 		}
 		
 		// 4. Delete user follows (both as follower and following)
 		if err := tx.Where("follower_id = ? OR following_id = ?", userID, userID).Delete(&models.UserFollow{}).Error; err != nil {
-			log.Printf("Failed to delete user follows: %v", err)
+			return fmt.Errorf("failed to delete user follows: %w", err)
+		}
+		
+		// 4b. Remove the user from other weaves, the invitations they sent and the collaborators of their own weaves
+		userWeaveIDs := tx.Model(&models.Weave{}).Select("id").Where("user_id = ?", userID)
+		err := tx.Where("user_id = ? OR invited_by = ? OR weave_id IN (?)", userID, userID, userWeaveIDs).
+			Delete(&models.WeaveCollaborator{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete weave collaborators: %w", err)
 		}
 		
-		// 5. Delete user weave versions
+		// 5. Keep the history of other users' weaves: versions the user wrote there,
+		// merged contributions included, are credited to the weave owner instead.
+		// Versions of the user's own weaves go with the weaves.
+		otherWeaveIDs := tx.Model(&models.Weave{}).Select("id").Where("user_id <> ?", userID)
+		var creditedWeaveIDs []string
+		err = tx.Model(&models.WeaveVersion{}).
+			Distinct("weave_id").
+			Where("user_id = ? AND weave_id IN (?)", userID, otherWeaveIDs).
+			Pluck("weave_id", &creditedWeaveIDs).Error
+		if err != nil {
+			return fmt.Errorf("failed to find weaves edited by user: %w", err)
+		}
+		err = tx.Model(&models.WeaveVersion{}).
+			Where("user_id = ? AND weave_id IN (?)", userID, otherWeaveIDs).
+			Update("user_id", gorm.Expr("(SELECT weaves.user_id FROM weaves WHERE weaves.id = weave_versions.weave_id)")).Error
+		if err != nil {
+			return fmt.Errorf("failed to reassign weave versions: %w", err)
+		}
 		if err := tx.Where("user_id = ?", userID).Delete(&models.WeaveVersion{}).Error; err != nil {
-			log.Printf("Failed to delete weave versions: %v", err)
+			return fmt.Errorf("failed to delete weave versions: %w", err)
+		}
+		if err := tx.Model(&models.Contribution{}).Where("merged_by = ?", userID).Update("merged_by", nil).Error; err != nil {
+			return fmt.Errorf("failed to clear merges by user: %w", err)
 		}
 		
 		// 6. Delete user weaves (this will cascade to related data)
 		if err := tx.Where("user_id = ?", userID).Delete(&models.Weave{}).Error; err != nil {
-			log.Printf("Failed to delete user weaves: %v", err)
+			return fmt.Errorf("failed to delete user weaves: %w", err)
 		}
 		
 		// 7. Delete user profile
 		if err := tx.Where("user_id = ?", userID).Delete(&models.UserProfile{}).Error; err != nil {
-			log.Printf("Failed to delete user profile: %v", err)
+			return fmt.Errorf("failed to delete user profile: %w", err)
 		}
 		
 		// 8. Finally, delete the user record
 		if err := tx.Where("id = ?", userID).Delete(&models.User{}).Error; err != nil {
-			log.Printf("Failed to delete user record: %v", err)
 			return fmt.Errorf("failed to delete user record: %w", err)
 		}
 		
@@ -260,6 +269,15 @@ func (s *ProcessingService) cleanupUserData(ctx context.Context, userID string,
 			return fmt.Errorf("failed to commit hard delete transaction: %w", err)
 		}
 		
+		// Cached blame of the reassigned versions still names the user. The
+		// deletion itself stands, so a failure here only leaves stale entries
+		// until they expire.
+		for _, weaveID := range creditedWeaveIDs {
+			if err := redis.DeleteWeaveBlame(ctx, weaveID); err != nil {
+				log.Printf("Failed to drop cached blame of weave %s: %v", weaveID, err)
+			}
+		}
+		
 		log.Printf("User %s hard deleted successfully", userID)
 	}
 
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"weave-module/bundle"
+	"weave-module/database"
+	"weave-module/models"
+	"weave-module/queue"
+)
+
+// importWeaveBundle restores a bundle the API validated but found too large to
+// import inline. Redelivered messages are recognised by their import ID, so a
+// bundle is never imported twice.
+func (s *ProcessingService) importWeaveBundle(ctx context.Context, userID string, data interface{}) error {
+	importData, ok := data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid import data format")
+	}
+
+	importID, err := uuid.Parse(fmt.Sprint(importData["import_id"]))
+	if err != nil {
+		return fmt.Errorf("invalid import_id: %w", err)
+	}
+	channelID, err := uuid.Parse(fmt.Sprint(importData["channel_id"]))
+	if err != nil {
+		return fmt.Errorf("invalid channel_id: %w", err)
+	}
+	owner := models.ParseUUID(userID)
+	if owner == uuid.Nil {
+		return fmt.Errorf("invalid user_id %q", userID)
+	}
+
+	raw, err := json.Marshal(importData["bundle"])
+	if err != nil {
+		return fmt.Errorf("invalid bundle: %w", err)
+	}
+	b, err := bundle.Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	var weave *models.Weave
+	err = database.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := database.FindImportedWeave(tx, importID)
+		if err != nil {
+			return err
+		}
+		if existing != uuid.Nil {
+			log.Printf("Import %s already created weave %s", importID, existing)
+			return nil
+		}
+
+		weave, err = database.ImportBundle(tx, b, owner, channelID, importID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import bundle %s: %w", importID, err)
+	}
+	if weave == nil {
+		return nil
+	}
+
+	log.Printf("Import %s created weave %s with %d versions", importID, weave.ID, weave.Version)
+	err = queue.PublishNotification(queue.NotificationMessage{
+		UserID:  userID,
+		Type:    "weave_imported",
+		Title:   "Import finished",
+		Message: fmt.Sprintf("\"%s\" was imported as a draft", weave.Title),
+		Data: map[string]interface{}{
+			"import_id": importID,
+			"weave_id":  weave.ID,
+		},
+	})
+	if err != nil {
+		// The weave exists; a missed notification is not worth a retry
+		log.Printf("Failed to notify user %s about import %s: %v", userID, importID, err)
+	}
+	return nil
+}